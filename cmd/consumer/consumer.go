@@ -6,8 +6,16 @@ import (
 	"github.com/nocturna-ta/golib/event"
 	"github.com/nocturna-ta/golib/log"
 	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/infrastructures/disclosure"
+	"github.com/nocturna-ta/result/internal/infrastructures/embargo"
+	"github.com/nocturna-ta/result/internal/infrastructures/ethereum"
+	"github.com/nocturna-ta/result/internal/infrastructures/health"
 	"github.com/nocturna-ta/result/internal/infrastructures/kafka"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
+	"github.com/nocturna-ta/result/internal/infrastructures/privacy"
+	"github.com/nocturna-ta/result/internal/infrastructures/registry"
 	"github.com/spf13/cobra"
+	"time"
 )
 
 var (
@@ -40,10 +48,77 @@ func run(cmd *cobra.Command, args []string) error {
 		ConnMaxLifetime: cfg.Database.ConnMaxLifetime,
 	}, sql.DriverClickHouse)
 
+	embargoSchedule, err := embargo.New(cfg.Embargo)
+	if err != nil {
+		return err
+	}
+
+	privacyPolicy, err := privacy.New(cfg.Privacy)
+	if err != nil {
+		return err
+	}
+
+	disclosureControl, err := disclosure.New(cfg.Disclosure)
+	if err != nil {
+		return err
+	}
+
+	var regionHierarchy *registry.RegionHierarchy
+	if cfg.Registry.RegionsFile != "" {
+		regionHierarchy, err = registry.LoadRegionHierarchy(cfg.Registry.RegionsFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	var producer *kafka.Producer
+	if cfg.ResultFeed.Enabled {
+		producer, err = kafka.NewProducer(cfg.Kafka.Producer, cfg.Kafka.Consumer)
+		if err != nil {
+			return err
+		}
+
+		err = producer.EnsureCompactedTopic(cfg.Kafka.Topics.ResultUpdated.Value, cfg.ResultFeed.Partitions, cfg.ResultFeed.ReplicationFactor)
+		if err != nil {
+			_ = producer.Close()
+			return err
+		}
+	}
+
+	var (
+		eventSource *ethereum.EventSource
+		verifier    *ethereum.Verifier
+	)
+	if cfg.ChainIngestion.Enabled || cfg.Finality.ConfirmationDepth > 0 || cfg.Reconciliation.Enabled {
+		client, err := ethereum.GetEthereumClient(&cfg.Blockchain)
+		if err != nil {
+			return err
+		}
+
+		defer client.Close()
+
+		if cfg.ChainIngestion.Enabled {
+			eventSource, err = ethereum.NewEventSource(client.GetEthClient(), cfg.ChainIngestion.ContractAddress)
+			if err != nil {
+				return err
+			}
+		}
+		if cfg.Finality.ConfirmationDepth > 0 || cfg.Reconciliation.Enabled {
+			verifier = ethereum.NewVerifier(client.GetEthClient())
+		}
+	}
+
 	appContainer := newContainer(&options{
-		Cfg: cfg,
-		DB:  database,
-		Ctx: ctx,
+		Cfg:         cfg,
+		DB:          database,
+		Ctx:         ctx,
+		Embargo:     embargoSchedule,
+		Privacy:     privacyPolicy,
+		Disclosure:  disclosureControl,
+		Producer:    producer,
+		Regions:     regionHierarchy,
+		EventSource: eventSource,
+		Verifier:    verifier,
 	})
 
 	consumer, err := kafka.NewConsumer(context.Background(), cfg.Kafka.Consumer, &appContainer.EventHandler)
@@ -55,18 +130,39 @@ func run(cmd *cobra.Command, args []string) error {
 		event.TopicName(cfg.Kafka.Topics.VoteSubmitData.Value): {
 			ConsumerGroup:     cfg.Kafka.Consumer.ConsumerGroup,
 			ErrorHandlerLevel: cfg.Kafka.Topics.VoteSubmitData.ErrorHandler,
-			Handler:           appContainer.ConsumerUc.ConsumeVoteSubmit,
+			Handler:           metrics.InstrumentConsumer(appContainer.ConsumerUc.ConsumeVoteSubmit),
 			WithBackOff:       cfg.Kafka.Topics.VoteSubmitData.WithBackOff,
 		},
 		event.TopicName(cfg.Kafka.Topics.VoteProcessed.Value): {
 			ConsumerGroup:     cfg.Kafka.Consumer.ConsumerGroup,
 			ErrorHandlerLevel: cfg.Kafka.Topics.VoteProcessed.ErrorHandler,
-			Handler:           appContainer.ConsumerUc.ConsumeVoteProcessed,
+			Handler:           metrics.InstrumentConsumer(appContainer.ConsumerUc.ConsumeVoteProcessed),
 			WithBackOff:       cfg.Kafka.Topics.VoteProcessed.WithBackOff,
 		},
 	}
 
+	metricsServer := metrics.NewServer(cfg.Metrics.Port, cfg.Metrics.Path)
+	metricsServer.Handle("/health/live", health.HTTPHandler(appContainer.Liveness))
+	metricsServer.Handle("/health/ready", health.HTTPHandler(appContainer.Readiness))
+	go metricsServer.Run()
+
 	consumer.RunWithHandlerConfig(topicHandler)
 
+	stopCtx, stopCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer stopCancel()
+	if err := metricsServer.Stop(stopCtx); err != nil {
+		log.Error("Error shutting down metrics server:", err)
+	}
+
+	if err := appContainer.KafkaHealth.Close(); err != nil {
+		log.Error("Error closing kafka health client:", err)
+	}
+
+	if producer != nil {
+		if err := producer.Close(); err != nil {
+			log.Error("Error closing kafka producer:", err)
+		}
+	}
+
 	return nil
 }
@@ -5,11 +5,27 @@ import (
 	"github.com/nocturna-ta/golib/database/sql"
 	"github.com/nocturna-ta/golib/event/handler"
 	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/infrastructures/disclosure"
+	"github.com/nocturna-ta/result/internal/infrastructures/electorate"
+	"github.com/nocturna-ta/result/internal/infrastructures/embargo"
+	"github.com/nocturna-ta/result/internal/infrastructures/ethereum"
+	"github.com/nocturna-ta/result/internal/infrastructures/health"
+	"github.com/nocturna-ta/result/internal/infrastructures/kafka"
+	"github.com/nocturna-ta/result/internal/infrastructures/privacy"
+	"github.com/nocturna-ta/result/internal/infrastructures/registry"
 	"github.com/nocturna-ta/result/internal/infrastructures/websocket"
 	"github.com/nocturna-ta/result/internal/interfaces/dao"
 	"github.com/nocturna-ta/result/internal/usecases"
+	"github.com/nocturna-ta/result/internal/usecases/anomaly"
+	"github.com/nocturna-ta/result/internal/usecases/certification"
+	"github.com/nocturna-ta/result/internal/usecases/chain_ingestion"
 	"github.com/nocturna-ta/result/internal/usecases/consumer"
+	"github.com/nocturna-ta/result/internal/usecases/finality"
 	"github.com/nocturna-ta/result/internal/usecases/live_result"
+	"github.com/nocturna-ta/result/internal/usecases/merkle"
+	"github.com/nocturna-ta/result/internal/usecases/reconciliation"
+	"github.com/nocturna-ta/result/internal/usecases/result_feed"
+	"github.com/nocturna-ta/result/internal/usecases/webhook"
 )
 
 type container struct {
@@ -17,12 +33,25 @@ type container struct {
 	ConsumerUc   usecases.Consumer
 	EventHandler handler.EventHandler
 	WebSocketHub *websocket.Hub
+	KafkaHealth  *kafka.GroupHealth
+	Liveness     *health.Checker
+	Readiness    *health.Checker
 }
 
 type options struct {
-	Cfg *config.MainConfig
-	DB  *sql.Store
-	Ctx context.Context
+	Cfg        *config.MainConfig
+	DB         *sql.Store
+	Ctx        context.Context
+	Embargo    *embargo.Schedule
+	Privacy    *privacy.Policy
+	Disclosure *disclosure.Control
+	Producer   *kafka.Producer
+	Regions    *registry.RegionHierarchy
+	// EventSource reads the voting contract when chain ingestion is enabled.
+	EventSource *ethereum.EventSource
+	// Verifier checks finalizing votes on chain when a confirmation depth is
+	// set, and confirmed votes when reconciliation is enabled.
+	Verifier *ethereum.Verifier
 }
 
 func newContainer(opts *options) *container {
@@ -30,21 +59,141 @@ func newContainer(opts *options) *container {
 		DB: opts.DB,
 	})
 
-	wsHub := websocket.NewHub(opts.Ctx)
+	pseudonymRepo := dao.NewPseudonymRepository(&dao.OptsPseudonymRepository{
+		DB: opts.DB,
+	})
 
+	anomalyRepo := dao.NewAnomalyRepository(&dao.OptsAnomalyRepository{
+		DB: opts.DB,
+	})
+
+	webhookRepo := dao.NewWebhookRepository(&dao.OptsWebhookRepository{
+		DB: opts.DB,
+	})
+
+	electionPairRepo := dao.NewElectionPairRepository(&dao.OptsElectionPairRepository{
+		DB: opts.DB,
+	})
+
+	certificationRepo := dao.NewCertificationRepository(&dao.OptsCertificationRepository{
+		DB: opts.DB,
+	})
+
+	chainCheckpointRepo := dao.NewChainCheckpointRepository(&dao.OptsChainCheckpointRepository{
+		DB: opts.DB,
+	})
+
+	merkleRepo := dao.NewMerkleRepository(&dao.OptsMerkleRepository{
+		DB: opts.DB,
+	})
+
+	wsHub := websocket.NewHub(opts.Ctx, opts.Cfg.LiveResults, opts.Privacy)
+
+	registeredVotersRepo := dao.NewRegisteredVotersRepository(&dao.OptsRegisteredVotersRepository{
+		DB: opts.DB,
+	})
+
+	// Webhooks receive what the public sees, so updates honour embargoes and
+	// disclosure control here as they do on the server.
 	liveResultUc := live_result.New(&live_result.Options{
 		VoteResultRepo: resultRepo,
 		Hub:            wsHub,
+		Embargo:        opts.Embargo,
+		Disclosure:     opts.Disclosure,
+		Electorate:     electorate.New(registeredVotersRepo, opts.Cfg.Registry.RegisteredVotersRefresh),
+	})
+
+	// Anomalies are stored here and pushed to admins by the server.
+	anomalyUc := anomaly.New(&anomaly.Options{
+		AnomalyRepo: anomalyRepo,
+		Cfg:         opts.Cfg.Anomaly,
+	})
+
+	webhookUc := webhook.New(&webhook.Options{
+		WebhookRepo: webhookRepo,
+		Hub:         wsHub,
+		Cfg:         opts.Cfg.Webhook,
+	})
+
+	// Events that would change a certified tally are held back and raised as
+	// anomalies, whether anomaly detection is enabled or not.
+	certificationUc := certification.New(&certification.Options{
+		CertificationRepo: certificationRepo,
+		ElectionPairRepo:  electionPairRepo,
+		VoteResultRepo:    resultRepo,
+		Anomaly:           anomalyUc,
+		Hierarchy:         opts.Regions,
+		Refresh:           opts.Cfg.Certification.Refresh,
 	})
 
 	go wsHub.Run()
+	go anomalyUc.Run(opts.Ctx)
+	go webhookUc.Run(opts.Ctx)
+
+	resultFeedUc := result_feed.New(&result_feed.Options{
+		VoteResultRepo: resultRepo,
+		Producer:       opts.Producer,
+		Embargo:        opts.Embargo,
+		Topic:          opts.Cfg.Kafka.Topics.ResultUpdated.Value,
+		Cfg:            opts.Cfg.ResultFeed,
+	})
+	go resultFeedUc.Run(opts.Ctx)
+
+	merkleUc := merkle.New(&merkle.Options{
+		MerkleRepo:       merkleRepo,
+		VoteResultRepo:   resultRepo,
+		ElectionPairRepo: electionPairRepo,
+		Embargo:          opts.Embargo,
+		Cfg:              opts.Cfg.Merkle,
+	})
+	go merkleUc.Run(opts.Ctx)
+	if opts.Cfg.Webhook.Enabled {
+		go liveResultUc.StartEmbargoWatcher(opts.Ctx)
+	}
 
 	consumerUc := consumer.New(&consumer.Options{
-		ResultRepo: resultRepo,
-		LiveResult: liveResultUc,
-		Topics:     opts.Cfg.Kafka.Topics,
+		ResultRepo:    resultRepo,
+		PseudonymRepo: pseudonymRepo,
+		LiveResult:    liveResultUc,
+		Anomaly:       anomalyUc,
+		ResultFeed:    resultFeedUc,
+		Topics:        opts.Cfg.Kafka.Topics,
+		Privacy:       opts.Privacy,
+		Certification: certificationUc,
+
+		ConfirmationDepth: opts.Cfg.Finality.ConfirmationDepth,
 	})
 
+	// Votes cast on chain go through the same consumer as those from Kafka.
+	chainIngestionUc := chain_ingestion.New(&chain_ingestion.Options{
+		Consumer:       consumerUc,
+		Certification:  certificationUc,
+		VoteResultRepo: resultRepo,
+		CheckpointRepo: chainCheckpointRepo,
+		Source:         opts.EventSource,
+		Cfg:            opts.Cfg.ChainIngestion,
+	})
+	go chainIngestionUc.Run(opts.Ctx)
+
+	finalityUc := finality.New(&finality.Options{
+		Consumer:       consumerUc,
+		VoteResultRepo: resultRepo,
+		Verifier:       opts.Verifier,
+		Certification:  certificationUc,
+		Cfg:            opts.Cfg.Finality,
+	})
+	go finalityUc.Run(opts.Ctx)
+
+	// Reconciliation runs here rather than on the server, so a single process
+	// checks each vote however many API replicas serve the flagged votes.
+	reconciliationUc := reconciliation.New(&reconciliation.Options{
+		VoteResultRepo: resultRepo,
+		Verifier:       opts.Verifier,
+		Privacy:        opts.Privacy,
+		Cfg:            opts.Cfg.Reconciliation,
+	})
+	go reconciliationUc.Run(opts.Ctx)
+
 	eventHandler := handler.New(&handler.Options{
 		RetryConfig: handler.RetryConfig{
 			MaxRetry:          opts.Cfg.Kafka.Consumer.Retry.MaxRetry,
@@ -58,10 +207,29 @@ func newContainer(opts *options) *container {
 		ServiceName: "result-service",
 	})
 
+	kafkaHealth := kafka.NewGroupHealth(opts.Cfg.Kafka.Consumer, []string{
+		opts.Cfg.Kafka.Topics.VoteSubmitData.Value,
+		opts.Cfg.Kafka.Topics.VoteProcessed.Value,
+	}, opts.Cfg.Health.KafkaMaxLag)
+
+	hubCheck := health.LoopCheck(wsHub.LastTick, 2*wsHub.HeartbeatInterval())
+
+	liveness := health.NewChecker(opts.Cfg.Health.Timeout).
+		Register("hub", hubCheck)
+
+	readiness := health.NewChecker(opts.Cfg.Health.Timeout).
+		Register("clickhouse_master", health.DatabaseMasterCheck(opts.DB)).
+		Register("clickhouse_slave", health.DatabaseSlaveCheck(opts.DB)).
+		Register("hub", hubCheck).
+		Register("kafka_consumer_group", kafkaHealth.Check)
+
 	return &container{
 		Cfg:          *opts.Cfg,
 		ConsumerUc:   consumerUc,
 		EventHandler: eventHandler,
 		WebSocketHub: wsHub,
+		KafkaHealth:  kafkaHealth,
+		Liveness:     liveness,
+		Readiness:    readiness,
 	}
 }
@@ -5,27 +5,63 @@ import (
 	_ "github.com/ClickHouse/clickhouse-go/v2"
 	"github.com/nocturna-ta/golib/database/sql"
 	"github.com/nocturna-ta/golib/ethereum"
+	"github.com/nocturna-ta/golib/log"
 	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/infrastructures/cache"
+	"github.com/nocturna-ta/result/internal/infrastructures/disclosure"
+	"github.com/nocturna-ta/result/internal/infrastructures/electorate"
+	"github.com/nocturna-ta/result/internal/infrastructures/embargo"
+	"github.com/nocturna-ta/result/internal/infrastructures/health"
+	"github.com/nocturna-ta/result/internal/infrastructures/kafka"
+	"github.com/nocturna-ta/result/internal/infrastructures/privacy"
+	"github.com/nocturna-ta/result/internal/infrastructures/registry"
 	"github.com/nocturna-ta/result/internal/infrastructures/websocket"
 	"github.com/nocturna-ta/result/internal/interfaces/dao"
 	"github.com/nocturna-ta/result/internal/usecases"
+	"github.com/nocturna-ta/result/internal/usecases/anomaly"
+	"github.com/nocturna-ta/result/internal/usecases/certification"
+	"github.com/nocturna-ta/result/internal/usecases/election_pair"
+	"github.com/nocturna-ta/result/internal/usecases/export"
 	"github.com/nocturna-ta/result/internal/usecases/live_result"
+	"github.com/nocturna-ta/result/internal/usecases/merkle"
+	"github.com/nocturna-ta/result/internal/usecases/pseudonym"
+	"github.com/nocturna-ta/result/internal/usecases/reconciliation"
+	"github.com/nocturna-ta/result/internal/usecases/region"
+	"github.com/nocturna-ta/result/internal/usecases/turnout"
 	"github.com/nocturna-ta/result/internal/usecases/vote_result"
+	"github.com/nocturna-ta/result/internal/usecases/webhook"
 	"time"
 )
 
 type container struct {
-	Cfg          config.MainConfig
-	VoteResultUc usecases.VoteResultUseCases
-	LiveResultUc usecases.LiveResultUsecases
-	WebSocketHub *websocket.Hub
+	Cfg              config.MainConfig
+	VoteResultUc     usecases.VoteResultUseCases
+	LiveResultUc     usecases.LiveResultUsecases
+	ExportUc         usecases.ExportUseCases
+	PseudonymUc      usecases.PseudonymUseCases
+	AnomalyUc        usecases.AnomalyUseCases
+	WebhookUc        usecases.WebhookUseCases
+	ElectionPairUc   usecases.ElectionPairUseCases
+	RegionUc         usecases.RegionUseCases
+	TurnoutUc        usecases.TurnoutUseCases
+	CertificationUc  usecases.CertificationUseCases
+	MerkleUc         usecases.MerkleUseCases
+	ReconciliationUc usecases.ReconciliationUseCases
+	WebSocketHub     *websocket.Hub
+	Liveness         *health.Checker
+	Readiness        *health.Checker
+	VoteEvents       *kafka.Tail
 }
 
 type options struct {
-	Cfg    *config.MainConfig
-	DB     *sql.Store
-	Client ethereum.Client
-	Ctx    context.Context
+	Cfg        *config.MainConfig
+	DB         *sql.Store
+	Client     ethereum.Client
+	Ctx        context.Context
+	Embargo    *embargo.Schedule
+	Privacy    *privacy.Policy
+	Disclosure *disclosure.Control
+	Regions    *registry.RegionHierarchy
 }
 
 func newContainer(opts *options) *container {
@@ -33,25 +69,196 @@ func newContainer(opts *options) *container {
 		DB: opts.DB,
 	})
 
+	pseudonymRepo := dao.NewPseudonymRepository(&dao.OptsPseudonymRepository{
+		DB: opts.DB,
+	})
+
+	anomalyRepo := dao.NewAnomalyRepository(&dao.OptsAnomalyRepository{
+		DB: opts.DB,
+	})
+
+	webhookRepo := dao.NewWebhookRepository(&dao.OptsWebhookRepository{
+		DB: opts.DB,
+	})
+
+	electionPairRepo := dao.NewElectionPairRepository(&dao.OptsElectionPairRepository{
+		DB: opts.DB,
+	})
+
+	registeredVotersRepo := dao.NewRegisteredVotersRepository(&dao.OptsRegisteredVotersRepository{
+		DB: opts.DB,
+	})
+
+	certificationRepo := dao.NewCertificationRepository(&dao.OptsCertificationRepository{
+		DB: opts.DB,
+	})
+
+	merkleRepo := dao.NewMerkleRepository(&dao.OptsMerkleRepository{
+		DB: opts.DB,
+	})
+
+	electorateRegister := electorate.New(registeredVotersRepo, opts.Cfg.Registry.RegisteredVotersRefresh)
+
 	voteResultUc := vote_result.New(&vote_result.Opts{
-		VoteResultRepo: voteResultRepo,
+		VoteResultRepo:    voteResultRepo,
+		Statistics:        opts.Cfg.Statistics,
+		Embargo:           opts.Embargo,
+		Privacy:           opts.Privacy,
+		Disclosure:        opts.Disclosure,
+		Electorate:        electorateRegister,
+		CertificationRepo: certificationRepo,
+		ElectionPairRepo:  electionPairRepo,
 	})
 
-	wsHub := websocket.NewHub(opts.Ctx)
+	var voteEvents *kafka.Tail
+	if opts.Cfg.Cache.Enabled {
+		cachedVoteResultUc := vote_result.NewCached(&vote_result.CachedOpts{
+			VoteResult: voteResultUc,
+			Cache:      cache.New(opts.Cfg.Cache.MaxEntries),
+			Cfg:        opts.Cfg.Cache,
+			Embargo:    opts.Embargo,
+		})
+		voteResultUc = cachedVoteResultUc
+
+		if opts.Cfg.Cache.FollowVoteEvents {
+			voteEvents = kafka.NewTail(opts.Cfg.Kafka.Consumer, []string{
+				opts.Cfg.Kafka.Topics.VoteSubmitData.Value,
+				opts.Cfg.Kafka.Topics.VoteProcessed.Value,
+			}, cachedVoteResultUc.HandleVoteEvent)
+
+			if err := voteEvents.Start(opts.Ctx); err != nil {
+				log.WithFields(log.Fields{
+					"error": err,
+				}).Error("Failed to follow vote events, cached aggregates expire by TTL only")
+				voteEvents = nil
+			}
+		}
+	}
+
+	wsHub := websocket.NewHub(opts.Ctx, opts.Cfg.LiveResults, opts.Privacy)
 
 	liveResultUc := live_result.New(&live_result.Options{
 		VoteResultRepo: voteResultRepo,
 		Hub:            wsHub,
+		Embargo:        opts.Embargo,
+		Disclosure:     opts.Disclosure,
+		Electorate:     electorateRegister,
+	})
+
+	exportUc := export.New(&export.Options{
+		VoteResultRepo: voteResultRepo,
+		Cfg:            opts.Cfg.Export,
+		Privacy:        opts.Privacy,
+	})
+
+	pseudonymUc := pseudonym.New(&pseudonym.Options{
+		PseudonymRepo: pseudonymRepo,
+	})
+
+	// Anomalies are detected and stored by the consumer; the server serves them
+	// and pushes new ones to admins.
+	anomalyUc := anomaly.New(&anomaly.Options{
+		AnomalyRepo: anomalyRepo,
+		Hub:         wsHub,
+		Cfg:         config.AnomalyConfig{AlertInterval: opts.Cfg.Anomaly.AlertInterval},
+	})
+
+	// Webhooks are delivered by the consumer; the server only manages them.
+	webhookUc := webhook.New(&webhook.Options{
+		WebhookRepo: webhookRepo,
+		Cfg:         opts.Cfg.Webhook,
+	})
+
+	electionPairUc := election_pair.New(&election_pair.Options{
+		ElectionPairRepo:  electionPairRepo,
+		VoteResultRepo:    voteResultRepo,
+		Embargo:           opts.Embargo,
+		Disclosure:        opts.Disclosure,
+		Electorate:        electorateRegister,
+		CertificationRepo: certificationRepo,
+	})
+
+	turnoutUc := turnout.New(&turnout.Options{
+		RegisteredVotersRepo: registeredVotersRepo,
+		ElectionPairRepo:     electionPairRepo,
+		VoteResultRepo:       voteResultRepo,
+		Electorate:           electorateRegister,
+		Embargo:              opts.Embargo,
+		Disclosure:           opts.Disclosure,
+	})
+
+	// Quarantined events are raised by the consumer; the server only certifies.
+	certificationUc := certification.New(&certification.Options{
+		CertificationRepo: certificationRepo,
+		ElectionPairRepo:  electionPairRepo,
+		VoteResultRepo:    voteResultRepo,
+		Disclosure:        opts.Disclosure,
+		Hierarchy:         opts.Regions,
+	})
+
+	// Commitments are published by the consumer; the server only serves proofs.
+	merkleUc := merkle.New(&merkle.Options{
+		MerkleRepo:       merkleRepo,
+		VoteResultRepo:   voteResultRepo,
+		ElectionPairRepo: electionPairRepo,
+		Embargo:          opts.Embargo,
+		Cfg:              opts.Cfg.Merkle,
+	})
+
+	// Votes are reconciled by the consumer; the server only lists flagged votes.
+	reconciliationUc := reconciliation.New(&reconciliation.Options{
+		VoteResultRepo: voteResultRepo,
+		Privacy:        opts.Privacy,
+		Cfg:            opts.Cfg.Reconciliation,
+	})
+
+	regionUc := region.New(&region.Options{
+		VoteResultRepo: voteResultRepo,
+		Hierarchy:      opts.Regions,
+		Embargo:        opts.Embargo,
+		Disclosure:     opts.Disclosure,
 	})
 
 	go wsHub.Run()
+	go exportUc.RunJobs(opts.Ctx)
+	go anomalyUc.StartAlertBroadcast(opts.Ctx)
+
+	broadcastInterval := websocket.BroadcastInterval(opts.Cfg.LiveResults)
+	go liveResultUc.StartPeriodicBroadcast(opts.Ctx, broadcastInterval)
+	go liveResultUc.StartEmbargoWatcher(opts.Ctx)
+
+	hubCheck := health.LoopCheck(wsHub.LastTick, 2*wsHub.HeartbeatInterval())
+	broadcasterCheck := health.LoopCheck(func() time.Time {
+		return liveResultUc.LastPeriodicBroadcast(opts.Ctx)
+	}, 2*broadcastInterval)
+
+	liveness := health.NewChecker(opts.Cfg.Health.Timeout).
+		Register("hub", hubCheck).
+		Register("periodic_broadcaster", broadcasterCheck)
 
-	go liveResultUc.StartPeriodicBroadcast(opts.Ctx, 30*time.Second)
+	readiness := health.NewChecker(opts.Cfg.Health.Timeout).
+		Register("clickhouse_master", health.DatabaseMasterCheck(opts.DB)).
+		Register("clickhouse_slave", health.DatabaseSlaveCheck(opts.DB)).
+		Register("hub", hubCheck).
+		Register("periodic_broadcaster", broadcasterCheck)
 
 	return &container{
-		Cfg:          *opts.Cfg,
-		VoteResultUc: voteResultUc,
-		LiveResultUc: liveResultUc,
-		WebSocketHub: wsHub,
+		Cfg:              *opts.Cfg,
+		VoteResultUc:     voteResultUc,
+		LiveResultUc:     liveResultUc,
+		ExportUc:         exportUc,
+		PseudonymUc:      pseudonymUc,
+		AnomalyUc:        anomalyUc,
+		WebhookUc:        webhookUc,
+		ElectionPairUc:   electionPairUc,
+		RegionUc:         regionUc,
+		TurnoutUc:        turnoutUc,
+		CertificationUc:  certificationUc,
+		MerkleUc:         merkleUc,
+		ReconciliationUc: reconciliationUc,
+		WebSocketHub:     wsHub,
+		Liveness:         liveness,
+		Readiness:        readiness,
+		VoteEvents:       voteEvents,
 	}
 }
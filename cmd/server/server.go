@@ -8,6 +8,12 @@ import (
 	"github.com/nocturna-ta/golib/log"
 	"github.com/nocturna-ta/result/config"
 	"github.com/nocturna-ta/result/internal/handler/api"
+	"github.com/nocturna-ta/result/internal/handler/grpc"
+	"github.com/nocturna-ta/result/internal/infrastructures/auth"
+	"github.com/nocturna-ta/result/internal/infrastructures/disclosure"
+	"github.com/nocturna-ta/result/internal/infrastructures/embargo"
+	"github.com/nocturna-ta/result/internal/infrastructures/privacy"
+	"github.com/nocturna-ta/result/internal/infrastructures/registry"
 	"github.com/spf13/cobra"
 	"os"
 	"os/signal"
@@ -46,6 +52,34 @@ func run(cmd *cobra.Command, args []string) error {
 		ConnMaxLifetime: cfg.Database.ConnMaxLifetime,
 	}, sql.DriverClickHouse)
 
+	authenticator, err := auth.New(cfg.Auth)
+	if err != nil {
+		return err
+	}
+
+	embargoSchedule, err := embargo.New(cfg.Embargo)
+	if err != nil {
+		return err
+	}
+
+	privacyPolicy, err := privacy.New(cfg.Privacy)
+	if err != nil {
+		return err
+	}
+
+	disclosureControl, err := disclosure.New(cfg.Disclosure)
+	if err != nil {
+		return err
+	}
+
+	var regionHierarchy *registry.RegionHierarchy
+	if cfg.Registry.RegionsFile != "" {
+		regionHierarchy, err = registry.LoadRegionHierarchy(cfg.Registry.RegionsFile)
+		if err != nil {
+			return err
+		}
+	}
+
 	//client, err := ethereum.GetEthereumClient(&cfg.Blockchain)
 	//if err != nil {
 	//	return err
@@ -54,28 +88,74 @@ func run(cmd *cobra.Command, args []string) error {
 	//defer client.Close()
 
 	appContainer := newContainer(&options{
-		Cfg: cfg,
-		DB:  database,
-		Ctx: ctx,
+		Cfg:        cfg,
+		DB:         database,
+		Ctx:        ctx,
+		Embargo:    embargoSchedule,
+		Privacy:    privacyPolicy,
+		Disclosure: disclosureControl,
+		Regions:    regionHierarchy,
 		//Client:    client,
 	})
 
+	if cfg.Registry.ElectionPairsFile != "" {
+		pairs, err := registry.LoadElectionPairs(cfg.Registry.ElectionPairsFile)
+		if err != nil {
+			return err
+		}
+		if err = appContainer.ElectionPairUc.ImportElectionPairs(ctx, pairs); err != nil {
+			return err
+		}
+	}
+
+	if cfg.Registry.RegisteredVotersFile != "" {
+		counts, err := registry.LoadRegisteredVoters(cfg.Registry.RegisteredVotersFile)
+		if err != nil {
+			return err
+		}
+		if err = appContainer.TurnoutUc.ImportRegisteredVoterCounts(ctx, counts); err != nil {
+			return err
+		}
+	}
+
 	server := api.New(&api.Options{
+		Cfg:            appContainer.Cfg,
+		VoteResult:     appContainer.VoteResultUc,
+		LiveResult:     appContainer.LiveResultUc,
+		Export:         appContainer.ExportUc,
+		Pseudonym:      appContainer.PseudonymUc,
+		Anomaly:        appContainer.AnomalyUc,
+		Webhook:        appContainer.WebhookUc,
+		ElectionPair:   appContainer.ElectionPairUc,
+		Region:         appContainer.RegionUc,
+		Turnout:        appContainer.TurnoutUc,
+		Certification:  appContainer.CertificationUc,
+		Merkle:         appContainer.MerkleUc,
+		Reconciliation: appContainer.ReconciliationUc,
+		WebsocketHub:   appContainer.WebSocketHub,
+		Liveness:       appContainer.Liveness,
+		Readiness:      appContainer.Readiness,
+		Auth:           authenticator,
+	})
+
+	grpcServer := grpc.New(&grpc.Options{
 		Cfg:          appContainer.Cfg,
 		VoteResult:   appContainer.VoteResultUc,
-		LiveResult:   appContainer.LiveResultUc,
 		WebsocketHub: appContainer.WebSocketHub,
+		Auth:         authenticator,
 	})
 
 	go server.Run()
+	go grpcServer.Run()
 
 	log.WithFields(log.Fields{
 		"port":         cfg.Server.Port,
+		"grpc_port":    cfg.GrpcServer.Port,
 		"websocket":    true,
 		"live_results": true,
 	}).Info("Result Service started with WebSocket support")
 
-	term := make(chan os.Signal)
+	term := make(chan os.Signal, 1)
 	signal.Notify(term, os.Interrupt, syscall.SIGTERM)
 	select {
 	case <-term:
@@ -84,9 +164,28 @@ func run(cmd *cobra.Command, args []string) error {
 	case err := <-server.ListenError():
 		log.Error("Error starting web server, exiting gracefully:", err)
 		cancel()
+	case err := <-grpcServer.ListenError():
+		log.Error("Error starting grpc server, exiting gracefully:", err)
+		cancel()
 	}
 
+	// Stopping the hub first closes every WatchResults stream, otherwise the
+	// graceful gRPC stop would wait on them until the timeout.
 	appContainer.WebSocketHub.Stop()
 
+	stopCtx, stopCancel := context.WithTimeout(context.Background(), cfg.GrpcServer.ShutdownTimeout)
+	defer stopCancel()
+	grpcServer.Stop(stopCtx)
+
+	if err := server.Stop(); err != nil {
+		log.Error("Error shutting down web server:", err)
+	}
+
+	if appContainer.VoteEvents != nil {
+		if err := appContainer.VoteEvents.Close(); err != nil {
+			log.Error("Error closing vote event reader:", err)
+		}
+	}
+
 	return nil
 }
@@ -8,12 +8,33 @@ import (
 
 type (
 	MainConfig struct {
-		Server     ServerConfig     `yaml:"Server"`
-		API        APIConfig        `yaml:"API"`
-		Database   DBConfig         `yaml:"Database"`
-		Kafka      KafkaConfig      `yaml:"Kafka"`
-		Cors       CorsConfig       `yaml:"Cors"`
-		GrpcServer GrpcServerConfig `yaml:"GrpcServer"`
+		Server         ServerConfig         `yaml:"Server"`
+		API            APIConfig            `yaml:"API"`
+		Database       DBConfig             `yaml:"Database"`
+		Kafka          KafkaConfig          `yaml:"Kafka"`
+		Cors           CorsConfig           `yaml:"Cors"`
+		GrpcServer     GrpcServerConfig     `yaml:"GrpcServer"`
+		LiveResults    LiveResultsConfig    `yaml:"LiveResults"`
+		Export         ExportConfig         `yaml:"Export"`
+		Metrics        MetricsConfig        `yaml:"Metrics"`
+		Health         HealthConfig         `yaml:"Health"`
+		Auth           AuthConfig           `yaml:"Auth"`
+		RateLimit      RateLimitConfig      `yaml:"RateLimit"`
+		Cache          CacheConfig          `yaml:"Cache"`
+		Statistics     StatisticsConfig     `yaml:"Statistics"`
+		Embargo        EmbargoConfig        `yaml:"Embargo"`
+		Privacy        PrivacyConfig        `yaml:"Privacy"`
+		Disclosure     DisclosureConfig     `yaml:"Disclosure"`
+		Anomaly        AnomalyConfig        `yaml:"Anomaly"`
+		Webhook        WebhookConfig        `yaml:"Webhook"`
+		ResultFeed     ResultFeedConfig     `yaml:"ResultFeed"`
+		Merkle         MerkleConfig         `yaml:"Merkle"`
+		Blockchain     BlockchainConfig     `yaml:"Blockchain"`
+		Reconciliation ReconciliationConfig `yaml:"Reconciliation"`
+		ChainIngestion ChainIngestionConfig `yaml:"ChainIngestion"`
+		Finality       FinalityConfig       `yaml:"Finality"`
+		Registry       RegistryConfig       `yaml:"Registry"`
+		Certification  CertificationConfig  `yaml:"Certification"`
 	}
 
 	ServerConfig struct {
@@ -46,14 +67,316 @@ type (
 	}
 
 	GrpcServerConfig struct {
-		Port uint `yaml:"Port"`
+		Port            uint          `yaml:"Port"`
+		ShutdownTimeout time.Duration `yaml:"ShutdownTimeout" default:"10s"`
+	}
+
+	LiveResultsConfig struct {
+		BroadcastInterval     time.Duration     `yaml:"BroadcastInterval" env:"LIVE_RESULTS_BROADCAST_INTERVAL" default:"30s"`
+		HeartbeatInterval     time.Duration     `yaml:"HeartbeatInterval" default:"30s"`
+		ReadDeadline          time.Duration     `yaml:"ReadDeadline" default:"60s"`
+		PingPeriod            time.Duration     `yaml:"PingPeriod" default:"54s"`
+		WriteTimeout          time.Duration     `yaml:"WriteTimeout" default:"10s"`
+		StaleThreshold        time.Duration     `yaml:"StaleThreshold" default:"2m"`
+		BroadcastBufferSize   int               `yaml:"BroadcastBufferSize" default:"256"`
+		ClientSendBufferSize  int               `yaml:"ClientSendBufferSize" default:"256"`
+		DefaultOverflowPolicy string            `yaml:"DefaultOverflowPolicy" default:"disconnect"`
+		OverflowPolicies      map[string]string `yaml:"OverflowPolicies"`
+		Admission             AdmissionConfig   `yaml:"Admission"`
+	}
+
+	AdmissionConfig struct {
+		MaxConnections      int     `yaml:"MaxConnections" env:"LIVE_RESULTS_MAX_CONNECTIONS"`
+		MaxConnectionsPerIP int     `yaml:"MaxConnectionsPerIP" env:"LIVE_RESULTS_MAX_CONNECTIONS_PER_IP"`
+		AllowedOrigins      string  `yaml:"AllowedOrigins"`
+		MessageRateLimit    float64 `yaml:"MessageRateLimit"`
+		MessageBurst        int     `yaml:"MessageBurst"`
+		MaxMessageSize      int64   `yaml:"MaxMessageSize" default:"4096"`
+	}
+
+	ExportConfig struct {
+		Directory         string        `yaml:"Directory" env:"EXPORT_DIRECTORY" default:"/tmp/result-exports"`
+		MaxConcurrentJobs int           `yaml:"MaxConcurrentJobs" default:"2"`
+		QueueSize         int           `yaml:"QueueSize" default:"16"`
+		JobTimeout        time.Duration `yaml:"JobTimeout" default:"2h"`
+		JobRetention      time.Duration `yaml:"JobRetention" default:"24h"`
+		StreamTimeout     time.Duration `yaml:"StreamTimeout" default:"30m"`
+	}
+
+	MetricsConfig struct {
+		// Port of the standalone metrics and health listener used by run-consumer;
+		// serve-http exposes /metrics and /health/* on the API port instead.
+		Port uint   `yaml:"Port" env:"METRICS_PORT" default:"9102"`
+		Path string `yaml:"Path" default:"/metrics"`
+	}
+
+	HealthConfig struct {
+		Timeout     time.Duration `yaml:"Timeout" default:"2s"`
+		KafkaMaxLag int64         `yaml:"KafkaMaxLag" default:"10000"`
+	}
+
+	AuthConfig struct {
+		// TrustIdentityHeaders keeps the X-User-Id and X-Role headers set by an
+		// authenticating gateway for requests that carry no credential of their own.
+		TrustIdentityHeaders bool           `yaml:"TrustIdentityHeaders" env:"AUTH_TRUST_IDENTITY_HEADERS"`
+		JWT                  JWTConfig      `yaml:"JWT"`
+		APIKeys              []APIKeyConfig `yaml:"APIKeys"`
+	}
+
+	JWTConfig struct {
+		Issuer    string         `yaml:"Issuer" env:"AUTH_JWT_ISSUER"`
+		Audience  string         `yaml:"Audience" env:"AUTH_JWT_AUDIENCE"`
+		RoleClaim string         `yaml:"RoleClaim" default:"role"`
+		Leeway    time.Duration  `yaml:"Leeway" default:"30s"`
+		Keys      []JWTKeyConfig `yaml:"Keys"`
+	}
+
+	JWTKeyConfig struct {
+		KeyID     string `yaml:"KeyID"`
+		Algorithm string `yaml:"Algorithm"`
+		Secret    string `yaml:"Secret"`
+		PublicKey string `yaml:"PublicKey"`
+	}
+
+	APIKeyConfig struct {
+		Name string `yaml:"Name"`
+		// KeyHash is the hex encoded SHA-256 of the key, so the plain key never lives in config.
+		KeyHash string `yaml:"KeyHash"`
+		Role    string `yaml:"Role"`
+	}
+
+	RateLimitConfig struct {
+		Enabled bool                    `yaml:"Enabled" env:"RATE_LIMIT_ENABLED"`
+		Rate    float64                 `yaml:"Rate" default:"10"`
+		Burst   int                     `yaml:"Burst" default:"20"`
+		IdleTTL time.Duration           `yaml:"IdleTTL" default:"10m"`
+		Routes  []RouteRateLimitConfig  `yaml:"Routes"`
+		Clients []ClientRateLimitConfig `yaml:"Clients"`
+	}
+
+	RouteRateLimitConfig struct {
+		Method string `yaml:"Method"`
+		// Path is relative to API.BasePath; a trailing * turns it into a prefix match.
+		Path  string  `yaml:"Path"`
+		Rate  float64 `yaml:"Rate"`
+		Burst int     `yaml:"Burst"`
+	}
+
+	ClientRateLimitConfig struct {
+		// Name is the API key name or JWT subject the quota applies to.
+		Name  string  `yaml:"Name"`
+		Rate  float64 `yaml:"Rate"`
+		Burst int     `yaml:"Burst"`
+	}
+
+	CacheConfig struct {
+		Enabled              bool          `yaml:"Enabled" env:"CACHE_ENABLED"`
+		MaxEntries           int           `yaml:"MaxEntries" default:"1024"`
+		ElectionResultsTTL   time.Duration `yaml:"ElectionResultsTTL" default:"5s"`
+		RegionStatisticsTTL  time.Duration `yaml:"RegionStatisticsTTL" default:"10s"`
+		OverallStatisticsTTL time.Duration `yaml:"OverallStatisticsTTL" default:"5s"`
+		DailyStatisticsTTL   time.Duration `yaml:"DailyStatisticsTTL" default:"1m"`
+		// FollowVoteEvents makes serve-http tail the vote topics and drop the aggregates a
+		// vote change affects, instead of relying on the TTLs alone.
+		FollowVoteEvents bool `yaml:"FollowVoteEvents" env:"CACHE_FOLLOW_VOTE_EVENTS"`
+	}
+
+	StatisticsConfig struct {
+		// DefaultTimeZone is the IANA zone time series are bucketed in when the request names none.
+		DefaultTimeZone string `yaml:"DefaultTimeZone" env:"STATISTICS_DEFAULT_TIME_ZONE" default:"Asia/Jakarta"`
+		MaxSeriesPoints int    `yaml:"MaxSeriesPoints" default:"5000"`
+	}
+
+	EmbargoConfig struct {
+		Enabled bool `yaml:"Enabled" env:"EMBARGO_ENABLED"`
+		// Schedules withhold results from public callers until polls close. An empty
+		// election pair or region matches every election pair or region.
+		Schedules []EmbargoScheduleConfig `yaml:"Schedules"`
+	}
+
+	EmbargoScheduleConfig struct {
+		ElectionPairID string `yaml:"ElectionPairID"`
+		Region         string `yaml:"Region"`
+		// LiftAt is the poll closing time in RFC3339, with the offset of the region's time zone.
+		LiftAt string `yaml:"LiftAt"`
+	}
+
+	PrivacyConfig struct {
+		// PseudonymKey is the HMAC key voter IDs are pseudonymized with. Changing it
+		// breaks the link between old and new pseudonyms of the same voter.
+		PseudonymKey string `yaml:"PseudonymKey" env:"PRIVACY_PSEUDONYM_KEY"`
+		// PseudonymizeAt is "response" to store raw voter IDs and pseudonymize them in every
+		// response, or "ingestion" to store only pseudonyms. Empty disables pseudonyms.
+		PseudonymizeAt string `yaml:"PseudonymizeAt" env:"PRIVACY_PSEUDONYMIZE_AT" default:"response"`
+		// Redact lists the vote fields hidden from each audience: public, observer or admin.
+		// Fields are voter_id, transaction_hash, error_message and region.
+		Redact map[string][]string `yaml:"Redact"`
+	}
+
+	DisclosureConfig struct {
+		Enabled bool `yaml:"Enabled" env:"DISCLOSURE_ENABLED"`
+		// Mode is "suppress" to withhold counts below the threshold, together with the
+		// counts they could be derived from, or "round" to round the counts of rows
+		// holding such a count.
+		Mode      string `yaml:"Mode" env:"DISCLOSURE_MODE" default:"suppress"`
+		Threshold uint64 `yaml:"Threshold" env:"DISCLOSURE_THRESHOLD" default:"10"`
+		// RoundTo is the rounding base in round mode, defaulting to the threshold.
+		RoundTo uint64 `yaml:"RoundTo" env:"DISCLOSURE_ROUND_TO"`
+	}
+
+	AnomalyConfig struct {
+		Enabled bool `yaml:"Enabled" env:"ANOMALY_ENABLED"`
+		// Window is the length of the buckets regional rates are compared in, against
+		// a rolling baseline of the previous BaselineWindows buckets.
+		Window          time.Duration `yaml:"Window" default:"1m"`
+		BaselineWindows int           `yaml:"BaselineWindows" default:"15"`
+		// A region spikes when a window holds at least MinSpikeVotes new votes and
+		// SpikeFactor times its baseline.
+		SpikeFactor   float64 `yaml:"SpikeFactor" default:"3"`
+		MinSpikeVotes uint64  `yaml:"MinSpikeVotes" default:"50"`
+		// Errors surge when at least MinErrorSample votes are processed in a window and
+		// the error rate reaches ErrorRateThreshold and ErrorRateFactor times its baseline.
+		ErrorRateThreshold float64 `yaml:"ErrorRateThreshold" default:"0.2"`
+		ErrorRateFactor    float64 `yaml:"ErrorRateFactor" default:"2"`
+		MinErrorSample     uint64  `yaml:"MinErrorSample" default:"20"`
+		// MaxVotesPerTransaction is the number of votes a transaction hash may carry.
+		MaxVotesPerTransaction int `yaml:"MaxVotesPerTransaction" default:"1"`
+		// MaxClockSkew is how far voted_at may be from the time the vote is ingested.
+		MaxClockSkew time.Duration `yaml:"MaxClockSkew" default:"10m"`
+		// Retention is how long voter IDs and transaction hashes are remembered.
+		Retention time.Duration `yaml:"Retention" default:"24h"`
+		QueueSize int           `yaml:"QueueSize" default:"1024"`
+		// AlertInterval is how often the server reads the anomalies stored by the
+		// consumer and pushes the new ones to admins subscribed to anomalies.
+		AlertInterval time.Duration `yaml:"AlertInterval" default:"5s"`
+	}
+
+	WebhookConfig struct {
+		// Enabled turns on delivery by the consumer; subscriptions can be managed either way.
+		Enabled bool          `yaml:"Enabled" env:"WEBHOOK_ENABLED"`
+		Timeout time.Duration `yaml:"Timeout" default:"10s"`
+		// A failed delivery is retried up to MaxAttempts in total, backing off
+		// exponentially from InitialBackoff up to MaxBackoff.
+		MaxAttempts    int           `yaml:"MaxAttempts" default:"8"`
+		InitialBackoff time.Duration `yaml:"InitialBackoff" default:"1s"`
+		MaxBackoff     time.Duration `yaml:"MaxBackoff" default:"5m"`
+		Workers        int           `yaml:"Workers" default:"4"`
+		QueueSize      int           `yaml:"QueueSize" default:"1024"`
+		// RefreshInterval is how often the consumer reloads subscriptions.
+		RefreshInterval time.Duration `yaml:"RefreshInterval" default:"30s"`
+		// DisableAfterFailures deactivates an endpoint after that many deliveries in a
+		// row gave up. Zero keeps failing endpoints active.
+		DisableAfterFailures uint64 `yaml:"DisableAfterFailures" default:"50"`
+	}
+
+	RegistryConfig struct {
+		// ElectionPairsFile is a YAML file of election pairs imported into the
+		// registry when the server starts. Pairs it lists replace those with the
+		// same ID; pairs added through the admin API are kept.
+		ElectionPairsFile string `yaml:"ElectionPairsFile"`
+		// RegionsFile is a YAML file of the province, regency, district and
+		// polling station hierarchy results are rolled up along. Rollups are
+		// unavailable without it.
+		RegionsFile string `yaml:"RegionsFile"`
+		// RegisteredVotersFile is a YAML file of registered voter counts by
+		// election and region imported when the server starts, for turnout.
+		RegisteredVotersFile string `yaml:"RegisteredVotersFile"`
+		// RegisteredVotersRefresh is how long registered voter counts are kept in
+		// memory before they are read again, so imports reach every process.
+		RegisteredVotersRefresh time.Duration `yaml:"RegisteredVotersRefresh" default:"1m"`
+	}
+
+	CertificationConfig struct {
+		// Refresh is how long certifications are kept in memory by the guard
+		// holding back changes to certified tallies, so a certification made
+		// by the server reaches the consumer within that time.
+		Refresh time.Duration `yaml:"Refresh" default:"5s"`
+	}
+
+	ResultFeedConfig struct {
+		// Enabled makes the consumer publish ResultUpdated events to the
+		// ResultUpdated topic whenever the tally of an election pair in a region changes.
+		Enabled bool `yaml:"Enabled"`
+		// FlushInterval coalesces changes: each changed tally is read and published
+		// at most once per interval.
+		FlushInterval time.Duration `yaml:"FlushInterval" default:"1s"`
+		// Settle keeps re-reading a changed tally for that many flushes, so
+		// updates ClickHouse applies asynchronously still reach the topic.
+		Settle int `yaml:"Settle" default:"2"`
+		// Partitions and ReplicationFactor are used to create the compacted topic
+		// when it does not exist yet.
+		Partitions        int32 `yaml:"Partitions" default:"6"`
+		ReplicationFactor int16 `yaml:"ReplicationFactor" default:"1"`
+	}
+
+	MerkleConfig struct {
+		// Enabled makes the consumer publish a Merkle root over the confirmed
+		// votes of every election and region, for inclusion proofs.
+		Enabled bool `yaml:"Enabled" env:"MERKLE_ENABLED"`
+		// Interval is how often the trees are rebuilt. A root is only published
+		// when the confirmed votes under it changed.
+		Interval time.Duration `yaml:"Interval" default:"5m"`
+	}
+
+	BlockchainConfig struct {
+		// GanacheURL is the JSON-RPC endpoint of the node votes are recorded on.
+		GanacheURL string `yaml:"GanacheURL" env:"BLOCKCHAIN_GANACHE_URL"`
+	}
+
+	ReconciliationConfig struct {
+		// Enabled makes the consumer check the transactions of confirmed votes
+		// against the chain at Blockchain.GanacheURL.
+		Enabled  bool          `yaml:"Enabled" env:"RECONCILIATION_ENABLED"`
+		Interval time.Duration `yaml:"Interval" default:"1m"`
+		// BatchSize is the number of votes checked per interval, least recently
+		// checked first.
+		BatchSize int `yaml:"BatchSize" default:"500"`
+		// RecheckDepth keeps verified votes under check until their block is that
+		// many blocks deep, so transactions dropped by a reorg are caught.
+		RecheckDepth uint64 `yaml:"RecheckDepth" default:"12"`
+	}
+
+	ChainIngestionConfig struct {
+		// Enabled makes the consumer ingest votes from the VoteCast events of the
+		// voting contract at Blockchain.GanacheURL, alongside Kafka.
+		Enabled         bool   `yaml:"Enabled" env:"CHAIN_INGESTION_ENABLED"`
+		ContractAddress string `yaml:"ContractAddress" env:"CHAIN_INGESTION_CONTRACT_ADDRESS"`
+		// StartBlock is the first block read when none was processed yet.
+		StartBlock uint64        `yaml:"StartBlock"`
+		Interval   time.Duration `yaml:"Interval" default:"15s"`
+		// BlockRange caps the blocks read by one log query.
+		BlockRange uint64 `yaml:"BlockRange" default:"1000"`
+		// MaxReorgDepth is how many blocks back a reorganization is looked for.
+		// Votes in blocks it replaced are rolled back to pending.
+		MaxReorgDepth uint64 `yaml:"MaxReorgDepth" default:"64"`
+	}
+
+	FinalityConfig struct {
+		// ConfirmationDepth is the number of blocks to be mined on top of the
+		// block of a vote's transaction before the vote is final. Until then a
+		// confirmed vote is held as finalizing, and the consumer checks it on the
+		// chain at Blockchain.GanacheURL. Votes are final once confirmed when 0.
+		ConfirmationDepth uint64        `yaml:"ConfirmationDepth" env:"FINALITY_CONFIRMATION_DEPTH"`
+		Interval          time.Duration `yaml:"Interval" default:"15s"`
+		// BatchSize is the number of finalizing votes checked per interval, least
+		// recently checked first.
+		BatchSize int `yaml:"BatchSize" default:"500"`
 	}
 
 	KafkaConfig struct {
 		Consumer KafkaConsumerConfig `yaml:"Consumer"`
+		Producer KafkaProducerConfig `yaml:"Producer"`
 		Topics   KafkaTopics         `yaml:"Topics"`
 	}
 
+	KafkaProducerConfig struct {
+		// Brokers and ClusterVersion default to the consumer's.
+		Brokers        []string      `yaml:"Brokers"`
+		ClusterVersion string        `yaml:"ClusterVersion"`
+		Timeout        time.Duration `yaml:"Timeout" default:"10s"`
+		MaxRetry       int           `yaml:"MaxRetry" default:"5"`
+	}
+
 	KafkaConsumerConfig struct {
 		Brokers        []string    `yaml:"Brokers"`
 		ClusterVersion string      `yaml:"ClusterVersion"`
@@ -76,6 +399,7 @@ type (
 		VoteSubmitData KafkaTopicConfig `yaml:"VoteSubmitData"`
 		VoteProcessed  KafkaTopicConfig `yaml:"VoteProcessed"`
 		VoteDLQ        KafkaTopicConfig `yaml:"VoteDLQ"`
+		ResultUpdated  KafkaTopicConfig `yaml:"ResultUpdated"`
 	}
 
 	KafkaTopicConfig struct {
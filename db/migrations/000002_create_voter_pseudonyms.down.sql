@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS voter_pseudonym_lookups;
+DROP TABLE IF EXISTS voter_pseudonyms;
@@ -0,0 +1,23 @@
+-- Voter IDs behind pseudonyms, written when votes are pseudonymized.
+CREATE TABLE IF NOT EXISTS voter_pseudonyms
+(
+    pseudonym  String,
+    voter_id   String,
+    created_at DateTime64(3)
+)
+ENGINE = MergeTree
+ORDER BY pseudonym;
+
+-- Audit log of admin lookups of the voter behind a pseudonym.
+CREATE TABLE IF NOT EXISTS voter_pseudonym_lookups
+(
+    id           String,
+    pseudonym    String,
+    user_id      String,
+    user_name    String,
+    reason       String,
+    found        Bool,
+    looked_up_at DateTime64(3)
+)
+ENGINE = MergeTree
+ORDER BY (looked_up_at, id);
@@ -0,0 +1,17 @@
+-- Anomalies detected in the vote stream.
+CREATE TABLE IF NOT EXISTS vote_anomalies
+(
+    id               String,
+    kind             LowCardinality(String),
+    election_pair_id String,
+    region           String,
+    vote_id          String,
+    voter_id         String,
+    transaction_hash String,
+    value            Float64,
+    baseline         Float64,
+    description      String,
+    detected_at      DateTime64(3)
+)
+ENGINE = MergeTree
+ORDER BY (detected_at, id);
@@ -0,0 +1,37 @@
+-- Outbound webhook subscriptions. Updated and deleted by mutations.
+CREATE TABLE IF NOT EXISTS webhook_subscriptions
+(
+    id                   String,
+    url                  String,
+    secret               String,
+    event_types          Array(String),
+    election_pair_id     String,
+    region               String,
+    active               Bool,
+    consecutive_failures UInt64,
+    last_error           String,
+    last_success_at      Nullable(DateTime64(3)),
+    last_failure_at      Nullable(DateTime64(3)),
+    created_at           DateTime64(3),
+    updated_at           DateTime64(3)
+)
+ENGINE = MergeTree
+ORDER BY id;
+
+-- Log of every webhook delivery attempt.
+CREATE TABLE IF NOT EXISTS webhook_deliveries
+(
+    id              String,
+    delivery_id     String,
+    subscription_id String,
+    event_type      LowCardinality(String),
+    payload         String,
+    attempt         Int64,
+    status          LowCardinality(String),
+    response_code   Int64,
+    error           String,
+    duration_ms     Int64,
+    attempted_at    DateTime64(3)
+)
+ENGINE = MergeTree
+ORDER BY (subscription_id, attempted_at, id);
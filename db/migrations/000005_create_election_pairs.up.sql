@@ -0,0 +1,15 @@
+-- Registry of the candidate pairs of each election. Updated and deleted by
+-- mutations.
+CREATE TABLE IF NOT EXISTS election_pairs
+(
+    id                String,
+    election_id       String,
+    pair_number       Int64,
+    candidate_name    String,
+    running_mate_name String,
+    party             String,
+    created_at        DateTime64(3),
+    updated_at        DateTime64(3)
+)
+ENGINE = MergeTree
+ORDER BY id;
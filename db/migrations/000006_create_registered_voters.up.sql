@@ -0,0 +1,11 @@
+-- Registered voter counts per election and region. Counts are appended, and
+-- the latest row of each election and region is the one in effect.
+CREATE TABLE IF NOT EXISTS registered_voters
+(
+    election_id       String,
+    region            String,
+    registered_voters UInt64,
+    updated_at        DateTime64(3)
+)
+ENGINE = MergeTree
+ORDER BY (election_id, region, updated_at);
@@ -0,0 +1,31 @@
+-- Certified result snapshots, one per scope.
+CREATE TABLE IF NOT EXISTS certifications
+(
+    id             String,
+    scope          LowCardinality(String),
+    scope_id       String,
+    snapshot       String,
+    content_hash   String,
+    certified_by   String,
+    certifier_name String,
+    certified_at   DateTime64(3)
+)
+ENGINE = MergeTree
+ORDER BY (scope, scope_id, certified_at);
+
+-- Events held back because they would change a certified tally.
+CREATE TABLE IF NOT EXISTS quarantined_events
+(
+    id               String,
+    certification_id String,
+    topic            String,
+    message_key      String,
+    vote_id          String,
+    election_pair_id String,
+    region           String,
+    reason           String,
+    payload          String,
+    quarantined_at   DateTime64(3)
+)
+ENGINE = MergeTree
+ORDER BY (quarantined_at, id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS merkle_leaves;
+DROP TABLE IF EXISTS merkle_commitments;
@@ -0,0 +1,27 @@
+-- Published Merkle roots over the confirmed votes of an election and region.
+CREATE TABLE IF NOT EXISTS merkle_commitments
+(
+    id           String,
+    election_id  String,
+    region       String,
+    root         String,
+    leaf_count   UInt64,
+    published_at DateTime64(3)
+)
+ENGINE = MergeTree
+ORDER BY (election_id, region, published_at);
+
+-- Leaves of each commitment, from which vote inclusion proofs are built.
+CREATE TABLE IF NOT EXISTS merkle_leaves
+(
+    commitment_id    String,
+    leaf_index       UInt64,
+    vote_id          String,
+    election_pair_id String,
+    region           String,
+    transaction_hash String,
+    leaf_hash        String,
+    published_at     DateTime64(3)
+)
+ENGINE = MergeTree
+ORDER BY (commitment_id, leaf_index);
@@ -0,0 +1,6 @@
+ALTER TABLE vote_results
+    DROP COLUMN IF EXISTS chain_checked_at,
+    DROP COLUMN IF EXISTS block_time,
+    DROP COLUMN IF EXISTS block_hash,
+    DROP COLUMN IF EXISTS block_number,
+    DROP COLUMN IF EXISTS chain_status;
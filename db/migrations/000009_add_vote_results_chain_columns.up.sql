@@ -0,0 +1,8 @@
+-- Where the transaction of each vote was last found on chain, recorded by
+-- reconciliation and chain ingestion.
+ALTER TABLE vote_results
+    ADD COLUMN IF NOT EXISTS chain_status     LowCardinality(String) DEFAULT '',
+    ADD COLUMN IF NOT EXISTS block_number     Nullable(UInt64),
+    ADD COLUMN IF NOT EXISTS block_hash       String DEFAULT '',
+    ADD COLUMN IF NOT EXISTS block_time       Nullable(DateTime64(3)),
+    ADD COLUMN IF NOT EXISTS chain_checked_at Nullable(DateTime64(3));
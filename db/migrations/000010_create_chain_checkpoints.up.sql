@@ -0,0 +1,11 @@
+-- Blocks up to which the events of a contract were ingested. Checkpoints above
+-- the fork of a reorganization are deleted by mutations.
+CREATE TABLE IF NOT EXISTS chain_checkpoints
+(
+    contract     String,
+    block_number UInt64,
+    block_hash   String,
+    created_at   DateTime64(3)
+)
+ENGINE = MergeTree
+ORDER BY (contract, block_number, created_at);
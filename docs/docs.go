@@ -66,43 +66,19 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/live/broadcast": {
-            "post": {
-                "description": "Manually trigger a broadcast of current results (for testing/admin purposes)",
-                "consumes": [
-                    "application/json"
-                ],
+        "/health/live": {
+            "get": {
+                "description": "Check that the hub run loop and the periodic broadcaster are making progress. Responds 503 when any of them is stalled.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Live Results"
-                ],
-                "summary": "Trigger manual broadcast",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Election Pair ID to broadcast",
-                        "name": "election_pair_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Region to broadcast",
-                        "name": "region",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "default": "all",
-                        "description": "Broadcast type: vote, election, region, statistics, all",
-                        "name": "type",
-                        "in": "query"
-                    }
+                    "Health"
                 ],
+                "summary": "Liveness probe",
                 "responses": {
                     "200": {
-                        "description": "Broadcast triggered",
+                        "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
@@ -112,8 +88,25 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object",
-                                            "additionalProperties": true
+                                            "$ref": "#/definitions/health.Report"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/health.Report"
                                         }
                                     }
                                 }
@@ -123,22 +116,19 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/live/status": {
+        "/health/ready": {
             "get": {
-                "description": "Get status information about the live results WebSocket service",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Check ClickHouse primary and replica, the hub run loop and the periodic broadcaster, with per-component status and latency. Responds 503 when any of them is down.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Live Results"
+                    "Health"
                 ],
-                "summary": "Get live results WebSocket status",
+                "summary": "Readiness probe",
                 "responses": {
                     "200": {
-                        "description": "WebSocket status",
+                        "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
@@ -148,8 +138,25 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object",
-                                            "additionalProperties": true
+                                            "$ref": "#/definitions/health.Report"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/health.Report"
                                         }
                                     }
                                 }
@@ -159,9 +166,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/results/elections/{election_pair_id}": {
-            "get": {
-                "description": "Get detailed election results for a specific election pair",
+        "/v1/admin/certifications": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Freeze the result of a region, or of an election across every region, into an immutable snapshot with its SHA-256 content hash and the certifier. Certified results are served from the snapshot, and later events that would change them are quarantined.",
                 "consumes": [
                     "application/json"
                 ],
@@ -169,21 +184,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Results"
+                    "Admin"
                 ],
-                "summary": "Get election results by election pair ID",
+                "summary": "Certify results",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Election Pair ID",
-                        "name": "election_pair_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Scope to certify",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CertificationRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Election results data",
+                        "description": "Certification",
                         "schema": {
                             "allOf": [
                                 {
@@ -193,19 +210,45 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.ElectionVoteResultResponse"
+                                            "$ref": "#/definitions/response.CertificationResponse"
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "400": {
+                        "description": "Invalid scope",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No votes or election pairs to certify",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Already certified",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
                     }
                 }
             }
         },
-        "/v1/results/elections/{election_pair_id}/count": {
-            "get": {
-                "description": "Count the number of votes for a specific election pair",
+        "/v1/admin/election-pairs": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Register an election pair. Its ID is the election_pair_id votes carry, and its pair number is unique within the election.",
                 "consumes": [
                     "application/json"
                 ],
@@ -213,21 +256,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Results"
+                    "Admin"
                 ],
-                "summary": "Count votes by election pair ID",
+                "summary": "Register election pair",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Election Pair ID",
-                        "name": "election_pair_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Election pair",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ElectionPairRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Count of votes",
+                        "description": "Registered election pair",
                         "schema": {
                             "allOf": [
                                 {
@@ -237,20 +282,39 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object",
-                                            "additionalProperties": true
+                                            "$ref": "#/definitions/response.ElectionPairResponse"
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "400": {
+                        "description": "Invalid election pair",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "ID or pair number already registered",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
                     }
                 }
             }
         },
-        "/v1/results/elections/{election_pair_id}/votes": {
-            "get": {
-                "description": "Get all vote results for a specific election pair",
+        "/v1/admin/election-pairs/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replace the election, pair number, candidates and party of a registered election pair. The ID in the body is ignored.",
                 "consumes": [
                     "application/json"
                 ],
@@ -258,35 +322,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Results"
+                    "Admin"
                 ],
-                "summary": "Get vote results by election pair ID",
+                "summary": "Update election pair",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "Election Pair ID",
-                        "name": "election_pair_id",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 50,
-                        "description": "Limit the number of results",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Offset for pagination",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "Election pair",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ElectionPairRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of vote results",
+                        "description": "Updated election pair",
                         "schema": {
                             "allOf": [
                                 {
@@ -296,22 +355,43 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/response.VoteResultResponse"
-                                            }
+                                            "$ref": "#/definitions/response.ElectionPairResponse"
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "400": {
+                        "description": "Invalid election pair",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Election pair not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Pair number already registered",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
                     }
                 }
-            }
-        },
-        "/v1/results/regions": {
-            "get": {
-                "description": "Get statistical data for all regions",
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove an election pair from the registry. Its votes are kept but no longer ranked.",
                 "consumes": [
                     "application/json"
                 ],
@@ -319,37 +399,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Results"
+                    "Admin"
+                ],
+                "summary": "Delete election pair",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Election Pair ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get region statistics",
                 "responses": {
                     "200": {
-                        "description": "List of region statistics",
+                        "description": "Election pair deleted",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/controller.jsonResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/response.RegionVoteResultResponse"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Election pair not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/results/regions/{region}": {
-            "get": {
-                "description": "Get detailed region results for a specific region",
+        "/v1/admin/pseudonyms/lookup": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Resolve a voter pseudonym to the voter ID. Every lookup is recorded in the audit log with the caller and the reason, whether or not the pseudonym is found.",
                 "consumes": [
                     "application/json"
                 ],
@@ -357,21 +445,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Results"
+                    "Admin"
                 ],
-                "summary": "Get region results by region",
+                "summary": "Look up voter pseudonym",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Region",
-                        "name": "region",
-                        "in": "path",
-                        "required": true
+                        "description": "Pseudonym and the reason for the lookup",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.PseudonymLookupRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Region results data",
+                        "description": "Voter ID behind the pseudonym",
                         "schema": {
                             "allOf": [
                                 {
@@ -381,19 +471,33 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.RegionVoteResultResponse"
+                                            "$ref": "#/definitions/response.PseudonymLookupResponse"
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "404": {
+                        "description": "Pseudonym not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
                     }
                 }
             }
         },
-        "/v1/results/regions/{region}/count": {
+        "/v1/admin/quarantine": {
             "get": {
-                "description": "Count the number of votes in a specific region",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the vote events held back because they would change a certified tally, newest first",
                 "consumes": [
                     "application/json"
                 ],
@@ -401,66 +505,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Results"
+                    "Admin"
                 ],
-                "summary": "Count votes by region",
+                "summary": "List quarantined events",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Region",
-                        "name": "region",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Count of votes",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/controller.jsonResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object",
-                                            "additionalProperties": true
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    }
-                }
-            }
-        },
-        "/v1/results/regions/{region}/elections": {
-            "get": {
-                "description": "Get detailed election results for a specific region",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Results"
-                ],
-                "summary": "Get election results by region",
-                "parameters": [
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
                     {
-                        "type": "string",
-                        "description": "Region",
-                        "name": "region",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of election results",
+                        "description": "List of quarantined events",
                         "schema": {
                             "allOf": [
                                 {
@@ -472,20 +538,34 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/response.ElectionVoteResultResponse"
+                                                "$ref": "#/definitions/response.QuarantinedEventResponse"
                                             }
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "400": {
+                        "description": "Invalid limit or offset",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
                     }
                 }
             }
         },
-        "/v1/results/regions/{region}/votes": {
+        "/v1/admin/reconciliation": {
             "get": {
-                "description": "Get all vote results for a specific region",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List confirmed votes whose transaction is missing, failed or reverted on chain, with the block they were last seen in",
                 "consumes": [
                     "application/json"
                 ],
@@ -493,35 +573,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Results"
+                    "Admin"
                 ],
-                "summary": "Get vote results by region",
+                "summary": "List votes flagged by reconciliation",
                 "parameters": [
                     {
+                        "enum": [
+                            "missing",
+                            "failed",
+                            "reverted"
+                        ],
                         "type": "string",
-                        "description": "Region",
-                        "name": "region",
-                        "in": "path",
-                        "required": true
+                        "description": "Chain status",
+                        "name": "status",
+                        "in": "query"
                     },
                     {
                         "type": "integer",
                         "default": 50,
-                        "description": "Limit the number of results",
+                        "description": "Limit",
                         "name": "limit",
                         "in": "query"
                     },
                     {
                         "type": "integer",
                         "default": 0,
-                        "description": "Offset for pagination",
+                        "description": "Offset",
                         "name": "offset",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of vote results",
+                        "description": "List of flagged votes",
                         "schema": {
                             "allOf": [
                                 {
@@ -540,13 +624,27 @@ const docTemplate = `{
                                 }
                             ]
                         }
+                    },
+                    "400": {
+                        "description": "Invalid status, limit or offset",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
                     }
                 }
             }
         },
-        "/v1/results/statistics": {
-            "get": {
-                "description": "Get overall vote statistics including total votes, valid votes, and invalid votes",
+        "/v1/admin/registered-voters": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Import registered voter counts by election and region. A count replaces the one of the same election and region; a count without election applies to every election held in the region.",
                 "consumes": [
                     "application/json"
                 ],
@@ -554,12 +652,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Results"
+                    "Admin"
+                ],
+                "summary": "Import registered voters",
+                "parameters": [
+                    {
+                        "description": "Registered voter counts",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.RegisteredVotersImportRequest"
+                        }
+                    }
                 ],
-                "summary": "Get overall vote statistics",
                 "responses": {
                     "200": {
-                        "description": "Overall vote statistics data",
+                        "description": "Imported counts",
                         "schema": {
                             "allOf": [
                                 {
@@ -569,19 +678,31 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.VoteStatisticsResponse"
+                                            "$ref": "#/definitions/response.RegisteredVotersImportResponse"
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "400": {
+                        "description": "Invalid registered voter counts",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
                     }
                 }
-            }
-        },
-        "/v1/results/statistics/daily": {
-            "get": {
-                "description": "Get daily vote statistics for a specified date range",
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove the registered voter count of an election in a region, or the region-wide count when no election is given",
                 "consumes": [
                     "application/json"
                 ],
@@ -589,28 +710,70 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Results"
+                    "Admin"
                 ],
-                "summary": "Get daily vote statistics",
+                "summary": "Delete registered voters",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Start date in YYYY-MM-DD format",
-                        "name": "start_date",
-                        "in": "query",
-                        "required": true
+                        "description": "Election ID",
+                        "name": "election_id",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "End date in YYYY-MM-DD format",
-                        "name": "end_date",
+                        "description": "Region",
+                        "name": "region",
                         "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of daily vote statistics",
+                        "description": "Registered voters deleted",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Region is required",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Registered voters not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/admin/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the webhook subscriptions with their delivery health",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "List webhook subscriptions",
+                "responses": {
+                    "200": {
+                        "description": "List of subscriptions",
                         "schema": {
                             "allOf": [
                                 {
@@ -622,7 +785,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/response.VoteStatisticsResponse"
+                                                "$ref": "#/definitions/response.WebhookSubscriptionResponse"
                                             }
                                         }
                                     }
@@ -631,11 +794,17 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/results/votes": {
-            "get": {
-                "description": "Get all vote results with a specific status",
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Register an endpoint to receive live result events as signed POST requests. Each request carries X-Webhook-Id, X-Webhook-Event, X-Webhook-Timestamp and X-Webhook-Signature, the hex HMAC-SHA256 of \"timestamp.body\" keyed with the secret. A secret is generated when none is given and is only returned here.",
                 "consumes": [
                     "application/json"
                 ],
@@ -643,35 +812,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Results"
+                    "Admin"
                 ],
-                "summary": "Get vote results by status",
+                "summary": "Create webhook subscription",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Vote Result Status",
-                        "name": "status",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "default": 50,
-                        "description": "Limit the number of results",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Offset for pagination",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "Endpoint, secret and event filters",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.WebhookSubscriptionRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of vote results",
+                        "description": "Created subscription, with its secret",
                         "schema": {
                             "allOf": [
                                 {
@@ -681,22 +838,33 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/response.VoteResultResponse"
-                                            }
+                                            "$ref": "#/definitions/response.WebhookSubscriptionResponse"
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "400": {
+                        "description": "Invalid subscription",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
                     }
                 }
             }
         },
-        "/v1/results/votes/count": {
-            "get": {
-                "description": "Count the number of votes with a specific status",
+        "/v1/admin/webhooks/deliveries/{delivery_id}/replay": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Send the payload of a past delivery again, once, to the current endpoint of its subscription. The attempt is added to the delivery log and returned, failed or not.",
                 "consumes": [
                     "application/json"
                 ],
@@ -704,21 +872,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Results"
+                    "Admin"
                 ],
-                "summary": "Count votes by status",
+                "summary": "Replay webhook delivery",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Vote Result Status",
-                        "name": "status",
-                        "in": "query",
+                        "description": "Delivery ID, as sent in X-Webhook-Id",
+                        "name": "delivery_id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Count of votes",
+                        "description": "Replayed attempt",
                         "schema": {
                             "allOf": [
                                 {
@@ -728,20 +896,33 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object",
-                                            "additionalProperties": true
+                                            "$ref": "#/definitions/response.WebhookDeliveryResponse"
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "404": {
+                        "description": "Delivery not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
                     }
                 }
             }
         },
-        "/v1/results/votes/{id}": {
+        "/v1/admin/webhooks/{id}": {
             "get": {
-                "description": "Get a specific vote result by its ID",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a webhook subscription with its delivery health",
                 "consumes": [
                     "application/json"
                 ],
@@ -749,13 +930,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Results"
+                    "Admin"
                 ],
-                "summary": "Get vote result by ID",
+                "summary": "Get webhook subscription",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Vote Result ID",
+                        "description": "Subscription ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -763,7 +944,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Vote result data",
+                        "description": "Subscription",
                         "schema": {
                             "allOf": [
                                 {
@@ -773,56 +954,3081 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.VoteResultResponse"
+                                            "$ref": "#/definitions/response.WebhookSubscriptionResponse"
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "404": {
+                        "description": "Subscription not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "controller.errorResponse": {
-            "type": "object",
-            "properties": {
-                "error_code": {
-                    "type": "integer"
-                },
-                "error_message": {
-                    "type": "string"
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replace the endpoint and filters of a webhook subscription. The secret is kept when omitted. Reactivating a subscription resets its failure count.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Update webhook subscription",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Endpoint, secret and event filters",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.WebhookSubscriptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated subscription",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.WebhookSubscriptionResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid subscription",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Subscription not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a webhook subscription. Its delivery log is kept.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Delete webhook subscription",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Subscription deleted",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Subscription not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/admin/webhooks/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the delivery attempts of a webhook subscription, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "List webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of delivery attempts",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.WebhookDeliveryResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Subscription not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/certifications": {
+            "get": {
+                "description": "List the certified regions and elections, oldest first. The snapshot is left out for public callers while disclosure control is enabled.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certifications"
+                ],
+                "summary": "List certifications",
+                "responses": {
+                    "200": {
+                        "description": "List of certifications",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.CertificationResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/certifications/{id}": {
+            "get": {
+                "description": "Get a certification with its content hash. The snapshot is left out for public callers while disclosure control is enabled.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certifications"
+                ],
+                "summary": "Get certification",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Certification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Certification",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.CertificationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Certification not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/election-pairs": {
+            "get": {
+                "description": "List the registered election pairs by election and pair number",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Election Pairs"
+                ],
+                "summary": "List election pairs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Election ID",
+                        "name": "election_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of election pairs",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.ElectionPairResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/election-pairs/{id}": {
+            "get": {
+                "description": "Get a registered election pair by the election_pair_id its votes carry",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Election Pairs"
+                ],
+                "summary": "Get election pair",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Election Pair ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Election pair",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.ElectionPairResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Election pair not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/live/broadcast": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Manually trigger a broadcast of current results. Requires the admin role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Live Results"
+                ],
+                "summary": "Trigger manual broadcast",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Election Pair ID to broadcast",
+                        "name": "election_pair_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Region to broadcast",
+                        "name": "region",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "all",
+                        "description": "Broadcast type: vote, election, region, statistics, all",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Broadcast triggered",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "additionalProperties": true
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/live/status": {
+            "get": {
+                "description": "Get status information about the live results WebSocket service",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Live Results"
+                ],
+                "summary": "Get live results WebSocket status",
+                "responses": {
+                    "200": {
+                        "description": "WebSocket status",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "additionalProperties": true
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/registered-voters": {
+            "get": {
+                "description": "List the registered voter counts in effect by election and region. With an election, its counts and the region-wide counts are listed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registered Voters"
+                ],
+                "summary": "List registered voters",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Election ID",
+                        "name": "election_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Registered voter counts",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.RegisteredVotersResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/anomalies": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the suspicious patterns flagged in the vote stream, newest first: regional spikes, error rate surges, shared transaction hashes, voters with several vote IDs and votes far from their ingestion time",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "List vote stream anomalies",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Anomaly kind: region_spike, error_rate_surge, shared_transaction_hash, duplicate_voter, clock_skew, certified_tally_change",
+                        "name": "kind",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Election Pair ID",
+                        "name": "election_pair_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Region",
+                        "name": "region",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Detected at or after, in RFC3339 format",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Detected at or before, in RFC3339 format",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of anomalies",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.AnomalyResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/commitments": {
+            "get": {
+                "description": "List the latest published Merkle root over the confirmed votes of every election and region. Commitments under embargo are left out.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "List Merkle commitments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Election ID",
+                        "name": "election_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Latest commitments",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.MerkleCommitmentResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/commitments/{id}": {
+            "get": {
+                "description": "Get a published Merkle root, including roots since superseded",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "Get Merkle commitment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Commitment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Commitment",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.MerkleCommitmentResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Commitment not found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    },
+                    "451": {
+                        "description": "Results embargoed until polls close",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/elections/{election_pair_id}": {
+            "get": {
+                "description": "Get detailed election results for a specific election pair",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "Get election results by election pair ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Election Pair ID",
+                        "name": "election_pair_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a previous response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Election results data",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.ElectionVoteResultResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response body"
+                            }
+                        }
+                    },
+                    "451": {
+                        "description": "Results embargoed until polls close",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/elections/{election_pair_id}/count": {
+            "get": {
+                "description": "Count the number of votes for a specific election pair",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "Count votes by election pair ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Election Pair ID",
+                        "name": "election_pair_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Count of votes",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "additionalProperties": true
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "451": {
+                        "description": "Results embargoed until polls close",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/elections/{election_pair_id}/votes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get all vote results for a specific election pair",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "Get vote results by election pair ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Election Pair ID",
+                        "name": "election_pair_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Limit the number of results",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of vote results",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.VoteResultResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Stream every vote result matching the filter as CSV, newline-delimited JSON or Parquet. Rows are streamed straight from the database, so there is no row limit.",
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson",
+                    "application/vnd.apache.parquet"
+                ],
+                "tags": [
+                    "Export"
+                ],
+                "summary": "Export vote results",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "csv",
+                        "description": "Export format: csv, ndjson, parquet",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Election Pair ID",
+                        "name": "election_pair_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Region",
+                        "name": "region",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Vote Result Status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date in YYYY-MM-DD or RFC3339 format",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date in YYYY-MM-DD or RFC3339 format",
+                        "name": "end_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Exported vote results",
+                        "schema": {
+                            "type": "file"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/export/jobs": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Queue an asynchronous export of every vote result matching the filter. Poll the job and download the file once it is completed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Export"
+                ],
+                "summary": "Create export job",
+                "parameters": [
+                    {
+                        "description": "Export format and filter",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ExportRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Export job queued",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.ExportJobResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/export/jobs/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the status and progress of an export job. Only the user who created the job sees it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Export"
+                ],
+                "summary": "Get export job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Export Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Export job",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.ExportJobResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/export/jobs/{id}/download": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Download the file produced by a completed export job. Only the user who created the job can download it.",
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson",
+                    "application/vnd.apache.parquet"
+                ],
+                "tags": [
+                    "Export"
+                ],
+                "summary": "Download export job file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Export Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Exported vote results",
+                        "schema": {
+                            "type": "file"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/hierarchy": {
+            "get": {
+                "description": "Get the national vote count rolled up from the provinces of the region hierarchy, and the regions votes carry that match no polling station",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "Get region hierarchy results",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ETag of a previous response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "National count by province",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.RegionHierarchyResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response body"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Region hierarchy not configured",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    },
+                    "451": {
+                        "description": "Results embargoed until polls close",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/regions": {
+            "get": {
+                "description": "Get statistical data for all regions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "Get region statistics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ETag of a previous response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of region statistics",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.RegionVoteResultResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response body"
+                            }
+                        }
+                    },
+                    "451": {
+                        "description": "Results embargoed until polls close",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/regions/{code}/children": {
+            "get": {
+                "description": "Drill down the region hierarchy: the vote count of a province, regency, district or polling station and of each region one level below it, which add up to it before disclosure control",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "Get region children results",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Region code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a previous response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Region count by child region",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.RegionChildrenResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response body"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Region not found in the region hierarchy",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    },
+                    "451": {
+                        "description": "Results embargoed until polls close",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/regions/{region}": {
+            "get": {
+                "description": "Get detailed region results for a specific region",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "Get region results by region",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Region",
+                        "name": "region",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Region results data",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.RegionVoteResultResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "451": {
+                        "description": "Results embargoed until polls close",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/regions/{region}/count": {
+            "get": {
+                "description": "Count the number of votes in a specific region",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "Count votes by region",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Region",
+                        "name": "region",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Count of votes",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "additionalProperties": true
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "451": {
+                        "description": "Results embargoed until polls close",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/regions/{region}/elections": {
+            "get": {
+                "description": "Get detailed election results for a specific region",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "Get election results by region",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Region",
+                        "name": "region",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of election results",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.ElectionVoteResultResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "451": {
+                        "description": "Results embargoed until polls close",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/regions/{region}/votes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get all vote results for a specific region",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "Get vote results by region",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Region",
+                        "name": "region",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Limit the number of results",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of vote results",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.VoteResultResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/standings/{election_id}": {
+            "get": {
+                "description": "Rank the registered pairs of an election by confirmed votes nationwide, with vote shares rounded with the largest remainder method so they sum to 100, the winner, the margin over the runner-up and whether the lead is tied",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "Get national standings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Election ID",
+                        "name": "election_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "National standings",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.StandingsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "No election pairs registered for the election",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    },
+                    "451": {
+                        "description": "Results embargoed until polls close",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/standings/{election_id}/regions": {
+            "get": {
+                "description": "Rank the registered pairs of an election in every region with votes, by region. Regions under embargo are left out.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "Get standings of every region",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Election ID",
+                        "name": "election_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Standings by region",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.StandingsResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "No election pairs registered for the election",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    },
+                    "451": {
+                        "description": "Results embargoed until polls close",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/standings/{election_id}/regions/{region}": {
+            "get": {
+                "description": "Rank the registered pairs of an election by confirmed votes in a region",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "Get standings in a region",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Election ID",
+                        "name": "election_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Region",
+                        "name": "region",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Region standings",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.StandingsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "No votes for the election in the region",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    },
+                    "451": {
+                        "description": "Results embargoed until polls close",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/statistics": {
+            "get": {
+                "description": "Get overall vote statistics including total votes, valid votes, and invalid votes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "Get overall vote statistics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ETag of a previous response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Overall vote statistics data",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.VoteStatisticsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response body"
+                            }
+                        }
+                    },
+                    "451": {
+                        "description": "Results embargoed until polls close",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/statistics/breakdown": {
+            "get": {
+                "description": "Get vote counts per time bucket, election pair, region and status, bucketed on the wall clock of the given time zone. Only combinations with at least one vote are returned. Without dates the breakdown covers the last 24 hours, or the last 30 days for day buckets.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "Get vote breakdown per time bucket",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "IANA time zone, e.g. Asia/Jakarta, Asia/Makassar, Asia/Jayapura. Defaults to the configured zone",
+                        "name": "tz",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "hour",
+                        "description": "Bucket size: minute, 15m, hour, day",
+                        "name": "bucket",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "voted_at",
+                        "description": "Timestamp to bucket on: voted_at, processed_at, created_at",
+                        "name": "axis",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start in YYYY-MM-DD (local midnight) or RFC3339 format",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End in YYYY-MM-DD (whole day included) or RFC3339 format",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Election Pair ID",
+                        "name": "election_pair_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Region",
+                        "name": "region",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a previous response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Vote counts per bucket",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.VoteBreakdownResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response body"
+                            }
+                        }
+                    },
+                    "451": {
+                        "description": "Results embargoed until polls close",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/statistics/daily": {
+            "get": {
+                "description": "Get daily vote statistics for a specified date range",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "Get daily vote statistics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date in YYYY-MM-DD format",
+                        "name": "start_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date in YYYY-MM-DD format",
+                        "name": "end_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a previous response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of daily vote statistics",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.VoteStatisticsResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response body"
+                            }
+                        }
+                    },
+                    "451": {
+                        "description": "Results embargoed until polls close",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/statistics/timeseries": {
+            "get": {
+                "description": "Get vote counts per time bucket, bucketed on the wall clock of the given time zone. Every bucket in the range is returned, with zero counts where no vote fell. Without dates the series covers the last 24 hours, or the last 30 days for day buckets.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "Get vote time series",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "IANA time zone, e.g. Asia/Jakarta, Asia/Makassar, Asia/Jayapura. Defaults to the configured zone",
+                        "name": "tz",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "hour",
+                        "description": "Bucket size: minute, 15m, hour, day",
+                        "name": "bucket",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "voted_at",
+                        "description": "Timestamp to bucket on: voted_at, processed_at, created_at",
+                        "name": "axis",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start in YYYY-MM-DD (local midnight) or RFC3339 format",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End in YYYY-MM-DD (whole day included) or RFC3339 format",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Election Pair ID",
+                        "name": "election_pair_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Region",
+                        "name": "region",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a previous response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Gap-filled vote time series",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.TimeSeriesResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response body"
+                            }
+                        }
+                    },
+                    "451": {
+                        "description": "Results embargoed until polls close",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/turnout": {
+            "get": {
+                "description": "Rank the regions with registered voters by turnout, the votes received over the registered voters. With an election, only votes for its registered pairs count. Regions under embargo are left out.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "Get turnout leaderboard",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Election ID",
+                        "name": "election_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "desc",
+                        "description": "desc for the highest turnout first, asc for the lowest",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a previous response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Regions by turnout",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.TurnoutLeaderboardResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response body"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid order or limit",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No registered voters imported",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    },
+                    "451": {
+                        "description": "Results embargoed until polls close",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/votes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get all vote results with a specific status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "Get vote results by status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Vote Result Status",
+                        "name": "status",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Limit the number of results",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of vote results",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.VoteResultResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/votes/count": {
+            "get": {
+                "description": "Count the number of votes with a specific status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "Count votes by status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Vote Result Status",
+                        "name": "status",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Count of votes",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "additionalProperties": true
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "451": {
+                        "description": "Results embargoed until polls close",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/votes/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a specific vote result by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "Get vote result by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Vote Result ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Vote result data",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.VoteResultResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/results/votes/{id}/proof": {
+            "get": {
+                "description": "Get the Merkle inclusion path of a confirmed vote in the latest published root of its election and region. The leaf is SHA-256(0x00 || vote_id || 0x00 || election_pair_id || 0x00 || region || 0x00 || transaction_hash); hashing it up the path with SHA-256(0x01 || left || right), each sibling on its position, must give the root.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Results"
+                ],
+                "summary": "Get vote inclusion proof",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Vote ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Inclusion proof",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/controller.jsonResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.MerkleProofResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Vote not committed yet",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    },
+                    "451": {
+                        "description": "Results embargoed until polls close",
+                        "schema": {
+                            "$ref": "#/definitions/controller.jsonResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "controller.errorResponse": {
+            "type": "object",
+            "properties": {
+                "error_code": {
+                    "type": "integer"
+                },
+                "error_message": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.jsonResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {},
+                "error": {
+                    "$ref": "#/definitions/controller.errorResponse"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "health.ComponentStatus": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "latency_ms": {
+                    "type": "number"
+                },
+                "status": {
+                    "$ref": "#/definitions/health.Status"
+                }
+            }
+        },
+        "health.Report": {
+            "type": "object",
+            "properties": {
+                "checked_at": {
+                    "type": "string"
+                },
+                "components": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/health.ComponentStatus"
+                    }
+                },
+                "status": {
+                    "$ref": "#/definitions/health.Status"
+                }
+            }
+        },
+        "health.Status": {
+            "type": "string",
+            "enum": [
+                "up",
+                "down"
+            ],
+            "x-enum-varnames": [
+                "StatusUp",
+                "StatusDown"
+            ]
+        },
+        "request.CertificationRequest": {
+            "type": "object",
+            "properties": {
+                "election_id": {
+                    "type": "string",
+                    "example": "presidential-2029"
+                },
+                "region": {
+                    "type": "string"
+                },
+                "scope": {
+                    "description": "Scope is region or election.",
+                    "type": "string",
+                    "example": "region"
+                }
+            }
+        },
+        "request.ElectionPairRequest": {
+            "type": "object",
+            "properties": {
+                "candidate_name": {
+                    "type": "string"
+                },
+                "election_id": {
+                    "type": "string",
+                    "example": "presidential-2029"
+                },
+                "id": {
+                    "description": "ID is the election_pair_id votes carry. It is only read on create.",
+                    "type": "string",
+                    "example": "pair-2029-01"
+                },
+                "pair_number": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "party": {
+                    "type": "string"
+                },
+                "running_mate_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.ExportRequest": {
+            "type": "object",
+            "properties": {
+                "election_pair_id": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2025-01-31"
+                },
+                "format": {
+                    "type": "string",
+                    "example": "csv"
+                },
+                "region": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2025-01-01"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.PseudonymLookupRequest": {
+            "type": "object",
+            "properties": {
+                "pseudonym": {
+                    "type": "string",
+                    "example": "vp_4f9c2a7d1e8b3c6a5d0f7e2b9a8c1d3e"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "court order 2025/123"
+                }
+            }
+        },
+        "request.RegisteredVotersImportRequest": {
+            "type": "object",
+            "properties": {
+                "registered_voters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/request.RegisteredVotersRequest"
+                    }
+                }
+            }
+        },
+        "request.RegisteredVotersRequest": {
+            "type": "object",
+            "properties": {
+                "election_id": {
+                    "description": "ElectionID is left empty for a count applying to every election held in the region.",
+                    "type": "string",
+                    "example": "presidential-2029"
+                },
+                "region": {
+                    "type": "string"
+                },
+                "registered_voters": {
+                    "type": "integer",
+                    "example": 290
+                }
+            }
+        },
+        "request.WebhookSubscriptionRequest": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "election_pair_id": {
+                    "type": "string"
+                },
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "election_update",
+                        "region_update"
+                    ]
+                },
+                "region": {
+                    "type": "string"
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://partner.example.com/hooks/results"
+                }
+            }
+        },
+        "response.AnomalyResponse": {
+            "type": "object",
+            "properties": {
+                "baseline": {
+                    "type": "number"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "detected_at": {
+                    "type": "string"
+                },
+                "election_pair_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "region": {
+                    "type": "string"
+                },
+                "transaction_hash": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                },
+                "vote_id": {
+                    "type": "string"
+                },
+                "voter_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.CandidateStandingResponse": {
+            "type": "object",
+            "properties": {
+                "candidate_name": {
+                    "type": "string"
+                },
+                "election_pair_id": {
+                    "type": "string"
+                },
+                "finalizing_votes": {
+                    "type": "integer"
+                },
+                "pair_number": {
+                    "type": "integer"
+                },
+                "party": {
+                    "type": "string"
+                },
+                "rank": {
+                    "description": "Rank is shared by tied pairs, and the next rank skips accordingly.",
+                    "type": "integer"
+                },
+                "running_mate_name": {
+                    "type": "string"
+                },
+                "share_percent": {
+                    "description": "SharePercent has two decimals, and the shares of a standing sum to exactly 100.",
+                    "type": "number"
+                },
+                "suppressed": {
+                    "type": "boolean"
+                },
+                "votes": {
+                    "description": "Votes counts the confirmed votes of the pair, FinalizingVotes of them\nstill provisional until their block is deep enough.",
+                    "type": "integer"
+                }
+            }
+        },
+        "response.CertificationResponse": {
+            "type": "object",
+            "properties": {
+                "certified_at": {
+                    "type": "string"
+                },
+                "certified_by": {
+                    "type": "string"
+                },
+                "certifier_name": {
+                    "type": "string"
+                },
+                "content_hash": {
+                    "type": "string"
+                },
+                "election_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "region": {
+                    "type": "string"
+                },
+                "scope": {
+                    "type": "string"
+                },
+                "snapshot": {
+                    "description": "Snapshot is the certified content whose SHA-256 digest is ContentHash. It\nholds counts as certified, so it is left out for public callers while\ndisclosure control is enabled.",
+                    "type": "object"
+                }
+            }
+        },
+        "response.ElectionPairResponse": {
+            "type": "object",
+            "properties": {
+                "candidate_name": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "election_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "pair_number": {
+                    "type": "integer"
+                },
+                "party": {
+                    "type": "string"
+                },
+                "running_mate_name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.ElectionVoteResultResponse": {
+            "type": "object",
+            "properties": {
+                "certification_id": {
+                    "description": "CertificationID is set when the counts are those of a certified snapshot.",
+                    "type": "string"
+                },
+                "confirmed_votes": {
+                    "type": "integer"
+                },
+                "election_pair_id": {
+                    "type": "string"
+                },
+                "error_votes": {
+                    "type": "integer"
+                },
+                "finalizing_votes": {
+                    "type": "integer"
+                },
+                "last_updated": {
+                    "type": "string"
+                },
+                "pending_votes": {
+                    "type": "integer"
+                },
+                "region": {
+                    "type": "string"
+                },
+                "rounded_to": {
+                    "description": "RoundedTo is the base the counts were rounded to by disclosure control.",
+                    "type": "integer"
+                },
+                "suppressed": {
+                    "description": "Suppressed lists the counts withheld by disclosure control, which read 0.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "total_votes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "response.ExportJobResponse": {
+            "type": "object",
+            "properties": {
+                "completed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "filter": {
+                    "$ref": "#/definitions/request.ExportRequest"
+                },
+                "format": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "rows_written": {
+                    "type": "integer"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "total_rows": {
+                    "type": "integer"
+                }
+            }
+        },
+        "response.MerkleCommitmentResponse": {
+            "type": "object",
+            "properties": {
+                "election_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "leaf_count": {
+                    "type": "integer"
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "region": {
+                    "type": "string"
+                },
+                "root": {
+                    "type": "string",
+                    "example": "9f2c4e0a8d1b7c3e5f6a0b9d8c7e6f5a4b3c2d1e0f9a8b7c6d5e4f3a2b1c0d9e"
+                }
+            }
+        },
+        "response.MerkleProofResponse": {
+            "type": "object",
+            "properties": {
+                "commitment_id": {
+                    "type": "string"
+                },
+                "election_id": {
+                    "type": "string"
+                },
+                "election_pair_id": {
+                    "type": "string"
+                },
+                "leaf_count": {
+                    "type": "integer"
+                },
+                "leaf_hash": {
+                    "type": "string"
+                },
+                "leaf_index": {
+                    "type": "integer"
+                },
+                "path": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.MerkleProofStepResponse"
+                    }
+                },
+                "published_at": {
+                    "type": "string"
+                },
+                "region": {
+                    "type": "string"
+                },
+                "root": {
+                    "type": "string"
+                },
+                "transaction_hash": {
+                    "type": "string"
+                },
+                "vote_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.MerkleProofStepResponse": {
+            "type": "object",
+            "properties": {
+                "hash": {
+                    "type": "string"
+                },
+                "position": {
+                    "description": "Position is left or right, the side the sibling is hashed on.",
+                    "type": "string",
+                    "example": "left"
+                }
+            }
+        },
+        "response.PseudonymLookupResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "pseudonym": {
+                    "type": "string"
+                },
+                "voter_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.QuarantinedEventResponse": {
+            "type": "object",
+            "properties": {
+                "certification_id": {
+                    "type": "string"
+                },
+                "election_pair_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "message_key": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "quarantined_at": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "region": {
+                    "type": "string"
+                },
+                "topic": {
+                    "type": "string"
+                },
+                "vote_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.RegionChildrenResponse": {
+            "type": "object",
+            "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.RegionRollupResponse"
+                    }
+                },
+                "path": {
+                    "description": "Path lists the ancestors of the region from the province down.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.RegionNodeResponse"
+                    }
+                },
+                "region": {
+                    "$ref": "#/definitions/response.RegionRollupResponse"
+                }
+            }
+        },
+        "response.RegionHierarchyResponse": {
+            "type": "object",
+            "properties": {
+                "confirmed_votes": {
+                    "type": "integer"
+                },
+                "error_votes": {
+                    "type": "integer"
+                },
+                "finalizing_votes": {
+                    "type": "integer"
+                },
+                "last_updated": {
+                    "type": "string"
+                },
+                "pending_votes": {
+                    "type": "integer"
+                },
+                "provinces": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.RegionRollupResponse"
+                    }
+                },
+                "total_votes": {
+                    "type": "integer"
+                },
+                "unmapped_regions": {
+                    "description": "UnmappedRegions are the regions votes carry that match no polling station.\nTheir votes are left out of the hierarchy until the reference file maps them.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.RegionVoteResultResponse"
+                    }
+                }
+            }
+        },
+        "response.RegionNodeResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "level": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.RegionRollupResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "confirmed_votes": {
+                    "type": "integer"
+                },
+                "error_votes": {
+                    "type": "integer"
+                },
+                "finalizing_votes": {
+                    "type": "integer"
+                },
+                "last_updated": {
+                    "type": "string"
+                },
+                "level": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_code": {
+                    "type": "string"
+                },
+                "pending_votes": {
+                    "type": "integer"
+                },
+                "rounded_to": {
+                    "description": "RoundedTo is the base the counts were rounded to by disclosure control.",
+                    "type": "integer"
+                },
+                "suppressed": {
+                    "description": "Suppressed lists the counts withheld by disclosure control, which read 0.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "total_votes": {
+                    "type": "integer"
                 }
             }
         },
-        "controller.jsonResponse": {
+        "response.RegionTurnoutResponse": {
             "type": "object",
             "properties": {
-                "code": {
+                "rank": {
+                    "description": "Rank is shared by regions with the same turnout.",
                     "type": "integer"
                 },
-                "data": {},
-                "error": {
-                    "$ref": "#/definitions/controller.errorResponse"
-                },
-                "message": {
+                "region": {
                     "type": "string"
+                },
+                "registered_voters": {
+                    "type": "integer"
+                },
+                "rounded_to": {
+                    "description": "RoundedTo is the base the total was rounded to by disclosure control.",
+                    "type": "integer"
+                },
+                "total_votes": {
+                    "type": "integer"
+                },
+                "turnout_percent": {
+                    "type": "number"
                 }
             }
         },
-        "response.ElectionVoteResultResponse": {
+        "response.RegionVoteResultResponse": {
             "type": "object",
             "properties": {
+                "certification_id": {
+                    "description": "CertificationID is set when the counts are those of a certified snapshot.",
+                    "type": "string"
+                },
                 "confirmed_votes": {
                     "type": "integer"
                 },
-                "election_pair_id": {
-                    "type": "string"
-                },
                 "error_votes": {
                     "type": "integer"
                 },
+                "finalizing_votes": {
+                    "type": "integer"
+                },
                 "last_updated": {
                     "type": "string"
                 },
@@ -832,27 +4038,127 @@ const docTemplate = `{
                 "region": {
                     "type": "string"
                 },
+                "registered_voters": {
+                    "description": "RegisteredVoters is unset when no registered voter count is imported for\nthe region. TurnoutPercent is the total votes over it, unset as well when\nthe total is withheld.",
+                    "type": "integer"
+                },
+                "rounded_to": {
+                    "description": "RoundedTo is the base the counts were rounded to by disclosure control.",
+                    "type": "integer"
+                },
+                "suppressed": {
+                    "description": "Suppressed lists the counts withheld by disclosure control, which read 0.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "total_votes": {
                     "type": "integer"
+                },
+                "turnout_percent": {
+                    "type": "number"
                 }
             }
         },
-        "response.RegionVoteResultResponse": {
+        "response.RegisteredVotersImportResponse": {
             "type": "object",
             "properties": {
-                "confirmed_votes": {
+                "imported": {
                     "type": "integer"
+                }
+            }
+        },
+        "response.RegisteredVotersResponse": {
+            "type": "object",
+            "properties": {
+                "election_id": {
+                    "type": "string"
                 },
-                "error_votes": {
+                "region": {
+                    "type": "string"
+                },
+                "registered_voters": {
                     "type": "integer"
                 },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.StandingsResponse": {
+            "type": "object",
+            "properties": {
+                "certification_id": {
+                    "description": "CertificationID is set when the standings rank a certified snapshot.",
+                    "type": "string"
+                },
+                "election_id": {
+                    "type": "string"
+                },
                 "last_updated": {
                     "type": "string"
                 },
-                "pending_votes": {
+                "margin": {
+                    "description": "Margin is the lead of the first pair over the second, in votes and in\npercentage points of the vote share.",
                     "type": "integer"
                 },
+                "margin_percent": {
+                    "type": "number"
+                },
                 "region": {
+                    "description": "Region is empty for national standings.",
+                    "type": "string"
+                },
+                "registered_voters": {
+                    "description": "RegisteredVoters is unset when no registered voter count is imported for\nthe election. TurnoutPercent counts every vote received for the election,\nwhatever its status, and is unset when a count was withheld.",
+                    "type": "integer"
+                },
+                "standings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.CandidateStandingResponse"
+                    }
+                },
+                "suppressed": {
+                    "description": "Suppressed is set when disclosure control withheld the votes of a pair,\nwhich is then ranked last without a vote share.",
+                    "type": "boolean"
+                },
+                "tie": {
+                    "type": "boolean"
+                },
+                "total_votes": {
+                    "type": "integer"
+                },
+                "turnout_percent": {
+                    "type": "number"
+                },
+                "winner": {
+                    "description": "Winner is the leading pair, unset while the lead is tied or no vote is counted.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/response.CandidateStandingResponse"
+                        }
+                    ]
+                }
+            }
+        },
+        "response.TimeSeriesPointResponse": {
+            "type": "object",
+            "properties": {
+                "confirmed_votes": {
+                    "type": "integer"
+                },
+                "error_votes": {
+                    "type": "integer"
+                },
+                "finalizing_votes": {
+                    "type": "integer"
+                },
+                "pending_votes": {
+                    "type": "integer"
+                },
+                "timestamp": {
                     "type": "string"
                 },
                 "total_votes": {
@@ -860,9 +4166,126 @@ const docTemplate = `{
                 }
             }
         },
+        "response.TimeSeriesResponse": {
+            "type": "object",
+            "properties": {
+                "axis": {
+                    "type": "string"
+                },
+                "bucket": {
+                    "type": "string"
+                },
+                "election_pair_id": {
+                    "type": "string"
+                },
+                "end": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.TimeSeriesPointResponse"
+                    }
+                },
+                "region": {
+                    "type": "string"
+                },
+                "start": {
+                    "type": "string"
+                },
+                "tz": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.TurnoutLeaderboardResponse": {
+            "type": "object",
+            "properties": {
+                "election_id": {
+                    "description": "ElectionID is empty when every vote of a region counts.",
+                    "type": "string"
+                },
+                "regions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.RegionTurnoutResponse"
+                    }
+                },
+                "unranked": {
+                    "description": "Unranked counts the regions left out for want of a registered voter count\nor because disclosure control withheld their total.",
+                    "type": "integer"
+                }
+            }
+        },
+        "response.VoteBreakdownResponse": {
+            "type": "object",
+            "properties": {
+                "axis": {
+                    "type": "string"
+                },
+                "bucket": {
+                    "type": "string"
+                },
+                "election_pair_id": {
+                    "type": "string"
+                },
+                "end": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.VoteBucketCountResponse"
+                    }
+                },
+                "region": {
+                    "type": "string"
+                },
+                "start": {
+                    "type": "string"
+                },
+                "tz": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.VoteBucketCountResponse": {
+            "type": "object",
+            "properties": {
+                "election_pair_id": {
+                    "type": "string"
+                },
+                "region": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "votes": {
+                    "type": "integer"
+                }
+            }
+        },
         "response.VoteResultResponse": {
             "type": "object",
             "properties": {
+                "block_hash": {
+                    "type": "string"
+                },
+                "block_number": {
+                    "type": "integer"
+                },
+                "block_time": {
+                    "type": "string"
+                },
+                "chain_status": {
+                    "description": "ChainStatus is the outcome of checking the transaction on chain, with the\nblock it was mined in. Unset until the vote is reconciled.",
+                    "type": "string",
+                    "example": "verified"
+                },
                 "created_at": {
                     "type": "string"
                 },
@@ -907,6 +4330,9 @@ const docTemplate = `{
                 "error_votes": {
                     "type": "integer"
                 },
+                "finalizing_votes": {
+                    "type": "integer"
+                },
                 "last_updated": {
                     "type": "string"
                 },
@@ -920,6 +4346,102 @@ const docTemplate = `{
                     "type": "integer"
                 }
             }
+        },
+        "response.WebhookDeliveryResponse": {
+            "type": "object",
+            "properties": {
+                "attempt": {
+                    "type": "integer"
+                },
+                "attempted_at": {
+                    "type": "string"
+                },
+                "delivery_id": {
+                    "type": "string"
+                },
+                "duration_ms": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "subscription_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.WebhookSubscriptionResponse": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "consecutive_failures": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "election_pair_id": {
+                    "type": "string"
+                },
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "last_failure_at": {
+                    "type": "string"
+                },
+                "last_success_at": {
+                    "type": "string"
+                },
+                "region": {
+                    "type": "string"
+                },
+                "secret": {
+                    "description": "Secret is only returned when the subscription is created.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        }
+    },
+    "securityDefinitions": {
+        "ApiKeyAuth": {
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        },
+        "BearerAuth": {
+            "description": "JWT bearer token, sent as \"Bearer \u003ctoken\u003e\"",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
         }
     }
 }`
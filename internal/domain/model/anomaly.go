@@ -0,0 +1,41 @@
+package model
+
+import "time"
+
+type AnomalyKind string
+
+const (
+	AnomalyKindRegionSpike           AnomalyKind = "region_spike"
+	AnomalyKindErrorRateSurge        AnomalyKind = "error_rate_surge"
+	AnomalyKindSharedTransactionHash AnomalyKind = "shared_transaction_hash"
+	AnomalyKindDuplicateVoter        AnomalyKind = "duplicate_voter"
+	AnomalyKindClockSkew             AnomalyKind = "clock_skew"
+	// AnomalyKindCertifiedTallyChange is raised for a vote event quarantined
+	// because it would change a certified tally.
+	AnomalyKindCertifiedTallyChange AnomalyKind = "certified_tally_change"
+)
+
+// Anomaly is a suspicious pattern found in the vote stream. Value is the
+// observed measure and Baseline what it was compared against.
+type Anomaly struct {
+	ID              string    `db:"id"`
+	Kind            string    `db:"kind"`
+	ElectionPairID  string    `db:"election_pair_id"`
+	Region          string    `db:"region"`
+	VoteID          string    `db:"vote_id"`
+	VoterID         string    `db:"voter_id"`
+	TransactionHash string    `db:"transaction_hash"`
+	Value           float64   `db:"value"`
+	Baseline        float64   `db:"baseline"`
+	Description     string    `db:"description"`
+	DetectedAt      time.Time `db:"detected_at"`
+}
+
+// AnomalyFilter narrows anomaly reads. Empty fields and zero times are ignored.
+type AnomalyFilter struct {
+	Kind           string
+	ElectionPairID string
+	Region         string
+	StartDate      time.Time
+	EndDate        time.Time
+}
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	CertificationScopeRegion   = "region"
+	CertificationScopeElection = "election"
+)
+
+// Certification freezes the result of a region, or of an election across every
+// region, as certified by the commission. Snapshot is the certified content as
+// published and ContentHash its hex SHA-256 digest. Certifications are never
+// updated or deleted.
+type Certification struct {
+	ID            string    `db:"id"`
+	Scope         string    `db:"scope"`
+	ScopeID       string    `db:"scope_id"`
+	Snapshot      string    `db:"snapshot"`
+	ContentHash   string    `db:"content_hash"`
+	CertifiedBy   string    `db:"certified_by"`
+	CertifierName string    `db:"certifier_name"`
+	CertifiedAt   time.Time `db:"certified_at"`
+}
+
+// CertificationSnapshot is the content of a certification: the counts of every
+// election pair in the region, or nationally for an election, and who certified
+// them when.
+type CertificationSnapshot struct {
+	CertificationID string              `json:"certification_id"`
+	Scope           string              `json:"scope"`
+	Region          string              `json:"region,omitempty"`
+	ElectionID      string              `json:"election_id,omitempty"`
+	Results         []*CertifiedResult  `json:"results"`
+	Totals          CertifiedVoteCounts `json:"totals"`
+	LastUpdated     time.Time           `json:"last_updated"`
+	CertifiedBy     string              `json:"certified_by"`
+	CertifierName   string              `json:"certifier_name,omitempty"`
+	CertifiedAt     time.Time           `json:"certified_at"`
+}
+
+type CertifiedResult struct {
+	ElectionPairID string `json:"election_pair_id"`
+	CertifiedVoteCounts
+}
+
+type CertifiedVoteCounts struct {
+	TotalVotes      uint64 `json:"total_votes"`
+	ConfirmedVotes  uint64 `json:"confirmed_votes"`
+	FinalizingVotes uint64 `json:"finalizing_votes"`
+	PendingVotes    uint64 `json:"pending_votes"`
+	ErrorVotes      uint64 `json:"error_votes"`
+}
+
+// Content decodes the snapshot of the certification.
+func (c *Certification) Content() (*CertificationSnapshot, error) {
+	var snapshot CertificationSnapshot
+	if err := json.Unmarshal([]byte(c.Snapshot), &snapshot); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// QuarantinedEvent is a vote event held back because applying it would change
+// a certified tally. Payload is the message as consumed.
+type QuarantinedEvent struct {
+	ID              string    `db:"id"`
+	CertificationID string    `db:"certification_id"`
+	Topic           string    `db:"topic"`
+	MessageKey      string    `db:"message_key"`
+	VoteID          string    `db:"vote_id"`
+	ElectionPairID  string    `db:"election_pair_id"`
+	Region          string    `db:"region"`
+	Reason          string    `db:"reason"`
+	Payload         string    `db:"payload"`
+	QuarantinedAt   time.Time `db:"quarantined_at"`
+}
@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// ChainCheckpoint records that the events of a contract were ingested up to
+// and including a block. The latest checkpoint is where ingestion resumes;
+// earlier ones locate the fork point of a reorganization.
+type ChainCheckpoint struct {
+	Contract    string    `db:"contract"`
+	BlockNumber uint64    `db:"block_number"`
+	BlockHash   string    `db:"block_hash"`
+	CreatedAt   time.Time `db:"created_at"`
+}
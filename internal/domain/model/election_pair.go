@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// ElectionPair is a ticket on the ballot of an election: the pair number voters
+// see, the candidate and running mate, and the party or coalition backing them.
+// Its ID is the election_pair_id votes carry.
+type ElectionPair struct {
+	ID              string    `db:"id" yaml:"id"`
+	ElectionID      string    `db:"election_id" yaml:"election_id"`
+	PairNumber      int       `db:"pair_number" yaml:"pair_number"`
+	CandidateName   string    `db:"candidate_name" yaml:"candidate_name"`
+	RunningMateName string    `db:"running_mate_name" yaml:"running_mate_name"`
+	Party           string    `db:"party" yaml:"party"`
+	CreatedAt       time.Time `db:"created_at" yaml:"-"`
+	UpdatedAt       time.Time `db:"updated_at" yaml:"-"`
+}
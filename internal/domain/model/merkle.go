@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// MerkleCommitment is a published Merkle root over the confirmed votes of an
+// election in a region. A new commitment is published whenever the set of
+// confirmed votes changes; earlier roots stay published.
+type MerkleCommitment struct {
+	ID          string    `db:"id"`
+	ElectionID  string    `db:"election_id"`
+	Region      string    `db:"region"`
+	Root        string    `db:"root"`
+	LeafCount   uint64    `db:"leaf_count"`
+	PublishedAt time.Time `db:"published_at"`
+}
+
+// MerkleLeaf is a confirmed vote at its position in a commitment. Leaves are
+// kept for the latest commitment of each election and region only.
+type MerkleLeaf struct {
+	CommitmentID    string    `db:"commitment_id"`
+	LeafIndex       uint64    `db:"leaf_index"`
+	VoteID          string    `db:"vote_id"`
+	ElectionPairID  string    `db:"election_pair_id"`
+	Region          string    `db:"region"`
+	TransactionHash string    `db:"transaction_hash"`
+	LeafHash        string    `db:"leaf_hash"`
+	PublishedAt     time.Time `db:"published_at"`
+}
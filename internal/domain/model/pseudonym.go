@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// VoterPseudonym links a voter pseudonym to the voter ID it was derived from.
+type VoterPseudonym struct {
+	Pseudonym string    `db:"pseudonym"`
+	VoterID   string    `db:"voter_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// PseudonymLookup is the audit record of an admin resolving a pseudonym.
+type PseudonymLookup struct {
+	ID         string    `db:"id"`
+	Pseudonym  string    `db:"pseudonym"`
+	UserID     string    `db:"user_id"`
+	UserName   string    `db:"user_name"`
+	Reason     string    `db:"reason"`
+	Found      bool      `db:"found"`
+	LookedUpAt time.Time `db:"looked_up_at"`
+}
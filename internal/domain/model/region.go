@@ -0,0 +1,35 @@
+package model
+
+// Region hierarchy levels, from the top of the tree down.
+const (
+	RegionLevelProvince       = "province"
+	RegionLevelRegency        = "regency"
+	RegionLevelDistrict       = "district"
+	RegionLevelPollingStation = "polling_station"
+)
+
+// RegionLevels lists the levels of the region hierarchy in order, so a node's
+// depth in the tree gives its level.
+var RegionLevels = []string{
+	RegionLevelProvince,
+	RegionLevelRegency,
+	RegionLevelDistrict,
+	RegionLevelPollingStation,
+}
+
+// RegionNode is an administrative area of the region hierarchy. Votes are
+// mapped to the polling station, the leaf, whose code or alias matches their
+// region.
+type RegionNode struct {
+	Code       string        `yaml:"code"`
+	Name       string        `yaml:"name"`
+	Level      string        `yaml:"level"`
+	Aliases    []string      `yaml:"aliases"`
+	Children   []*RegionNode `yaml:"children"`
+	ParentCode string        `yaml:"-"`
+}
+
+// Leaf reports whether the node is a polling station.
+func (n *RegionNode) Leaf() bool {
+	return len(n.Children) == 0
+}
@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// RegisteredVoters is the number of voters registered in a region, for one
+// election or, with no election ID, for every election held in the region.
+// The count imported last for an election and region is the one in effect.
+type RegisteredVoters struct {
+	ElectionID       string    `db:"election_id" yaml:"election_id"`
+	Region           string    `db:"region" yaml:"region"`
+	RegisteredVoters uint64    `db:"registered_voters" yaml:"registered_voters"`
+	UpdatedAt        time.Time `db:"updated_at" yaml:"-"`
+}
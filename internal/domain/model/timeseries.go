@@ -0,0 +1,99 @@
+package model
+
+import "time"
+
+// TimeAxis is the vote timestamp a time series is bucketed on.
+type TimeAxis string
+
+const (
+	TimeAxisVotedAt     TimeAxis = "voted_at"
+	TimeAxisProcessedAt TimeAxis = "processed_at"
+	TimeAxisCreatedAt   TimeAxis = "created_at"
+)
+
+func (a TimeAxis) Valid() bool {
+	switch a {
+	case TimeAxisVotedAt, TimeAxisProcessedAt, TimeAxisCreatedAt:
+		return true
+	}
+	return false
+}
+
+// BucketSize is the width of one time series bucket.
+type BucketSize string
+
+const (
+	BucketMinute        BucketSize = "minute"
+	BucketFifteenMinute BucketSize = "15m"
+	BucketHour          BucketSize = "hour"
+	BucketDay           BucketSize = "day"
+)
+
+func (b BucketSize) Valid() bool {
+	switch b {
+	case BucketMinute, BucketFifteenMinute, BucketHour, BucketDay:
+		return true
+	}
+	return false
+}
+
+// Floor returns the start of the bucket holding t, on the wall clock of loc.
+func (b BucketSize) Floor(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	switch b {
+	case BucketMinute:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, loc)
+	case BucketFifteenMinute:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute()-t.Minute()%15, 0, 0, loc)
+	case BucketHour:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	}
+}
+
+// Next returns the start of the bucket following start. Days advance on the
+// calendar so buckets stay aligned to local midnight across DST changes.
+func (b BucketSize) Next(start time.Time) time.Time {
+	switch b {
+	case BucketMinute:
+		return start.Add(time.Minute)
+	case BucketFifteenMinute:
+		return start.Add(15 * time.Minute)
+	case BucketHour:
+		return start.Add(time.Hour)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// TimeSeriesFilter selects the votes counted into a time series. Start is
+// inclusive and End exclusive, both on the chosen axis.
+type TimeSeriesFilter struct {
+	Axis           TimeAxis
+	Bucket         BucketSize
+	Location       *time.Location
+	Start          time.Time
+	End            time.Time
+	ElectionPairID string
+	Region         string
+}
+
+type TimeSeriesPoint struct {
+	Bucket          time.Time `db:"bucket"`
+	TotalVotes      uint64    `db:"total_votes"`
+	ConfirmedVotes  uint64    `db:"confirmed_votes"`
+	FinalizingVotes uint64    `db:"finalizing_votes"`
+	PendingVotes    uint64    `db:"pending_votes"`
+	ErrorVotes      uint64    `db:"error_votes"`
+}
+
+// VoteBucketCount is the number of votes in one bucket for one election pair,
+// region and status.
+type VoteBucketCount struct {
+	Bucket         time.Time `db:"bucket"`
+	ElectionPairID string    `db:"election_pair_id"`
+	Region         string    `db:"region"`
+	Status         string    `db:"status"`
+	Votes          uint64    `db:"votes"`
+}
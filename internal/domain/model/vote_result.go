@@ -14,6 +14,26 @@ const (
 	VoteStatusError     VoteStatus = "error"
 	VoteStatusQueued    VoteStatus = "queued"
 	VoteStatusRetrying  VoteStatus = "retrying"
+	// VoteStatusFinalizing is a vote confirmed on chain whose block is not yet
+	// Finality.ConfirmationDepth blocks deep, so a reorg could still drop it.
+	// It becomes confirmed, which is final, once it is.
+	VoteStatusFinalizing VoteStatus = "finalizing"
+)
+
+// ChainStatus is the outcome of checking a confirmed vote's transaction on
+// chain. Votes not checked yet have none.
+type ChainStatus string
+
+const (
+	// ChainStatusVerified is a transaction mined and executed successfully.
+	ChainStatusVerified ChainStatus = "verified"
+	// ChainStatusMissing is a transaction the node has no receipt for.
+	ChainStatusMissing ChainStatus = "missing"
+	// ChainStatusFailed is a transaction mined but whose execution failed.
+	ChainStatusFailed ChainStatus = "failed"
+	// ChainStatusReverted is a transaction verified before and since dropped
+	// from the canonical chain by a reorg.
+	ChainStatusReverted ChainStatus = "reverted"
 )
 
 type VoteResult struct {
@@ -28,35 +48,54 @@ type VoteResult struct {
 	ProcessedAt     *time.Time `db:"processed_at" `
 	CreatedAt       time.Time  `db:"created_at" `
 	UpdatedAt       time.Time  `db:"updated_at"`
+	// Chain fields are recorded by reconciliation against the chain.
+	ChainStatus    string     `db:"chain_status"`
+	BlockNumber    *uint64    `db:"block_number"`
+	BlockHash      string     `db:"block_hash"`
+	BlockTime      *time.Time `db:"block_time"`
+	ChainCheckedAt *time.Time `db:"chain_checked_at"`
+}
+
+// VoteResultFilter narrows bulk reads of vote results. Empty fields and zero
+// times are ignored.
+type VoteResultFilter struct {
+	ElectionPairID string
+	Region         string
+	Status         string
+	StartDate      time.Time
+	EndDate        time.Time
 }
 
 type ElectionResult struct {
-	ElectionPairID string    `db:"election_pair_id"`
-	Region         string    `db:"region"`
-	TotalVotes     uint64    `db:"total_votes"`
-	ConfirmedVotes uint64    `db:"confirmed_votes"`
-	PendingVotes   uint64    `db:"pending_votes"`
-	ErrorVotes     uint64    `db:"error_votes"`
-	LastUpdated    time.Time `db:"last_updated"`
+	ElectionPairID  string    `db:"election_pair_id"`
+	Region          string    `db:"region"`
+	TotalVotes      uint64    `db:"total_votes"`
+	ConfirmedVotes  uint64    `db:"confirmed_votes"`
+	FinalizingVotes uint64    `db:"finalizing_votes"`
+	PendingVotes    uint64    `db:"pending_votes"`
+	ErrorVotes      uint64    `db:"error_votes"`
+	LastUpdated     time.Time `db:"last_updated"`
 }
 
 type RegionResult struct {
-	Region         string    `db:"region"`
-	TotalVotes     uint64    `db:"total_votes"`
-	ConfirmedVotes uint64    `db:"confirmed_votes"`
-	PendingVotes   uint64    `db:"pending_votes" `
-	ErrorVotes     uint64    `db:"error_votes"`
-	LastUpdated    time.Time `db:"last_updated"`
+	Region          string    `db:"region"`
+	TotalVotes      uint64    `db:"total_votes"`
+	ConfirmedVotes  uint64    `db:"confirmed_votes"`
+	FinalizingVotes uint64    `db:"finalizing_votes"`
+	PendingVotes    uint64    `db:"pending_votes" `
+	ErrorVotes      uint64    `db:"error_votes"`
+	LastUpdated     time.Time `db:"last_updated"`
 }
 
 type VoteStatistics struct {
-	Date           time.Time `db:"date"`
-	TotalVotes     uint64    `db:"total_votes"`
-	ConfirmedVotes uint64    `db:"confirmed_votes"`
-	PendingVotes   uint64    `db:"pending_votes"`
-	ErrorVotes     uint64    `db:"error_votes"`
-	SuccessRate    float64   `db:"success_rate"`
-	LastUpdated    time.Time `db:"last_updated"`
+	Date            time.Time `db:"date"`
+	TotalVotes      uint64    `db:"total_votes"`
+	ConfirmedVotes  uint64    `db:"confirmed_votes"`
+	FinalizingVotes uint64    `db:"finalizing_votes"`
+	PendingVotes    uint64    `db:"pending_votes"`
+	ErrorVotes      uint64    `db:"error_votes"`
+	SuccessRate     float64   `db:"success_rate"`
+	LastUpdated     time.Time `db:"last_updated"`
 }
 
 func FromVoteProcessedMessage(msg *event.VoteProcessedMessage) *VoteResult {
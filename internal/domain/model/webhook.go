@@ -0,0 +1,43 @@
+package model
+
+import "time"
+
+const (
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryRetrying  = "retrying"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// WebhookSubscription is a partner endpoint receiving live result events. Empty
+// event types, election pair or region match every one of them.
+type WebhookSubscription struct {
+	ID                  string     `db:"id"`
+	URL                 string     `db:"url"`
+	Secret              string     `db:"secret"`
+	EventTypes          []string   `db:"event_types"`
+	ElectionPairID      string     `db:"election_pair_id"`
+	Region              string     `db:"region"`
+	Active              bool       `db:"active"`
+	ConsecutiveFailures uint64     `db:"consecutive_failures"`
+	LastError           string     `db:"last_error"`
+	LastSuccessAt       *time.Time `db:"last_success_at"`
+	LastFailureAt       *time.Time `db:"last_failure_at"`
+	CreatedAt           time.Time  `db:"created_at"`
+	UpdatedAt           time.Time  `db:"updated_at"`
+}
+
+// WebhookDelivery is one attempt at delivering an event to a subscription.
+// Every attempt of the same event shares the delivery ID.
+type WebhookDelivery struct {
+	ID             string    `db:"id"`
+	DeliveryID     string    `db:"delivery_id"`
+	SubscriptionID string    `db:"subscription_id"`
+	EventType      string    `db:"event_type"`
+	Payload        string    `db:"payload"`
+	Attempt        int       `db:"attempt"`
+	Status         string    `db:"status"`
+	ResponseCode   int       `db:"response_code"`
+	Error          string    `db:"error"`
+	DurationMs     int64     `db:"duration_ms"`
+	AttemptedAt    time.Time `db:"attempted_at"`
+}
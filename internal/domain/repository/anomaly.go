@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"context"
+	"github.com/nocturna-ta/result/internal/domain/model"
+)
+
+type AnomalyRepository interface {
+	InsertAnomaly(ctx context.Context, anomaly *model.Anomaly) error
+	GetAnomalies(ctx context.Context, filter model.AnomalyFilter, limit, offset int) ([]*model.Anomaly, error)
+}
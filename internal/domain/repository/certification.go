@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"github.com/nocturna-ta/result/internal/domain/model"
+)
+
+type CertificationRepository interface {
+	InsertCertification(ctx context.Context, certification *model.Certification) error
+	GetCertification(ctx context.Context, id string) (*model.Certification, error)
+	// GetCertificationByScope returns the certification of a region or an
+	// election, or ErrNoResult when it is not certified.
+	GetCertificationByScope(ctx context.Context, scope, scopeID string) (*model.Certification, error)
+	GetCertifications(ctx context.Context) ([]*model.Certification, error)
+
+	InsertQuarantinedEvent(ctx context.Context, quarantined *model.QuarantinedEvent) error
+	GetQuarantinedEvents(ctx context.Context, limit, offset int) ([]*model.QuarantinedEvent, error)
+}
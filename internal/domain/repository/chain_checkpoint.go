@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"github.com/nocturna-ta/result/internal/domain/model"
+)
+
+type ChainCheckpointRepository interface {
+	InsertChainCheckpoint(ctx context.Context, checkpoint *model.ChainCheckpoint) error
+	// GetLatestChainCheckpoint returns the checkpoint of the contract written
+	// last, or ErrNoResult when it has none.
+	GetLatestChainCheckpoint(ctx context.Context, contract string) (*model.ChainCheckpoint, error)
+	// GetChainCheckpoints returns the checkpoints of the contract from block
+	// fromBlock through toBlock, latest block first.
+	GetChainCheckpoints(ctx context.Context, contract string, fromBlock, toBlock uint64) ([]*model.ChainCheckpoint, error)
+	// DeleteChainCheckpoints deletes the checkpoints of the contract above block.
+	DeleteChainCheckpoints(ctx context.Context, contract string, block uint64) error
+}
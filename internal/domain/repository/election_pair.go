@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"github.com/nocturna-ta/result/internal/domain/model"
+)
+
+type ElectionPairRepository interface {
+	InsertElectionPair(ctx context.Context, pair *model.ElectionPair) error
+	UpdateElectionPair(ctx context.Context, pair *model.ElectionPair) error
+	DeleteElectionPair(ctx context.Context, id string) error
+	GetElectionPair(ctx context.Context, id string) (*model.ElectionPair, error)
+	// GetElectionPairs lists the pairs of an election by pair number, or of every
+	// election when electionID is empty.
+	GetElectionPairs(ctx context.Context, electionID string) ([]*model.ElectionPair, error)
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"github.com/nocturna-ta/result/internal/domain/model"
+)
+
+type MerkleRepository interface {
+	// InsertMerkleCommitment stores a commitment with its leaves in order.
+	InsertMerkleCommitment(ctx context.Context, commitment *model.MerkleCommitment, leaves []*model.MerkleLeaf) error
+	GetMerkleCommitment(ctx context.Context, id string) (*model.MerkleCommitment, error)
+	// GetLatestMerkleCommitments returns the latest commitment of every region of
+	// an election, or of every election when electionID is empty.
+	GetLatestMerkleCommitments(ctx context.Context, electionID string) ([]*model.MerkleCommitment, error)
+	// GetMerkleLeaf returns the vote's leaf in the latest commitment holding it,
+	// or ErrNoResult when it is in none.
+	GetMerkleLeaf(ctx context.Context, voteID string) (*model.MerkleLeaf, error)
+	// GetMerkleLeafHashes returns the leaf hashes of a commitment in order.
+	GetMerkleLeafHashes(ctx context.Context, commitmentID string) ([]string, error)
+	DeleteMerkleLeaves(ctx context.Context, commitmentID string) error
+}
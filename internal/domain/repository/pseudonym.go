@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"github.com/nocturna-ta/result/internal/domain/model"
+)
+
+type PseudonymRepository interface {
+	InsertVoterPseudonym(ctx context.Context, pseudonym *model.VoterPseudonym) error
+	GetVoterPseudonym(ctx context.Context, pseudonym string) (*model.VoterPseudonym, error)
+	InsertPseudonymLookup(ctx context.Context, lookup *model.PseudonymLookup) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"github.com/nocturna-ta/result/internal/domain/model"
+)
+
+type RegisteredVotersRepository interface {
+	// InsertRegisteredVoters appends counts; a later count for the same election
+	// and region replaces the earlier one.
+	InsertRegisteredVoters(ctx context.Context, counts []*model.RegisteredVoters) error
+	// GetRegisteredVoters returns the count in effect for every election and
+	// region, of one election and the region-wide counts when electionID is set.
+	GetRegisteredVoters(ctx context.Context, electionID string) ([]*model.RegisteredVoters, error)
+	DeleteRegisteredVoters(ctx context.Context, electionID, region string) error
+}
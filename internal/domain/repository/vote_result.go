@@ -20,12 +20,16 @@ type VoteResultRepository interface {
 	// Statistics operations
 	GetElectionResults(ctx context.Context, electionPairID string) (*model.ElectionResult, error)
 	GetRegionResults(ctx context.Context, region string) (*model.RegionResult, error)
+	GetElectionRegionResult(ctx context.Context, electionPairID, region string) (*model.ElectionResult, error)
 	GetOverallStatistics(ctx context.Context) (*model.VoteStatistics, error)
 
 	// Advanced queries
 	GetVoteResultsByDateRange(ctx context.Context, startDate, endDate time.Time, limit, offset int) ([]*model.VoteResult, error)
 	GetElectionResultsByRegion(ctx context.Context, region string) ([]*model.ElectionResult, error)
 	GetRegionStatistics(ctx context.Context) ([]*model.RegionResult, error)
+	// GetElectionResultsByPairs tallies the election pairs nationally, or per
+	// region when byRegion is set.
+	GetElectionResultsByPairs(ctx context.Context, electionPairIDs []string, byRegion bool) ([]*model.ElectionResult, error)
 
 	// Count operations
 	CountVotesByStatus(ctx context.Context, status string) (uint64, error)
@@ -33,7 +37,23 @@ type VoteResultRepository interface {
 	CountVotesByRegion(ctx context.Context, region string) (uint64, error)
 
 	// Time-based aggregations
-	GetVoteResultsByHour(ctx context.Context, date time.Time) ([]*model.VoteResult, error)
-	GetVoteResultsByDay(ctx context.Context, date time.Time) ([]*model.VoteResult, error)
+	GetVoteResultsByHour(ctx context.Context, date time.Time, limit, offset int) ([]*model.VoteResult, error)
+	GetVoteResultsByDay(ctx context.Context, date time.Time, limit, offset int) ([]*model.VoteResult, error)
 	GetDailyStatistics(ctx context.Context, startDate, endDate time.Time) ([]*model.VoteStatistics, error)
+	GetVoteTimeSeries(ctx context.Context, filter model.TimeSeriesFilter) ([]*model.TimeSeriesPoint, error)
+	GetVoteBucketCounts(ctx context.Context, filter model.TimeSeriesFilter) ([]*model.VoteBucketCount, error)
+
+	// Chain reconciliation operations
+	GetVotesToReconcile(ctx context.Context, minBlock uint64, limit int) ([]*model.VoteResult, error)
+	GetVoteResultsByChainStatus(ctx context.Context, chainStatuses []string, limit, offset int) ([]*model.VoteResult, error)
+	UpdateVoteChainStatus(ctx context.Context, result *model.VoteResult) error
+	// GetVotesAboveBlock returns the votes whose transaction was verified or
+	// failed in a block above block.
+	GetVotesAboveBlock(ctx context.Context, block uint64) ([]*model.VoteResult, error)
+	// GetVotesToFinalize returns finalizing votes, least recently checked first.
+	GetVotesToFinalize(ctx context.Context, limit int) ([]*model.VoteResult, error)
+
+	// Bulk export operations
+	StreamVoteResults(ctx context.Context, filter model.VoteResultFilter, fn func(result *model.VoteResult) error) error
+	CountVoteResults(ctx context.Context, filter model.VoteResultFilter) (uint64, error)
 }
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"github.com/nocturna-ta/result/internal/domain/model"
+)
+
+type WebhookRepository interface {
+	// Subscriptions
+	InsertWebhookSubscription(ctx context.Context, subscription *model.WebhookSubscription) error
+	UpdateWebhookSubscription(ctx context.Context, subscription *model.WebhookSubscription) error
+	UpdateWebhookHealth(ctx context.Context, subscription *model.WebhookSubscription) error
+	DeleteWebhookSubscription(ctx context.Context, id string) error
+	GetWebhookSubscription(ctx context.Context, id string) (*model.WebhookSubscription, error)
+	GetWebhookSubscriptions(ctx context.Context) ([]*model.WebhookSubscription, error)
+
+	// Delivery log
+	InsertWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error
+	GetWebhookDeliveries(ctx context.Context, subscriptionID string, limit, offset int) ([]*model.WebhookDelivery, error)
+	GetLatestWebhookDelivery(ctx context.Context, deliveryID string) (*model.WebhookDelivery, error)
+}
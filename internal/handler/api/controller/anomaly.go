@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"context"
+	"github.com/nocturna-ta/golib/custerr"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/golib/response/rest"
+	"github.com/nocturna-ta/golib/router"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/infrastructures/custresp"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"strconv"
+)
+
+// GetAnomalies godoc
+// @Summary List vote stream anomalies
+// @Description List the suspicious patterns flagged in the vote stream, newest first: regional spikes, error rate surges, shared transaction hashes, voters with several vote IDs and votes far from their ingestion time
+// @Tags Results
+// @Accept json
+// @Produce json
+// @Param kind query string false "Anomaly kind: region_spike, error_rate_surge, shared_transaction_hash, duplicate_voter, clock_skew, certified_tally_change"
+// @Param election_pair_id query string false "Election Pair ID"
+// @Param region query string false "Region"
+// @Param start_date query string false "Detected at or after, in RFC3339 format"
+// @Param end_date query string false "Detected at or before, in RFC3339 format"
+// @Param limit query int false "Limit" default(50)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} jsonResponse{data=[]response.AnomalyResponse} "List of anomalies"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/results/anomalies [get]
+func (api *API) GetAnomalies(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetAnomalies")
+	defer span.End()
+
+	limit, err := strconv.Atoi(req.Query("limit", "50"))
+	if err != nil {
+		return custresp.CustomErrorResponse(&custerr.ErrChain{
+			Message: "invalid limit or offset",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		})
+	}
+	offset, err := strconv.Atoi(req.Query("offset", "0"))
+	if err != nil {
+		return custresp.CustomErrorResponse(&custerr.ErrChain{
+			Message: "invalid limit or offset",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		})
+	}
+
+	results, err := api.anomaly.GetAnomalies(ctx, &request.AnomalyRequest{
+		Kind:           req.Query("kind"),
+		ElectionPairID: req.Query("election_pair_id"),
+		Region:         req.Query("region"),
+		StartDate:      req.Query("start_date"),
+		EndDate:        req.Query("end_date"),
+		Limit:          limit,
+		Offset:         offset,
+	})
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(results), nil
+}
@@ -1,11 +1,17 @@
 package controller
 
 import (
+	"github.com/gofiber/fiber/v2/middleware/etag"
 	"github.com/gofiber/swagger"
 	"github.com/nocturna-ta/golib/router"
 	_ "github.com/nocturna-ta/result/docs"
+	"github.com/nocturna-ta/result/internal/infrastructures/auth"
+	"github.com/nocturna-ta/result/internal/infrastructures/health"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
+	"github.com/nocturna-ta/result/internal/infrastructures/throttle"
 	"github.com/nocturna-ta/result/internal/infrastructures/websocket"
 	"github.com/nocturna-ta/result/internal/usecases"
+	"github.com/nocturna-ta/result/pkg/constants"
 	"github.com/nocturna-ta/result/pkg/utils"
 	"html/template"
 	"time"
@@ -20,24 +26,56 @@ type API struct {
 	enableSwagger  bool
 	voteResult     usecases.VoteResultUseCases
 	liveResult     usecases.LiveResultUsecases
+	export         usecases.ExportUseCases
+	pseudonym      usecases.PseudonymUseCases
+	anomaly        usecases.AnomalyUseCases
+	webhook        usecases.WebhookUseCases
+	electionPair   usecases.ElectionPairUseCases
+	region         usecases.RegionUseCases
+	turnout        usecases.TurnoutUseCases
+	certification  usecases.CertificationUseCases
+	merkle         usecases.MerkleUseCases
+	reconciliation usecases.ReconciliationUseCases
 	wsController   *WebSocketController
+	liveness       *health.Checker
+	readiness      *health.Checker
+	auth           *auth.Authenticator
+	throttle       *throttle.Throttle
+
+	exportStreamTimeout time.Duration
 }
 
 type Options struct {
-	Prefix         string
-	Port           uint
-	ReadTimeout    time.Duration
-	WriteTimeout   time.Duration
-	RequestTimeout time.Duration
-	EnableSwagger  bool
-	VoteResult     usecases.VoteResultUseCases
-	LiveResult     usecases.LiveResultUsecases
-	WebSocketHub   *websocket.Hub
+	Prefix              string
+	Port                uint
+	ReadTimeout         time.Duration
+	WriteTimeout        time.Duration
+	RequestTimeout      time.Duration
+	EnableSwagger       bool
+	VoteResult          usecases.VoteResultUseCases
+	LiveResult          usecases.LiveResultUsecases
+	Export              usecases.ExportUseCases
+	ExportStreamTimeout time.Duration
+	Pseudonym           usecases.PseudonymUseCases
+	Anomaly             usecases.AnomalyUseCases
+	Webhook             usecases.WebhookUseCases
+	ElectionPair        usecases.ElectionPairUseCases
+	Region              usecases.RegionUseCases
+	Turnout             usecases.TurnoutUseCases
+	Certification       usecases.CertificationUseCases
+	Merkle              usecases.MerkleUseCases
+	Reconciliation      usecases.ReconciliationUseCases
+	WebSocketHub        *websocket.Hub
+	WebSocketAdmission  *websocket.Admission
+	Liveness            *health.Checker
+	Readiness           *health.Checker
+	Auth                *auth.Authenticator
+	Throttle            *throttle.Throttle
 }
 
 func New(opts *Options) *API {
 
-	wsHandler := websocket.NewHandler(opts.WebSocketHub)
+	wsHandler := websocket.NewHandler(opts.WebSocketHub, opts.WebSocketAdmission)
 
 	wsController := NewWebSocketController(&WebSocketControllerOptions{
 		Handler:           wsHandler,
@@ -53,7 +91,23 @@ func New(opts *Options) *API {
 		enableSwagger:  opts.EnableSwagger,
 		voteResult:     opts.VoteResult,
 		liveResult:     opts.LiveResult,
+		export:         opts.Export,
+		pseudonym:      opts.Pseudonym,
+		anomaly:        opts.Anomaly,
+		webhook:        opts.Webhook,
+		electionPair:   opts.ElectionPair,
+		region:         opts.Region,
+		turnout:        opts.Turnout,
+		certification:  opts.Certification,
+		merkle:         opts.Merkle,
+		reconciliation: opts.Reconciliation,
 		wsController:   wsController,
+		liveness:       opts.Liveness,
+		readiness:      opts.Readiness,
+		auth:           opts.Auth,
+		throttle:       opts.Throttle,
+
+		exportStreamTimeout: opts.ExportStreamTimeout,
 	}
 }
 
@@ -77,31 +131,103 @@ func (api *API) RegisterRoute() *router.FastRouter {
 		myRouter.CustomHandler("GET", "/docs/*", swagger.New(swaggerConfig), router.MustAuthorized(false))
 	}
 
+	myRouter.Use("/", metrics.Middleware())
+	myRouter.Use("/", api.auth.Middleware())
+	if api.throttle != nil {
+		myRouter.Use("/v1", api.throttle.Middleware())
+	}
+
+	// Aggregates carry an ETag so polling clients can revalidate with If-None-Match.
+	aggregateETag := etag.New()
+	myRouter.Use("/v1/results/elections", aggregateETag)
+	myRouter.Use("/v1/results/regions", aggregateETag)
+	myRouter.Use("/v1/results/hierarchy", aggregateETag)
+	myRouter.Use("/v1/results/statistics", aggregateETag)
+	myRouter.Use("/v1/results/standings", aggregateETag)
+	myRouter.Use("/v1/results/turnout", aggregateETag)
+
+	// Aggregates stay public; individual vote rows need at least the observer role.
+	observer := router.WithRoles(constants.RoleObserver, constants.RoleAdmin)
+	admin := router.WithRoles(constants.RoleAdmin)
+
 	myRouter.GET("/health", api.Ping, router.MustAuthorized(false))
+	myRouter.GET("/health/live", api.Liveness, router.MustAuthorized(false))
+	myRouter.GET("/health/ready", api.Readiness, router.MustAuthorized(false))
+	myRouter.CustomHandler("GET", "/metrics", metrics.Handler(), router.MustAuthorized(false))
 	myRouter.Group("/v1", func(v1 *router.FastRouter) {
 		v1.Group("/results", func(results *router.FastRouter) {
-			results.GET("/votes/:id", api.GetVoteResult, router.MustAuthorized(false))
-			results.GET("/votes", api.GetVoteResultByStatus, router.MustAuthorized(false))
+			// Static paths go before /votes/:id, which would match them first.
 			results.GET("/votes/count", api.CountVotesByStatus, router.MustAuthorized(false))
+			results.GET("/votes", api.GetVoteResultByStatus, observer)
+			results.GET("/votes/:id", api.GetVoteResult, observer)
+			results.GET("/votes/:id/proof", api.GetVoteProof, router.MustAuthorized(false))
+			results.GET("/commitments", api.GetCommitments, router.MustAuthorized(false))
+			results.GET("/commitments/:id", api.GetCommitment, router.MustAuthorized(false))
 
 			results.GET("/elections/:election_pair_id", api.GetElectionResults, router.MustAuthorized(false))
-			results.GET("/elections/:election_pair_id/votes", api.GetVoteResultByElectionPair, router.MustAuthorized(false))
+			results.GET("/elections/:election_pair_id/votes", api.GetVoteResultByElectionPair, observer)
 			results.GET("/elections/:election_pair_id/count", api.CountVotesByElectionPair, router.MustAuthorized(false))
 
 			results.GET("/regions", api.GetRegionStatistics, router.MustAuthorized(false))
 			results.GET("/regions/:region", api.GetRegionResults, router.MustAuthorized(false))
-			results.GET("/regions/:region/votes", api.GetVoteResultByRegion, router.MustAuthorized(false))
+			results.GET("/regions/:region/votes", api.GetVoteResultByRegion, observer)
 			results.GET("/regions/:region/elections", api.GetElectionResultsByRegion, router.MustAuthorized(false))
 			results.GET("/regions/:region/count", api.CountVotesByRegion, router.MustAuthorized(false))
+			results.GET("/regions/:code/children", api.GetRegionChildren, router.MustAuthorized(false))
+			results.GET("/hierarchy", api.GetRegionHierarchy, router.MustAuthorized(false))
 
 			results.GET("/statistics", api.GetOverallStatistics, router.MustAuthorized(false))
 			results.GET("/statistics/daily", api.GetDailyStatistics, router.MustAuthorized(false))
+			results.GET("/statistics/timeseries", api.GetTimeSeriesStatistics, router.MustAuthorized(false))
+			results.GET("/statistics/breakdown", api.GetVoteBreakdown, router.MustAuthorized(false))
+
+			results.GET("/standings/:election_id", api.GetNationalStandings, router.MustAuthorized(false))
+			results.GET("/standings/:election_id/regions", api.GetRegionalStandings, router.MustAuthorized(false))
+			results.GET("/standings/:election_id/regions/:region", api.GetRegionStandings, router.MustAuthorized(false))
+
+			results.GET("/turnout", api.GetTurnoutLeaderboard, router.MustAuthorized(false))
+
+			results.GET("/anomalies", api.GetAnomalies, admin)
+
+			results.CustomHandler("GET", "/export", auth.RequireRoles(api.ExportVoteResults, constants.RoleObserver, constants.RoleAdmin), router.MustAuthorized(true))
+			results.POST("/export/jobs", api.CreateExportJob, observer)
+			results.GET("/export/jobs/:id", api.GetExportJob, observer)
+			results.CustomHandler("GET", "/export/jobs/:id/download", auth.RequireRoles(api.DownloadExportJob, constants.RoleObserver, constants.RoleAdmin), router.MustAuthorized(true))
+		})
+
+		v1.GET("/election-pairs", api.GetElectionPairs, router.MustAuthorized(false))
+		v1.GET("/election-pairs/:id", api.GetElectionPair, router.MustAuthorized(false))
+		v1.GET("/registered-voters", api.GetRegisteredVoters, router.MustAuthorized(false))
+		v1.GET("/certifications", api.GetCertifications, router.MustAuthorized(false))
+		v1.GET("/certifications/:id", api.GetCertification, router.MustAuthorized(false))
+
+		v1.Group("/admin", func(adminGroup *router.FastRouter) {
+			adminGroup.POST("/pseudonyms/lookup", api.LookupPseudonym, admin)
+
+			adminGroup.POST("/election-pairs", api.CreateElectionPair, admin)
+			adminGroup.PUT("/election-pairs/:id", api.UpdateElectionPair, admin)
+			adminGroup.DELETE("/election-pairs/:id", api.DeleteElectionPair, admin)
+
+			adminGroup.POST("/registered-voters", api.ImportRegisteredVoters, admin)
+			adminGroup.DELETE("/registered-voters", api.DeleteRegisteredVoters, admin)
+
+			adminGroup.POST("/certifications", api.CertifyResults, admin)
+			adminGroup.GET("/quarantine", api.GetQuarantinedEvents, admin)
+			adminGroup.GET("/reconciliation", api.GetFlaggedVotes, admin)
+
+			adminGroup.POST("/webhooks", api.CreateWebhookSubscription, admin)
+			adminGroup.GET("/webhooks", api.GetWebhookSubscriptions, admin)
+			adminGroup.GET("/webhooks/:id", api.GetWebhookSubscription, admin)
+			adminGroup.PUT("/webhooks/:id", api.UpdateWebhookSubscription, admin)
+			adminGroup.DELETE("/webhooks/:id", api.DeleteWebhookSubscription, admin)
+			adminGroup.GET("/webhooks/:id/deliveries", api.GetWebhookDeliveries, admin)
+			adminGroup.POST("/webhooks/deliveries/:delivery_id/replay", api.ReplayWebhookDelivery, admin)
 		})
 
 		v1.Group("/live", func(live *router.FastRouter) {
 			// REST endpoints for live results management
 			live.GET("/status", api.wsController.GetLiveResultsStatus, router.MustAuthorized(false))
-			live.POST("/broadcast", api.wsController.TriggerBroadcast, router.MustAuthorized(false))
+			live.POST("/broadcast", api.wsController.TriggerBroadcast, admin)
 
 			// WebSocket endpoint - requires special handling
 			live.Use("/ws", api.wsController.WebSocketMiddleware())
@@ -0,0 +1,133 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/nocturna-ta/golib/custerr"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/golib/response/rest"
+	"github.com/nocturna-ta/golib/router"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/infrastructures/custresp"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"strconv"
+)
+
+// CertifyResults godoc
+// @Summary Certify results
+// @Description Freeze the result of a region, or of an election across every region, into an immutable snapshot with its SHA-256 content hash and the certifier. Certified results are served from the snapshot, and later events that would change them are quarantined.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body request.CertificationRequest true "Scope to certify"
+// @Success 200 {object} jsonResponse{data=response.CertificationResponse} "Certification"
+// @Failure 400 {object} jsonResponse "Invalid scope"
+// @Failure 404 {object} jsonResponse "No votes or election pairs to certify"
+// @Failure 409 {object} jsonResponse "Already certified"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/admin/certifications [post]
+func (api *API) CertifyResults(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.CertifyResults")
+	defer span.End()
+
+	var certifyReq request.CertificationRequest
+	if err := json.Unmarshal(req.RawBody(), &certifyReq); err != nil {
+		return custresp.CustomErrorResponse(&custerr.ErrChain{
+			Message: "invalid request body",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		})
+	}
+
+	res, err := api.certification.Certify(ctx, &certifyReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetCertifications godoc
+// @Summary List certifications
+// @Description List the certified regions and elections, oldest first. The snapshot is left out for public callers while disclosure control is enabled.
+// @Tags Certifications
+// @Accept json
+// @Produce json
+// @Success 200 {object} jsonResponse{data=[]response.CertificationResponse} "List of certifications"
+// @Router /v1/certifications [get]
+func (api *API) GetCertifications(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetCertifications")
+	defer span.End()
+
+	res, err := api.certification.GetCertifications(ctx)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetCertification godoc
+// @Summary Get certification
+// @Description Get a certification with its content hash. The snapshot is left out for public callers while disclosure control is enabled.
+// @Tags Certifications
+// @Accept json
+// @Produce json
+// @Param id path string true "Certification ID"
+// @Success 200 {object} jsonResponse{data=response.CertificationResponse} "Certification"
+// @Failure 404 {object} jsonResponse "Certification not found"
+// @Router /v1/certifications/{id} [get]
+func (api *API) GetCertification(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetCertification")
+	defer span.End()
+
+	res, err := api.certification.GetCertification(ctx, req.Params("id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetQuarantinedEvents godoc
+// @Summary List quarantined events
+// @Description List the vote events held back because they would change a certified tally, newest first
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param limit query int false "Limit" default(50)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} jsonResponse{data=[]response.QuarantinedEventResponse} "List of quarantined events"
+// @Failure 400 {object} jsonResponse "Invalid limit or offset"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/admin/quarantine [get]
+func (api *API) GetQuarantinedEvents(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetQuarantinedEvents")
+	defer span.End()
+
+	limit, err := strconv.Atoi(req.Query("limit", "50"))
+	if err != nil {
+		return custresp.CustomErrorResponse(&custerr.ErrChain{
+			Message: "invalid limit or offset",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		})
+	}
+	offset, err := strconv.Atoi(req.Query("offset", "0"))
+	if err != nil {
+		return custresp.CustomErrorResponse(&custerr.ErrChain{
+			Message: "invalid limit or offset",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		})
+	}
+
+	res, err := api.certification.GetQuarantinedEvents(ctx, limit, offset)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
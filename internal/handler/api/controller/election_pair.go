@@ -0,0 +1,219 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/nocturna-ta/golib/custerr"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/golib/response/rest"
+	"github.com/nocturna-ta/golib/router"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/infrastructures/custresp"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+)
+
+// GetElectionPairs godoc
+// @Summary List election pairs
+// @Description List the registered election pairs by election and pair number
+// @Tags Election Pairs
+// @Accept json
+// @Produce json
+// @Param election_id query string false "Election ID"
+// @Success 200 {object} jsonResponse{data=[]response.ElectionPairResponse} "List of election pairs"
+// @Router /v1/election-pairs [get]
+func (api *API) GetElectionPairs(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetElectionPairs")
+	defer span.End()
+
+	res, err := api.electionPair.GetElectionPairs(ctx, req.Query("election_id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetElectionPair godoc
+// @Summary Get election pair
+// @Description Get a registered election pair by the election_pair_id its votes carry
+// @Tags Election Pairs
+// @Accept json
+// @Produce json
+// @Param id path string true "Election Pair ID"
+// @Success 200 {object} jsonResponse{data=response.ElectionPairResponse} "Election pair"
+// @Failure 404 {object} jsonResponse "Election pair not found"
+// @Router /v1/election-pairs/{id} [get]
+func (api *API) GetElectionPair(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetElectionPair")
+	defer span.End()
+
+	res, err := api.electionPair.GetElectionPair(ctx, req.Params("id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// CreateElectionPair godoc
+// @Summary Register election pair
+// @Description Register an election pair. Its ID is the election_pair_id votes carry, and its pair number is unique within the election.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body request.ElectionPairRequest true "Election pair"
+// @Success 200 {object} jsonResponse{data=response.ElectionPairResponse} "Registered election pair"
+// @Failure 400 {object} jsonResponse "Invalid election pair"
+// @Failure 409 {object} jsonResponse "ID or pair number already registered"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/admin/election-pairs [post]
+func (api *API) CreateElectionPair(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.CreateElectionPair")
+	defer span.End()
+
+	var pairReq request.ElectionPairRequest
+	if err := json.Unmarshal(req.RawBody(), &pairReq); err != nil {
+		return custresp.CustomErrorResponse(&custerr.ErrChain{
+			Message: "invalid request body",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		})
+	}
+
+	res, err := api.electionPair.CreateElectionPair(ctx, &pairReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// UpdateElectionPair godoc
+// @Summary Update election pair
+// @Description Replace the election, pair number, candidates and party of a registered election pair. The ID in the body is ignored.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Election Pair ID"
+// @Param request body request.ElectionPairRequest true "Election pair"
+// @Success 200 {object} jsonResponse{data=response.ElectionPairResponse} "Updated election pair"
+// @Failure 400 {object} jsonResponse "Invalid election pair"
+// @Failure 404 {object} jsonResponse "Election pair not found"
+// @Failure 409 {object} jsonResponse "Pair number already registered"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/admin/election-pairs/{id} [put]
+func (api *API) UpdateElectionPair(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.UpdateElectionPair")
+	defer span.End()
+
+	var pairReq request.ElectionPairRequest
+	if err := json.Unmarshal(req.RawBody(), &pairReq); err != nil {
+		return custresp.CustomErrorResponse(&custerr.ErrChain{
+			Message: "invalid request body",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		})
+	}
+
+	res, err := api.electionPair.UpdateElectionPair(ctx, req.Params("id"), &pairReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// DeleteElectionPair godoc
+// @Summary Delete election pair
+// @Description Remove an election pair from the registry. Its votes are kept but no longer ranked.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Election Pair ID"
+// @Success 200 {object} jsonResponse "Election pair deleted"
+// @Failure 404 {object} jsonResponse "Election pair not found"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/admin/election-pairs/{id} [delete]
+func (api *API) DeleteElectionPair(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.DeleteElectionPair")
+	defer span.End()
+
+	if err := api.electionPair.DeleteElectionPair(ctx, req.Params("id")); err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetMessage("election pair deleted"), nil
+}
+
+// GetNationalStandings godoc
+// @Summary Get national standings
+// @Description Rank the registered pairs of an election by confirmed votes nationwide, with vote shares rounded with the largest remainder method so they sum to 100, the winner, the margin over the runner-up and whether the lead is tied
+// @Tags Results
+// @Accept json
+// @Produce json
+// @Param election_id path string true "Election ID"
+// @Success 200 {object} jsonResponse{data=response.StandingsResponse} "National standings"
+// @Failure 404 {object} jsonResponse "No election pairs registered for the election"
+// @Failure 451 {object} jsonResponse "Results embargoed until polls close"
+// @Router /v1/results/standings/{election_id} [get]
+func (api *API) GetNationalStandings(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetNationalStandings")
+	defer span.End()
+
+	res, err := api.electionPair.GetNationalStandings(ctx, req.Params("election_id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetRegionalStandings godoc
+// @Summary Get standings of every region
+// @Description Rank the registered pairs of an election in every region with votes, by region. Regions under embargo are left out.
+// @Tags Results
+// @Accept json
+// @Produce json
+// @Param election_id path string true "Election ID"
+// @Success 200 {object} jsonResponse{data=[]response.StandingsResponse} "Standings by region"
+// @Failure 404 {object} jsonResponse "No election pairs registered for the election"
+// @Failure 451 {object} jsonResponse "Results embargoed until polls close"
+// @Router /v1/results/standings/{election_id}/regions [get]
+func (api *API) GetRegionalStandings(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetRegionalStandings")
+	defer span.End()
+
+	res, err := api.electionPair.GetRegionalStandings(ctx, req.Params("election_id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetRegionStandings godoc
+// @Summary Get standings in a region
+// @Description Rank the registered pairs of an election by confirmed votes in a region
+// @Tags Results
+// @Accept json
+// @Produce json
+// @Param election_id path string true "Election ID"
+// @Param region path string true "Region"
+// @Success 200 {object} jsonResponse{data=response.StandingsResponse} "Region standings"
+// @Failure 404 {object} jsonResponse "No votes for the election in the region"
+// @Failure 451 {object} jsonResponse "Results embargoed until polls close"
+// @Router /v1/results/standings/{election_id}/regions/{region} [get]
+func (api *API) GetRegionStandings(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetRegionStandings")
+	defer span.End()
+
+	res, err := api.electionPair.GetRegionStandings(ctx, req.Params("election_id"), req.Params("region"))
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
@@ -0,0 +1,181 @@
+package controller
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/golib/log"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/golib/response/rest"
+	"github.com/nocturna-ta/golib/router"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/infrastructures/custresp"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"time"
+)
+
+// ExportVoteResults godoc
+// @Summary Export vote results
+// @Description Stream every vote result matching the filter as CSV, newline-delimited JSON or Parquet. Rows are streamed straight from the database, so there is no row limit.
+// @Tags Export
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Produce application/vnd.apache.parquet
+// @Param format query string false "Export format: csv, ndjson, parquet" default(csv)
+// @Param election_pair_id query string false "Election Pair ID"
+// @Param region query string false "Region"
+// @Param status query string false "Vote Result Status"
+// @Param start_date query string false "Start date in YYYY-MM-DD or RFC3339 format"
+// @Param end_date query string false "End date in YYYY-MM-DD or RFC3339 format"
+// @Success 200 {file} file "Exported vote results"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/results/export [get]
+func (api *API) ExportVoteResults(c *fiber.Ctx) error {
+	span, ctx := tracing.StartSpanFromContext(c.UserContext(), "ResultController.ExportVoteResults")
+	defer span.End()
+
+	req := &request.ExportRequest{
+		Format:         c.Query("format", "csv"),
+		ElectionPairID: c.Query("election_pair_id"),
+		Region:         c.Query("region"),
+		Status:         c.Query("status"),
+		StartDate:      c.Query("start_date"),
+		EndDate:        c.Query("end_date"),
+	}
+
+	file, err := api.export.PrepareExport(ctx, req)
+	if err != nil {
+		resp, _ := custresp.CustomErrorResponse(err)
+		return resp.Send(c)
+	}
+
+	c.Set(fiber.HeaderContentType, file.ContentType)
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, file.FileName))
+
+	// The body is written by fasthttp after this handler returns, when the
+	// request context has already been cancelled by the router.
+	streamCtx := context.WithoutCancel(ctx)
+	streamTimeout := api.exportStreamTimeout
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		ctx := streamCtx
+		if streamTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, streamTimeout)
+			defer cancel()
+		}
+
+		startedAt := time.Now()
+		rows, err := api.export.StreamVoteResults(ctx, req, w)
+		if err == nil {
+			err = w.Flush()
+		}
+
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error":  err,
+				"format": req.Format,
+				"rows":   rows,
+			}).ErrorWithCtx(ctx, "[ResultController.ExportVoteResults] Export stream aborted")
+			return
+		}
+
+		log.WithFields(log.Fields{
+			"format":   req.Format,
+			"rows":     rows,
+			"duration": time.Since(startedAt).String(),
+		}).InfoWithCtx(ctx, "[ResultController.ExportVoteResults] Export stream completed")
+	})
+
+	return nil
+}
+
+// CreateExportJob godoc
+// @Summary Create export job
+// @Description Queue an asynchronous export of every vote result matching the filter. Poll the job and download the file once it is completed.
+// @Tags Export
+// @Accept json
+// @Produce json
+// @Param request body request.ExportRequest true "Export format and filter"
+// @Success 202 {object} jsonResponse{data=response.ExportJobResponse} "Export job queued"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/results/export/jobs [post]
+func (api *API) CreateExportJob(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.CreateExportJob")
+	defer span.End()
+
+	var exportReq request.ExportRequest
+	if err := json.Unmarshal(req.RawBody(), &exportReq); err != nil {
+		return custresp.CustomErrorResponse(&custerr.ErrChain{
+			Message: "invalid request body",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		})
+	}
+
+	job, err := api.export.CreateExportJob(ctx, &exportReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetCode(fiber.StatusAccepted).SetData(job), nil
+}
+
+// GetExportJob godoc
+// @Summary Get export job
+// @Description Get the status and progress of an export job. Only the user who created the job sees it.
+// @Tags Export
+// @Accept json
+// @Produce json
+// @Param id path string true "Export Job ID"
+// @Success 200 {object} jsonResponse{data=response.ExportJobResponse} "Export job"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/results/export/jobs/{id} [get]
+func (api *API) GetExportJob(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetExportJob")
+	defer span.End()
+
+	job, err := api.export.GetExportJob(ctx, req.Params("id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(job), nil
+}
+
+// DownloadExportJob godoc
+// @Summary Download export job file
+// @Description Download the file produced by a completed export job. Only the user who created the job can download it.
+// @Tags Export
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Produce application/vnd.apache.parquet
+// @Param id path string true "Export Job ID"
+// @Success 200 {file} file "Exported vote results"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/results/export/jobs/{id}/download [get]
+func (api *API) DownloadExportJob(c *fiber.Ctx) error {
+	span, ctx := tracing.StartSpanFromContext(c.UserContext(), "ResultController.DownloadExportJob")
+	defer span.End()
+
+	file, err := api.export.GetExportJobFile(ctx, c.Params("id"))
+	if err != nil {
+		resp, _ := custresp.CustomErrorResponse(err)
+		return resp.Send(c)
+	}
+
+	c.Attachment(file.FileName)
+	if err = c.SendFile(file.Path); err != nil {
+		return err
+	}
+	c.Set(fiber.HeaderContentType, file.ContentType)
+
+	return nil
+}
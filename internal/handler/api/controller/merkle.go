@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"context"
+	"github.com/nocturna-ta/golib/response/rest"
+	"github.com/nocturna-ta/golib/router"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/infrastructures/custresp"
+)
+
+// GetVoteProof godoc
+// @Summary Get vote inclusion proof
+// @Description Get the Merkle inclusion path of a confirmed vote in the latest published root of its election and region. The leaf is SHA-256(0x00 || vote_id || 0x00 || election_pair_id || 0x00 || region || 0x00 || transaction_hash); hashing it up the path with SHA-256(0x01 || left || right), each sibling on its position, must give the root.
+// @Tags Results
+// @Accept json
+// @Produce json
+// @Param id path string true "Vote ID"
+// @Success 200 {object} jsonResponse{data=response.MerkleProofResponse} "Inclusion proof"
+// @Failure 404 {object} jsonResponse "Vote not committed yet"
+// @Failure 451 {object} jsonResponse "Results embargoed until polls close"
+// @Router /v1/results/votes/{id}/proof [get]
+func (api *API) GetVoteProof(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetVoteProof")
+	defer span.End()
+
+	res, err := api.merkle.GetVoteProof(ctx, req.Params("id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetCommitments godoc
+// @Summary List Merkle commitments
+// @Description List the latest published Merkle root over the confirmed votes of every election and region. Commitments under embargo are left out.
+// @Tags Results
+// @Accept json
+// @Produce json
+// @Param election_id query string false "Election ID"
+// @Success 200 {object} jsonResponse{data=[]response.MerkleCommitmentResponse} "Latest commitments"
+// @Router /v1/results/commitments [get]
+func (api *API) GetCommitments(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetCommitments")
+	defer span.End()
+
+	res, err := api.merkle.GetCommitments(ctx, req.Query("election_id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetCommitment godoc
+// @Summary Get Merkle commitment
+// @Description Get a published Merkle root, including roots since superseded
+// @Tags Results
+// @Accept json
+// @Produce json
+// @Param id path string true "Commitment ID"
+// @Success 200 {object} jsonResponse{data=response.MerkleCommitmentResponse} "Commitment"
+// @Failure 404 {object} jsonResponse "Commitment not found"
+// @Failure 451 {object} jsonResponse "Results embargoed until polls close"
+// @Router /v1/results/commitments/{id} [get]
+func (api *API) GetCommitment(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetCommitment")
+	defer span.End()
+
+	res, err := api.merkle.GetCommitment(ctx, req.Params("id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
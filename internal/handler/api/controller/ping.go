@@ -5,6 +5,8 @@ import (
 	"github.com/nocturna-ta/golib/response/rest"
 	"github.com/nocturna-ta/golib/router"
 	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/infrastructures/health"
+	"net/http"
 )
 
 // Ping godoc
@@ -25,3 +27,41 @@ func (api *API) Ping(ctx context.Context, req *router.Request) (*rest.JSONRespon
 
 	return rest.NewJSONResponse().SetData("ok"), nil
 }
+
+// Liveness godoc
+// @Summary 	Liveness probe
+// @Description	Check that the hub run loop and the periodic broadcaster are making progress. Responds 503 when any of them is stalled.
+// @Tags		Health
+// @Produce		json
+// @Success		200	{object}	jsonResponse{data=health.Report}
+// @Failure		503	{object}	jsonResponse{data=health.Report}
+// @Router		/health/live	[get]
+func (api *API) Liveness(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.Liveness")
+	defer span.End()
+
+	return healthResponse(api.liveness.Check(ctx)), nil
+}
+
+// Readiness godoc
+// @Summary 	Readiness probe
+// @Description	Check ClickHouse primary and replica, the hub run loop and the periodic broadcaster, with per-component status and latency. Responds 503 when any of them is down.
+// @Tags		Health
+// @Produce		json
+// @Success		200	{object}	jsonResponse{data=health.Report}
+// @Failure		503	{object}	jsonResponse{data=health.Report}
+// @Router		/health/ready	[get]
+func (api *API) Readiness(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "Controller.Readiness")
+	defer span.End()
+
+	return healthResponse(api.readiness.Check(ctx)), nil
+}
+
+func healthResponse(report *health.Report) *rest.JSONResponse {
+	resp := rest.NewJSONResponse().SetData(report)
+	if report.Status != health.StatusUp {
+		resp.SetCode(http.StatusServiceUnavailable)
+	}
+	return resp
+}
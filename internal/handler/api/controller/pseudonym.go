@@ -0,0 +1,46 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/nocturna-ta/golib/custerr"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/golib/response/rest"
+	"github.com/nocturna-ta/golib/router"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/infrastructures/custresp"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+)
+
+// LookupPseudonym godoc
+// @Summary Look up voter pseudonym
+// @Description Resolve a voter pseudonym to the voter ID. Every lookup is recorded in the audit log with the caller and the reason, whether or not the pseudonym is found.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body request.PseudonymLookupRequest true "Pseudonym and the reason for the lookup"
+// @Success 200 {object} jsonResponse{data=response.PseudonymLookupResponse} "Voter ID behind the pseudonym"
+// @Failure 404 {object} jsonResponse "Pseudonym not found"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/admin/pseudonyms/lookup [post]
+func (api *API) LookupPseudonym(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.LookupPseudonym")
+	defer span.End()
+
+	var lookupReq request.PseudonymLookupRequest
+	if err := json.Unmarshal(req.RawBody(), &lookupReq); err != nil {
+		return custresp.CustomErrorResponse(&custerr.ErrChain{
+			Message: "invalid request body",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		})
+	}
+
+	res, err := api.pseudonym.LookupPseudonym(ctx, &lookupReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
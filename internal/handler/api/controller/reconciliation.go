@@ -0,0 +1,55 @@
+package controller
+
+import (
+	"context"
+	"github.com/nocturna-ta/golib/custerr"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/golib/response/rest"
+	"github.com/nocturna-ta/golib/router"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/infrastructures/custresp"
+	"strconv"
+)
+
+// GetFlaggedVotes godoc
+// @Summary List votes flagged by reconciliation
+// @Description List confirmed votes whose transaction is missing, failed or reverted on chain, with the block they were last seen in
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param status query string false "Chain status" Enums(missing, failed, reverted)
+// @Param limit query int false "Limit" default(50)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} jsonResponse{data=[]response.VoteResultResponse} "List of flagged votes"
+// @Failure 400 {object} jsonResponse "Invalid status, limit or offset"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/admin/reconciliation [get]
+func (api *API) GetFlaggedVotes(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetFlaggedVotes")
+	defer span.End()
+
+	limit, err := strconv.Atoi(req.Query("limit", "50"))
+	if err != nil {
+		return custresp.CustomErrorResponse(&custerr.ErrChain{
+			Message: "invalid limit or offset",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		})
+	}
+	offset, err := strconv.Atoi(req.Query("offset", "0"))
+	if err != nil {
+		return custresp.CustomErrorResponse(&custerr.ErrChain{
+			Message: "invalid limit or offset",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		})
+	}
+
+	res, err := api.reconciliation.GetFlaggedVotes(ctx, req.Query("status", ""), limit, offset)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
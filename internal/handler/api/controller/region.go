@@ -0,0 +1,58 @@
+package controller
+
+import (
+	"context"
+	"github.com/nocturna-ta/golib/response/rest"
+	"github.com/nocturna-ta/golib/router"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/infrastructures/custresp"
+)
+
+// GetRegionHierarchy godoc
+// @Summary Get region hierarchy results
+// @Description Get the national vote count rolled up from the provinces of the region hierarchy, and the regions votes carry that match no polling station
+// @Tags Results
+// @Accept json
+// @Produce json
+// @Param If-None-Match header string false "ETag of a previous response"
+// @Success 200 {object} jsonResponse{data=response.RegionHierarchyResponse} "National count by province"
+// @Failure 404 {object} jsonResponse "Region hierarchy not configured"
+// @Failure 451 {object} jsonResponse "Results embargoed until polls close"
+// @Header 200 {string} ETag "Entity tag of the response body"
+// @Router /v1/results/hierarchy [get]
+func (api *API) GetRegionHierarchy(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetRegionHierarchy")
+	defer span.End()
+
+	res, err := api.region.GetRegionHierarchy(ctx)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetRegionChildren godoc
+// @Summary Get region children results
+// @Description Drill down the region hierarchy: the vote count of a province, regency, district or polling station and of each region one level below it, which add up to it before disclosure control
+// @Tags Results
+// @Accept json
+// @Produce json
+// @Param code path string true "Region code"
+// @Param If-None-Match header string false "ETag of a previous response"
+// @Success 200 {object} jsonResponse{data=response.RegionChildrenResponse} "Region count by child region"
+// @Failure 404 {object} jsonResponse "Region not found in the region hierarchy"
+// @Failure 451 {object} jsonResponse "Results embargoed until polls close"
+// @Header 200 {string} ETag "Entity tag of the response body"
+// @Router /v1/results/regions/{code}/children [get]
+func (api *API) GetRegionChildren(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetRegionChildren")
+	defer span.End()
+
+	res, err := api.region.GetRegionChildren(ctx, req.Params("code"))
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
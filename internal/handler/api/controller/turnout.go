@@ -0,0 +1,143 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/nocturna-ta/golib/custerr"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/golib/response/rest"
+	"github.com/nocturna-ta/golib/router"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/infrastructures/custresp"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"strconv"
+)
+
+// GetTurnoutLeaderboard godoc
+// @Summary Get turnout leaderboard
+// @Description Rank the regions with registered voters by turnout, the votes received over the registered voters. With an election, only votes for its registered pairs count. Regions under embargo are left out.
+// @Tags Results
+// @Accept json
+// @Produce json
+// @Param election_id query string false "Election ID"
+// @Param order query string false "desc for the highest turnout first, asc for the lowest" default(desc)
+// @Param limit query int false "Limit" default(20)
+// @Param If-None-Match header string false "ETag of a previous response"
+// @Success 200 {object} jsonResponse{data=response.TurnoutLeaderboardResponse} "Regions by turnout"
+// @Failure 400 {object} jsonResponse "Invalid order or limit"
+// @Failure 404 {object} jsonResponse "No registered voters imported"
+// @Failure 451 {object} jsonResponse "Results embargoed until polls close"
+// @Header 200 {string} ETag "Entity tag of the response body"
+// @Router /v1/results/turnout [get]
+func (api *API) GetTurnoutLeaderboard(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetTurnoutLeaderboard")
+	defer span.End()
+
+	limit, err := strconv.Atoi(req.Query("limit", "20"))
+	if err != nil {
+		return custresp.CustomErrorResponse(&custerr.ErrChain{
+			Message: "invalid limit",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		})
+	}
+
+	var ascending bool
+	switch req.Query("order", "desc") {
+	case "desc":
+	case "asc":
+		ascending = true
+	default:
+		return custresp.CustomErrorResponse(&custerr.ErrChain{
+			Message: "order must be asc or desc",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		})
+	}
+
+	res, err := api.turnout.GetTurnoutLeaderboard(ctx, req.Query("election_id"), limit, ascending)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetRegisteredVoters godoc
+// @Summary List registered voters
+// @Description List the registered voter counts in effect by election and region. With an election, its counts and the region-wide counts are listed.
+// @Tags Registered Voters
+// @Accept json
+// @Produce json
+// @Param election_id query string false "Election ID"
+// @Success 200 {object} jsonResponse{data=[]response.RegisteredVotersResponse} "Registered voter counts"
+// @Router /v1/registered-voters [get]
+func (api *API) GetRegisteredVoters(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetRegisteredVoters")
+	defer span.End()
+
+	res, err := api.turnout.GetRegisteredVoters(ctx, req.Query("election_id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// ImportRegisteredVoters godoc
+// @Summary Import registered voters
+// @Description Import registered voter counts by election and region. A count replaces the one of the same election and region; a count without election applies to every election held in the region.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body request.RegisteredVotersImportRequest true "Registered voter counts"
+// @Success 200 {object} jsonResponse{data=response.RegisteredVotersImportResponse} "Imported counts"
+// @Failure 400 {object} jsonResponse "Invalid registered voter counts"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/admin/registered-voters [post]
+func (api *API) ImportRegisteredVoters(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.ImportRegisteredVoters")
+	defer span.End()
+
+	var importReq request.RegisteredVotersImportRequest
+	if err := json.Unmarshal(req.RawBody(), &importReq); err != nil {
+		return custresp.CustomErrorResponse(&custerr.ErrChain{
+			Message: "invalid request body",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		})
+	}
+
+	res, err := api.turnout.ImportRegisteredVoters(ctx, &importReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// DeleteRegisteredVoters godoc
+// @Summary Delete registered voters
+// @Description Remove the registered voter count of an election in a region, or the region-wide count when no election is given
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param election_id query string false "Election ID"
+// @Param region query string true "Region"
+// @Success 200 {object} jsonResponse "Registered voters deleted"
+// @Failure 400 {object} jsonResponse "Region is required"
+// @Failure 404 {object} jsonResponse "Registered voters not found"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/admin/registered-voters [delete]
+func (api *API) DeleteRegisteredVoters(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.DeleteRegisteredVoters")
+	defer span.End()
+
+	if err := api.turnout.DeleteRegisteredVoters(ctx, req.Query("election_id"), req.Query("region")); err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetMessage("registered voters deleted"), nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/nocturna-ta/golib/router"
 	"github.com/nocturna-ta/golib/tracing"
 	"github.com/nocturna-ta/result/internal/infrastructures/custresp"
+	"github.com/nocturna-ta/result/internal/usecases/request"
 	"strconv"
 	"time"
 )
@@ -19,6 +20,8 @@ import (
 // @Produce json
 // @Param id path string true "Vote Result ID"
 // @Success 200 {object} jsonResponse{data=response.VoteResultResponse} "Vote result data"
+// @Security BearerAuth
+// @Security ApiKeyAuth
 // @Router /v1/results/votes/{id} [get]
 func (api *API) GetVoteResult(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetVoteResult")
@@ -50,6 +53,8 @@ func (api *API) GetVoteResult(ctx context.Context, req *router.Request) (*rest.J
 // @Param limit query int false "Limit the number of results" default(50)
 // @Param offset query int false "Offset for pagination" default(0)
 // @Success 200 {object} jsonResponse{data=[]response.VoteResultResponse} "List of vote results"
+// @Security BearerAuth
+// @Security ApiKeyAuth
 // @Router /v1/results/elections/{election_pair_id}/votes [get]
 func (api *API) GetVoteResultByElectionPair(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetVoteResultByElectionPair")
@@ -83,6 +88,8 @@ func (api *API) GetVoteResultByElectionPair(ctx context.Context, req *router.Req
 // @Param limit query int false "Limit the number of results" default(50)
 // @Param offset query int false "Offset for pagination" default(0)
 // @Success 200 {object} jsonResponse{data=[]response.VoteResultResponse} "List of vote results"
+// @Security BearerAuth
+// @Security ApiKeyAuth
 // @Router /v1/results/regions/{region}/votes [get]
 func (api *API) GetVoteResultByRegion(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetVoteResultByRegion")
@@ -116,6 +123,8 @@ func (api *API) GetVoteResultByRegion(ctx context.Context, req *router.Request)
 // @Param limit query int false "Limit the number of results" default(50)
 // @Param offset query int false "Offset for pagination" default(0)
 // @Success 200 {object} jsonResponse{data=[]response.VoteResultResponse} "List of vote results"
+// @Security BearerAuth
+// @Security ApiKeyAuth
 // @Router /v1/results/votes [get]
 func (api *API) GetVoteResultByStatus(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetVoteResultByStatus")
@@ -146,7 +155,10 @@ func (api *API) GetVoteResultByStatus(ctx context.Context, req *router.Request)
 // @Accept json
 // @Produce json
 // @Param election_pair_id path string true "Election Pair ID"
+// @Param If-None-Match header string false "ETag of a previous response"
 // @Success 200 {object} jsonResponse{data=response.ElectionVoteResultResponse} "Election results data"
+// @Failure 451 {object} jsonResponse "Results embargoed until polls close"
+// @Header 200 {string} ETag "Entity tag of the response body"
 // @Router /v1/results/elections/{election_pair_id} [get]
 func (api *API) GetElectionResults(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetElectionResults")
@@ -169,6 +181,7 @@ func (api *API) GetElectionResults(ctx context.Context, req *router.Request) (*r
 // @Produce json
 // @Param region path string true "Region"
 // @Success 200 {object} jsonResponse{data=[]response.ElectionVoteResultResponse} "List of election results"
+// @Failure 451 {object} jsonResponse "Results embargoed until polls close"
 // @Router /v1/results/regions/{region}/elections [get]
 func (api *API) GetElectionResultsByRegion(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetElectionResultsByRegion")
@@ -192,6 +205,7 @@ func (api *API) GetElectionResultsByRegion(ctx context.Context, req *router.Requ
 // @Produce json
 // @Param region path string true "Region"
 // @Success 200 {object} jsonResponse{data=response.RegionVoteResultResponse} "Region results data"
+// @Failure 451 {object} jsonResponse "Results embargoed until polls close"
 // @Router /v1/results/regions/{region} [get]
 func (api *API) GetRegionResults(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetRegionResults")
@@ -213,7 +227,10 @@ func (api *API) GetRegionResults(ctx context.Context, req *router.Request) (*res
 // @Tags Results
 // @Accept json
 // @Produce json
+// @Param If-None-Match header string false "ETag of a previous response"
 // @Success 200 {object} jsonResponse{data=[]response.RegionVoteResultResponse} "List of region statistics"
+// @Failure 451 {object} jsonResponse "Results embargoed until polls close"
+// @Header 200 {string} ETag "Entity tag of the response body"
 // @Router /v1/results/regions [get]
 func (api *API) GetRegionStatistics(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetRegionStatistics")
@@ -233,7 +250,10 @@ func (api *API) GetRegionStatistics(ctx context.Context, req *router.Request) (*
 // @Tags Results
 // @Accept json
 // @Produce json
+// @Param If-None-Match header string false "ETag of a previous response"
 // @Success 200 {object} jsonResponse{data=response.VoteStatisticsResponse} "Overall vote statistics data"
+// @Failure 451 {object} jsonResponse "Results embargoed until polls close"
+// @Header 200 {string} ETag "Entity tag of the response body"
 // @Router /v1/results/statistics [get]
 func (api *API) GetOverallStatistics(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetOverallStatistics")
@@ -255,7 +275,10 @@ func (api *API) GetOverallStatistics(ctx context.Context, req *router.Request) (
 // @Produce json
 // @Param start_date query string true "Start date in YYYY-MM-DD format"
 // @Param end_date query string true "End date in YYYY-MM-DD format"
+// @Param If-None-Match header string false "ETag of a previous response"
 // @Success 200 {object} jsonResponse{data=[]response.VoteStatisticsResponse} "List of daily vote statistics"
+// @Failure 451 {object} jsonResponse "Results embargoed until polls close"
+// @Header 200 {string} ETag "Entity tag of the response body"
 // @Router /v1/results/statistics/daily [get]
 func (api *API) GetDailyStatistics(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetDailyStatistics")
@@ -288,6 +311,82 @@ func (api *API) GetDailyStatistics(ctx context.Context, req *router.Request) (*r
 	return rest.NewJSONResponse().SetData(results), nil
 }
 
+// GetTimeSeriesStatistics godoc
+// @Summary Get vote time series
+// @Description Get vote counts per time bucket, bucketed on the wall clock of the given time zone. Every bucket in the range is returned, with zero counts where no vote fell. Without dates the series covers the last 24 hours, or the last 30 days for day buckets.
+// @Tags Results
+// @Accept json
+// @Produce json
+// @Param tz query string false "IANA time zone, e.g. Asia/Jakarta, Asia/Makassar, Asia/Jayapura. Defaults to the configured zone"
+// @Param bucket query string false "Bucket size: minute, 15m, hour, day" default(hour)
+// @Param axis query string false "Timestamp to bucket on: voted_at, processed_at, created_at" default(voted_at)
+// @Param start_date query string false "Start in YYYY-MM-DD (local midnight) or RFC3339 format"
+// @Param end_date query string false "End in YYYY-MM-DD (whole day included) or RFC3339 format"
+// @Param election_pair_id query string false "Election Pair ID"
+// @Param region query string false "Region"
+// @Param If-None-Match header string false "ETag of a previous response"
+// @Success 200 {object} jsonResponse{data=response.TimeSeriesResponse} "Gap-filled vote time series"
+// @Failure 451 {object} jsonResponse "Results embargoed until polls close"
+// @Header 200 {string} ETag "Entity tag of the response body"
+// @Router /v1/results/statistics/timeseries [get]
+func (api *API) GetTimeSeriesStatistics(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetTimeSeriesStatistics")
+	defer span.End()
+
+	result, err := api.voteResult.GetTimeSeriesStatistics(ctx, &request.TimeSeriesRequest{
+		TimeZone:       req.Query("tz"),
+		Bucket:         req.Query("bucket"),
+		Axis:           req.Query("axis"),
+		StartDate:      req.Query("start_date"),
+		EndDate:        req.Query("end_date"),
+		ElectionPairID: req.Query("election_pair_id"),
+		Region:         req.Query("region"),
+	})
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(result), nil
+}
+
+// GetVoteBreakdown godoc
+// @Summary Get vote breakdown per time bucket
+// @Description Get vote counts per time bucket, election pair, region and status, bucketed on the wall clock of the given time zone. Only combinations with at least one vote are returned. Without dates the breakdown covers the last 24 hours, or the last 30 days for day buckets.
+// @Tags Results
+// @Accept json
+// @Produce json
+// @Param tz query string false "IANA time zone, e.g. Asia/Jakarta, Asia/Makassar, Asia/Jayapura. Defaults to the configured zone"
+// @Param bucket query string false "Bucket size: minute, 15m, hour, day" default(hour)
+// @Param axis query string false "Timestamp to bucket on: voted_at, processed_at, created_at" default(voted_at)
+// @Param start_date query string false "Start in YYYY-MM-DD (local midnight) or RFC3339 format"
+// @Param end_date query string false "End in YYYY-MM-DD (whole day included) or RFC3339 format"
+// @Param election_pair_id query string false "Election Pair ID"
+// @Param region query string false "Region"
+// @Param If-None-Match header string false "ETag of a previous response"
+// @Success 200 {object} jsonResponse{data=response.VoteBreakdownResponse} "Vote counts per bucket"
+// @Failure 451 {object} jsonResponse "Results embargoed until polls close"
+// @Header 200 {string} ETag "Entity tag of the response body"
+// @Router /v1/results/statistics/breakdown [get]
+func (api *API) GetVoteBreakdown(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetVoteBreakdown")
+	defer span.End()
+
+	result, err := api.voteResult.GetVoteBreakdown(ctx, &request.TimeSeriesRequest{
+		TimeZone:       req.Query("tz"),
+		Bucket:         req.Query("bucket"),
+		Axis:           req.Query("axis"),
+		StartDate:      req.Query("start_date"),
+		EndDate:        req.Query("end_date"),
+		ElectionPairID: req.Query("election_pair_id"),
+		Region:         req.Query("region"),
+	})
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(result), nil
+}
+
 // CountVotesByStatus godoc
 // @Summary Count votes by status
 // @Description Count the number of votes with a specific status
@@ -296,6 +395,7 @@ func (api *API) GetDailyStatistics(ctx context.Context, req *router.Request) (*r
 // @Produce json
 // @Param status query string true "Vote Result Status"
 // @Success 200 {object} jsonResponse{data=map[string]any} "Count of votes"
+// @Failure 451 {object} jsonResponse "Results embargoed until polls close"
 // @Router /v1/results/votes/count [get]
 func (api *API) CountVotesByStatus(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.CountVotesByStatus")
@@ -323,6 +423,7 @@ func (api *API) CountVotesByStatus(ctx context.Context, req *router.Request) (*r
 // @Produce json
 // @Param election_pair_id path string true "Election Pair ID"
 // @Success 200 {object} jsonResponse{data=map[string]any} "Count of votes"
+// @Failure 451 {object} jsonResponse "Results embargoed until polls close"
 // @Router /v1/results/elections/{election_pair_id}/count [get]
 func (api *API) CountVotesByElectionPair(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.CountVotesByElectionPair")
@@ -348,6 +449,7 @@ func (api *API) CountVotesByElectionPair(ctx context.Context, req *router.Reques
 // @Produce json
 // @Param region path string true "Region"
 // @Success 200 {object} jsonResponse{data=map[string]any} "Count of votes"
+// @Failure 451 {object} jsonResponse "Results embargoed until polls close"
 // @Router /v1/results/regions/{region}/count [get]
 func (api *API) CountVotesByRegion(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.CountVotesByRegion")
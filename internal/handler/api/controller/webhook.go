@@ -0,0 +1,218 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/nocturna-ta/golib/custerr"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/golib/response/rest"
+	"github.com/nocturna-ta/golib/router"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/infrastructures/custresp"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"strconv"
+)
+
+// CreateWebhookSubscription godoc
+// @Summary Create webhook subscription
+// @Description Register an endpoint to receive live result events as signed POST requests. Each request carries X-Webhook-Id, X-Webhook-Event, X-Webhook-Timestamp and X-Webhook-Signature, the hex HMAC-SHA256 of "timestamp.body" keyed with the secret. A secret is generated when none is given and is only returned here.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body request.WebhookSubscriptionRequest true "Endpoint, secret and event filters"
+// @Success 200 {object} jsonResponse{data=response.WebhookSubscriptionResponse} "Created subscription, with its secret"
+// @Failure 400 {object} jsonResponse "Invalid subscription"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/admin/webhooks [post]
+func (api *API) CreateWebhookSubscription(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.CreateWebhookSubscription")
+	defer span.End()
+
+	var subscriptionReq request.WebhookSubscriptionRequest
+	if err := json.Unmarshal(req.RawBody(), &subscriptionReq); err != nil {
+		return custresp.CustomErrorResponse(&custerr.ErrChain{
+			Message: "invalid request body",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		})
+	}
+
+	res, err := api.webhook.CreateWebhookSubscription(ctx, &subscriptionReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetWebhookSubscriptions godoc
+// @Summary List webhook subscriptions
+// @Description List the webhook subscriptions with their delivery health
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Success 200 {object} jsonResponse{data=[]response.WebhookSubscriptionResponse} "List of subscriptions"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/admin/webhooks [get]
+func (api *API) GetWebhookSubscriptions(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetWebhookSubscriptions")
+	defer span.End()
+
+	res, err := api.webhook.GetWebhookSubscriptions(ctx)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// GetWebhookSubscription godoc
+// @Summary Get webhook subscription
+// @Description Get a webhook subscription with its delivery health
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Success 200 {object} jsonResponse{data=response.WebhookSubscriptionResponse} "Subscription"
+// @Failure 404 {object} jsonResponse "Subscription not found"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/admin/webhooks/{id} [get]
+func (api *API) GetWebhookSubscription(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetWebhookSubscription")
+	defer span.End()
+
+	res, err := api.webhook.GetWebhookSubscription(ctx, req.Params("id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// UpdateWebhookSubscription godoc
+// @Summary Update webhook subscription
+// @Description Replace the endpoint and filters of a webhook subscription. The secret is kept when omitted. Reactivating a subscription resets its failure count.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Param request body request.WebhookSubscriptionRequest true "Endpoint, secret and event filters"
+// @Success 200 {object} jsonResponse{data=response.WebhookSubscriptionResponse} "Updated subscription"
+// @Failure 400 {object} jsonResponse "Invalid subscription"
+// @Failure 404 {object} jsonResponse "Subscription not found"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/admin/webhooks/{id} [put]
+func (api *API) UpdateWebhookSubscription(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.UpdateWebhookSubscription")
+	defer span.End()
+
+	var subscriptionReq request.WebhookSubscriptionRequest
+	if err := json.Unmarshal(req.RawBody(), &subscriptionReq); err != nil {
+		return custresp.CustomErrorResponse(&custerr.ErrChain{
+			Message: "invalid request body",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		})
+	}
+
+	res, err := api.webhook.UpdateWebhookSubscription(ctx, req.Params("id"), &subscriptionReq)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// DeleteWebhookSubscription godoc
+// @Summary Delete webhook subscription
+// @Description Delete a webhook subscription. Its delivery log is kept.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Success 200 {object} jsonResponse "Subscription deleted"
+// @Failure 404 {object} jsonResponse "Subscription not found"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/admin/webhooks/{id} [delete]
+func (api *API) DeleteWebhookSubscription(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.DeleteWebhookSubscription")
+	defer span.End()
+
+	if err := api.webhook.DeleteWebhookSubscription(ctx, req.Params("id")); err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetMessage("webhook subscription deleted"), nil
+}
+
+// GetWebhookDeliveries godoc
+// @Summary List webhook deliveries
+// @Description List the delivery attempts of a webhook subscription, newest first
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Param limit query int false "Limit" default(50)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} jsonResponse{data=[]response.WebhookDeliveryResponse} "List of delivery attempts"
+// @Failure 404 {object} jsonResponse "Subscription not found"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/admin/webhooks/{id}/deliveries [get]
+func (api *API) GetWebhookDeliveries(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.GetWebhookDeliveries")
+	defer span.End()
+
+	limit, err := strconv.Atoi(req.Query("limit", "50"))
+	if err != nil {
+		return custresp.CustomErrorResponse(&custerr.ErrChain{
+			Message: "invalid limit or offset",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		})
+	}
+	offset, err := strconv.Atoi(req.Query("offset", "0"))
+	if err != nil {
+		return custresp.CustomErrorResponse(&custerr.ErrChain{
+			Message: "invalid limit or offset",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		})
+	}
+
+	res, err := api.webhook.GetWebhookDeliveries(ctx, req.Params("id"), limit, offset)
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
+
+// ReplayWebhookDelivery godoc
+// @Summary Replay webhook delivery
+// @Description Send the payload of a past delivery again, once, to the current endpoint of its subscription. The attempt is added to the delivery log and returned, failed or not.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param delivery_id path string true "Delivery ID, as sent in X-Webhook-Id"
+// @Success 200 {object} jsonResponse{data=response.WebhookDeliveryResponse} "Replayed attempt"
+// @Failure 404 {object} jsonResponse "Delivery not found"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/admin/webhooks/deliveries/{delivery_id}/replay [post]
+func (api *API) ReplayWebhookDelivery(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultController.ReplayWebhookDelivery")
+	defer span.End()
+
+	res, err := api.webhook.ReplayWebhookDelivery(ctx, req.Params("delivery_id"))
+	if err != nil {
+		return custresp.CustomErrorResponse(err)
+	}
+
+	return rest.NewJSONResponse().SetData(res), nil
+}
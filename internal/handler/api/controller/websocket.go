@@ -59,6 +59,7 @@ func (wsc *WebSocketController) GetLiveResultsStatus(ctx context.Context, req *r
 			"region_update",
 			"statistics_update",
 			"heartbeat",
+			"error",
 		},
 	}
 
@@ -67,7 +68,7 @@ func (wsc *WebSocketController) GetLiveResultsStatus(ctx context.Context, req *r
 
 // TriggerBroadcast godoc
 // @Summary Trigger manual broadcast
-// @Description Manually trigger a broadcast of current results (for testing/admin purposes)
+// @Description Manually trigger a broadcast of current results. Requires the admin role.
 // @Tags Live Results
 // @Accept json
 // @Produce json
@@ -75,6 +76,8 @@ func (wsc *WebSocketController) GetLiveResultsStatus(ctx context.Context, req *r
 // @Param region query string false "Region to broadcast"
 // @Param type query string false "Broadcast type: vote, election, region, statistics, all" default(all)
 // @Success 200 {object} jsonResponse{data=map[string]any} "Broadcast triggered"
+// @Security BearerAuth
+// @Security ApiKeyAuth
 // @Router /v1/live/broadcast [post]
 func (wsc *WebSocketController) TriggerBroadcast(ctx context.Context, req *router.Request) (*rest.JSONResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "WebSocketController.TriggerBroadcast")
@@ -85,7 +88,7 @@ func (wsc *WebSocketController) TriggerBroadcast(ctx context.Context, req *route
 	broadcastType := req.Query("type", "all")
 
 	connectedClients := wsc.liveResultService.GetConnectedClients(ctx)
-	if connectedClients == 0 {
+	if !wsc.liveResultService.HasSubscribers(ctx) {
 		return rest.NewJSONResponse().SetData(map[string]interface{}{
 			"message": "No connected clients, broadcast skipped",
 			"clients": 0,
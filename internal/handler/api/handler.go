@@ -5,15 +5,31 @@ import (
 	"github.com/nocturna-ta/golib/router"
 	"github.com/nocturna-ta/result/config"
 	"github.com/nocturna-ta/result/internal/handler/api/controller"
+	"github.com/nocturna-ta/result/internal/infrastructures/auth"
+	"github.com/nocturna-ta/result/internal/infrastructures/health"
+	"github.com/nocturna-ta/result/internal/infrastructures/throttle"
 	"github.com/nocturna-ta/result/internal/infrastructures/websocket"
 	"github.com/nocturna-ta/result/internal/usecases"
 )
 
 type Options struct {
-	Cfg          config.MainConfig
-	VoteResult   usecases.VoteResultUseCases
-	LiveResult   usecases.LiveResultUsecases
-	WebsocketHub *websocket.Hub
+	Cfg            config.MainConfig
+	VoteResult     usecases.VoteResultUseCases
+	LiveResult     usecases.LiveResultUsecases
+	Export         usecases.ExportUseCases
+	Pseudonym      usecases.PseudonymUseCases
+	Anomaly        usecases.AnomalyUseCases
+	Webhook        usecases.WebhookUseCases
+	ElectionPair   usecases.ElectionPairUseCases
+	Region         usecases.RegionUseCases
+	Turnout        usecases.TurnoutUseCases
+	Certification  usecases.CertificationUseCases
+	Merkle         usecases.MerkleUseCases
+	Reconciliation usecases.ReconciliationUseCases
+	WebsocketHub   *websocket.Hub
+	Liveness       *health.Checker
+	Readiness      *health.Checker
+	Auth           *auth.Authenticator
 }
 
 type Handler struct {
@@ -24,18 +40,40 @@ type Handler struct {
 
 func New(opts *Options) *Handler {
 	handler := &Handler{
-		opts: opts,
+		opts:        opts,
+		listenErrCh: make(chan error, 1),
 	}
+	var limiter *throttle.Throttle
+	if opts.Cfg.RateLimit.Enabled {
+		limiter = throttle.New(opts.Cfg.RateLimit, opts.Cfg.API.BasePath)
+	}
+
 	handler.myRouter = controller.New(&controller.Options{
-		Prefix:         opts.Cfg.API.BasePath,
-		Port:           opts.Cfg.Server.Port,
-		ReadTimeout:    opts.Cfg.Server.ReadTimeout,
-		WriteTimeout:   opts.Cfg.Server.WriteTimeout,
-		RequestTimeout: opts.Cfg.API.APITimeout,
-		EnableSwagger:  opts.Cfg.API.EnableSwagger,
-		VoteResult:     opts.VoteResult,
-		LiveResult:     opts.LiveResult,
-		WebSocketHub:   opts.WebsocketHub,
+		Prefix:              opts.Cfg.API.BasePath,
+		Port:                opts.Cfg.Server.Port,
+		ReadTimeout:         opts.Cfg.Server.ReadTimeout,
+		WriteTimeout:        opts.Cfg.Server.WriteTimeout,
+		RequestTimeout:      opts.Cfg.API.APITimeout,
+		EnableSwagger:       opts.Cfg.API.EnableSwagger,
+		VoteResult:          opts.VoteResult,
+		LiveResult:          opts.LiveResult,
+		Export:              opts.Export,
+		ExportStreamTimeout: opts.Cfg.Export.StreamTimeout,
+		Pseudonym:           opts.Pseudonym,
+		Anomaly:             opts.Anomaly,
+		Webhook:             opts.Webhook,
+		ElectionPair:        opts.ElectionPair,
+		Region:              opts.Region,
+		Turnout:             opts.Turnout,
+		Certification:       opts.Certification,
+		Merkle:              opts.Merkle,
+		Reconciliation:      opts.Reconciliation,
+		WebSocketHub:        opts.WebsocketHub,
+		WebSocketAdmission:  websocket.NewAdmission(opts.Cfg.LiveResults.Admission, opts.Cfg.Cors),
+		Liveness:            opts.Liveness,
+		Readiness:           opts.Readiness,
+		Auth:                opts.Auth,
+		Throttle:            limiter,
 	}).RegisterRoute()
 	return handler
 }
@@ -47,3 +85,7 @@ func (h *Handler) Run() {
 func (h *Handler) ListenError() <-chan error {
 	return h.listenErrCh
 }
+
+func (h *Handler) Stop() error {
+	return h.myRouter.Shutdown()
+}
@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"context"
+	grpcLib "github.com/nocturna-ta/golib/grpc"
+	"github.com/nocturna-ta/golib/log"
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/handler/grpc/service"
+	"github.com/nocturna-ta/result/internal/infrastructures/auth"
+	"github.com/nocturna-ta/result/internal/infrastructures/websocket"
+	"github.com/nocturna-ta/result/internal/usecases"
+	"github.com/nocturna-ta/result/pkg/constants"
+	resultv1 "github.com/nocturna-ta/result/pkg/pb/result/v1"
+	"google.golang.org/grpc"
+)
+
+type Options struct {
+	Cfg          config.MainConfig
+	VoteResult   usecases.VoteResultUseCases
+	WebsocketHub *websocket.Hub
+	Auth         *auth.Authenticator
+}
+
+type Handler struct {
+	opts        *Options
+	listenErrCh chan error
+	server      *grpcLib.Server
+}
+
+func New(opts *Options) *Handler {
+	server := grpcLib.NewServer(&grpcLib.ServerOptions{
+		Port: opts.Cfg.GrpcServer.Port,
+		UnaryInterceptors: []grpc.UnaryServerInterceptor{
+			opts.Auth.UnaryServerInterceptor(rawVoteMethodRoles()),
+		},
+		StreamServerInterceptors: []grpc.StreamServerInterceptor{
+			opts.Auth.StreamServerInterceptor(nil),
+		},
+	})
+
+	server.Register(resultv1.RegisterResultServiceServer, service.NewResultService(&service.ResultServiceOptions{
+		VoteResult: opts.VoteResult,
+		Hub:        opts.WebsocketHub,
+	}))
+
+	return &Handler{
+		opts:        opts,
+		listenErrCh: make(chan error, 1),
+		server:      server,
+	}
+}
+
+// rawVoteMethodRoles lists the RPCs returning individual vote rows, which are
+// limited to observers and admins like their REST counterparts.
+func rawVoteMethodRoles() map[string][]string {
+	roles := []string{constants.RoleObserver, constants.RoleAdmin}
+	return map[string][]string{
+		resultv1.ResultService_GetVoteResult_FullMethodName:                roles,
+		resultv1.ResultService_GetVoteResultsByElectionPair_FullMethodName: roles,
+		resultv1.ResultService_GetVoteResultsByRegion_FullMethodName:       roles,
+		resultv1.ResultService_GetVoteResultsByStatus_FullMethodName:       roles,
+		resultv1.ResultService_GetVoteResultsByDateRange_FullMethodName:    roles,
+		resultv1.ResultService_GetVoteResultsByHour_FullMethodName:         roles,
+		resultv1.ResultService_GetVoteResultsByDay_FullMethodName:          roles,
+	}
+}
+
+func (h *Handler) Run() {
+	log.Infof("gRPC Listening on %d", h.opts.Cfg.GrpcServer.Port)
+	h.listenErrCh <- h.server.Start()
+}
+
+func (h *Handler) ListenError() <-chan error {
+	return h.listenErrCh
+}
+
+// Stop waits for in-flight RPCs to finish or the context to expire, whichever comes first.
+func (h *Handler) Stop(ctx context.Context) {
+	h.server.StopContext(ctx)
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/result/internal/infrastructures/websocket"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	resultv1 "github.com/nocturna-ta/result/pkg/pb/result/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"net/http"
+	"time"
+)
+
+func toStatusError(err error) error {
+	var e *custerr.ErrChain
+	switch {
+	case errors.As(err, &e):
+		return status.Error(httpCodeToGrpcCode(e.Code), e.Message)
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func httpCodeToGrpcCode(code int) codes.Code {
+	switch code {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusUnavailableForLegalReasons:
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
+
+func toTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func fromTimestamp(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+// formatTimestamp renders an optional timestamp the way the HTTP query
+// parameters carry it, leaving it empty when unset.
+func formatTimestamp(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().Format(time.RFC3339Nano)
+}
+
+func toVoteResult(result *response.VoteResultResponse) *resultv1.VoteResult {
+	vote := &resultv1.VoteResult{
+		Id:              result.ID,
+		VoterId:         result.VoterID,
+		ElectionPairId:  result.ElectionPairID,
+		Region:          result.Region,
+		Status:          result.Status,
+		TransactionHash: result.TransactionHash,
+		ErrorMessage:    result.ErrorMessage,
+		VotedAt:         toTimestamp(result.VotedAt),
+		CreatedAt:       toTimestamp(result.CreatedAt),
+		UpdatedAt:       toTimestamp(result.UpdatedAt),
+	}
+
+	if result.ProcessedAt != nil {
+		vote.ProcessedAt = toTimestamp(*result.ProcessedAt)
+	}
+
+	return vote
+}
+
+func toVoteResultList(results []*response.VoteResultResponse) *resultv1.VoteResultList {
+	list := &resultv1.VoteResultList{}
+	for _, result := range results {
+		list.Items = append(list.Items, toVoteResult(result))
+	}
+	return list
+}
+
+func toElectionResult(result *response.ElectionVoteResultResponse) *resultv1.ElectionResult {
+	return &resultv1.ElectionResult{
+		ElectionPairId:  result.ElectionPairID,
+		Region:          result.Region,
+		TotalVotes:      result.TotalVotes,
+		ConfirmedVotes:  result.ConfirmedVotes,
+		PendingVotes:    result.PendingVotes,
+		ErrorVotes:      result.ErrorVotes,
+		LastUpdated:     toTimestamp(result.LastUpdated),
+		Suppressed:      result.Suppressed,
+		RoundedTo:       result.RoundedTo,
+		FinalizingVotes: result.FinalizingVotes,
+	}
+}
+
+func toRegionResult(result *response.RegionVoteResultResponse) *resultv1.RegionResult {
+	return &resultv1.RegionResult{
+		Region:          result.Region,
+		TotalVotes:      result.TotalVotes,
+		ConfirmedVotes:  result.ConfirmedVotes,
+		PendingVotes:    result.PendingVotes,
+		ErrorVotes:      result.ErrorVotes,
+		LastUpdated:     toTimestamp(result.LastUpdated),
+		Suppressed:      result.Suppressed,
+		RoundedTo:       result.RoundedTo,
+		FinalizingVotes: result.FinalizingVotes,
+	}
+}
+
+func toVoteStatistics(stats *response.VoteStatisticsResponse) *resultv1.VoteStatistics {
+	return &resultv1.VoteStatistics{
+		TotalVotes:      stats.TotalVotes,
+		ConfirmedVotes:  stats.ConfirmedVotes,
+		PendingVotes:    stats.PendingVotes,
+		ErrorVotes:      stats.ErrorVotes,
+		SuccessRate:     stats.SuccessRate,
+		LastUpdated:     toTimestamp(stats.LastUpdated),
+		FinalizingVotes: stats.FinalizingVotes,
+	}
+}
+
+func toVoteBreakdown(breakdown *response.VoteBreakdownResponse) *resultv1.VoteBreakdown {
+	result := &resultv1.VoteBreakdown{
+		Tz:        breakdown.TimeZone,
+		Bucket:    breakdown.Bucket,
+		Axis:      breakdown.Axis,
+		StartDate: toTimestamp(breakdown.Start),
+		EndDate:   toTimestamp(breakdown.End),
+	}
+	for _, item := range breakdown.Items {
+		result.Items = append(result.Items, &resultv1.VoteBucketCount{
+			Timestamp:      toTimestamp(item.Timestamp),
+			ElectionPairId: item.ElectionPairID,
+			Region:         item.Region,
+			Status:         item.Status,
+			Votes:          item.Votes,
+		})
+	}
+	return result
+}
+
+func toResultUpdate(message *websocket.LiveMessage) *resultv1.ResultUpdate {
+	update := &resultv1.ResultUpdate{
+		Type:      string(message.Type),
+		Timestamp: toTimestamp(message.Timestamp),
+	}
+
+	switch data := message.Data.(type) {
+	case *response.VoteResultResponse:
+		update.Payload = &resultv1.ResultUpdate_Vote{Vote: toVoteResult(data)}
+	case *response.ElectionVoteResultResponse:
+		update.Payload = &resultv1.ResultUpdate_Election{Election: toElectionResult(data)}
+	case *response.RegionVoteResultResponse:
+		update.Payload = &resultv1.ResultUpdate_Region{Region: toRegionResult(data)}
+	case *response.VoteStatisticsResponse:
+		update.Payload = &resultv1.ResultUpdate_Statistics{Statistics: toVoteStatistics(data)}
+	case *websocket.PollsClosed:
+		update.Payload = &resultv1.ResultUpdate_PollsClosed{PollsClosed: &resultv1.PollsClosed{
+			ElectionPairId: data.ElectionPairID,
+			Region:         data.Region,
+			LiftedAt:       toTimestamp(data.LiftedAt),
+		}}
+	case *response.AnomalyResponse:
+		update.Payload = &resultv1.ResultUpdate_AnomalyAlert{AnomalyAlert: &resultv1.AnomalyAlert{
+			Id:              data.ID,
+			Kind:            data.Kind,
+			ElectionPairId:  data.ElectionPairID,
+			Region:          data.Region,
+			VoteId:          data.VoteID,
+			VoterId:         data.VoterID,
+			TransactionHash: data.TransactionHash,
+			Value:           data.Value,
+			Baseline:        data.Baseline,
+			Description:     data.Description,
+			DetectedAt:      toTimestamp(data.DetectedAt),
+		}}
+	default:
+		return nil
+	}
+
+	return update
+}
@@ -0,0 +1,306 @@
+package service
+
+import (
+	"context"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/infrastructures/privacy"
+	"github.com/nocturna-ta/result/internal/infrastructures/websocket"
+	"github.com/nocturna-ta/result/internal/usecases"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	resultv1 "github.com/nocturna-ta/result/pkg/pb/result/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const watchBufferSize = 256
+
+type ResultService struct {
+	resultv1.UnimplementedResultServiceServer
+	voteResult usecases.VoteResultUseCases
+	hub        *websocket.Hub
+}
+
+type ResultServiceOptions struct {
+	VoteResult usecases.VoteResultUseCases
+	Hub        *websocket.Hub
+}
+
+func NewResultService(opts *ResultServiceOptions) *ResultService {
+	return &ResultService{
+		voteResult: opts.VoteResult,
+		hub:        opts.Hub,
+	}
+}
+
+func (s *ResultService) GetVoteResult(ctx context.Context, req *resultv1.GetVoteResultRequest) (*resultv1.VoteResult, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultGrpcService.GetVoteResult")
+	defer span.End()
+
+	result, err := s.voteResult.GetVoteResultByID(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toVoteResult(result), nil
+}
+
+func (s *ResultService) GetVoteResultsByElectionPair(ctx context.Context, req *resultv1.GetVoteResultsByElectionPairRequest) (*resultv1.VoteResultList, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultGrpcService.GetVoteResultsByElectionPair")
+	defer span.End()
+
+	results, err := s.voteResult.GetVoteResultsByElectionPair(ctx, req.GetElectionPairId(), int(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toVoteResultList(results), nil
+}
+
+func (s *ResultService) GetVoteResultsByRegion(ctx context.Context, req *resultv1.GetVoteResultsByRegionRequest) (*resultv1.VoteResultList, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultGrpcService.GetVoteResultsByRegion")
+	defer span.End()
+
+	results, err := s.voteResult.GetVoteResultsByRegion(ctx, req.GetRegion(), int(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toVoteResultList(results), nil
+}
+
+func (s *ResultService) GetVoteResultsByStatus(ctx context.Context, req *resultv1.GetVoteResultsByStatusRequest) (*resultv1.VoteResultList, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultGrpcService.GetVoteResultsByStatus")
+	defer span.End()
+
+	results, err := s.voteResult.GetVoteResultsByStatus(ctx, req.GetStatus(), int(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toVoteResultList(results), nil
+}
+
+func (s *ResultService) GetVoteResultsByDateRange(ctx context.Context, req *resultv1.GetVoteResultsByDateRangeRequest) (*resultv1.VoteResultList, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultGrpcService.GetVoteResultsByDateRange")
+	defer span.End()
+
+	results, err := s.voteResult.GetVoteResultsByDateRange(ctx, fromTimestamp(req.GetStartDate()), fromTimestamp(req.GetEndDate()),
+		int(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toVoteResultList(results), nil
+}
+
+func (s *ResultService) GetVoteResultsByHour(ctx context.Context, req *resultv1.GetVoteResultsByTimeRequest) (*resultv1.VoteResultList, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultGrpcService.GetVoteResultsByHour")
+	defer span.End()
+
+	results, err := s.voteResult.GetVoteResultsByHour(ctx, fromTimestamp(req.GetDate()),
+		int(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toVoteResultList(results), nil
+}
+
+func (s *ResultService) GetVoteResultsByDay(ctx context.Context, req *resultv1.GetVoteResultsByTimeRequest) (*resultv1.VoteResultList, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultGrpcService.GetVoteResultsByDay")
+	defer span.End()
+
+	results, err := s.voteResult.GetVoteResultsByDay(ctx, fromTimestamp(req.GetDate()),
+		int(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toVoteResultList(results), nil
+}
+
+func (s *ResultService) GetElectionResults(ctx context.Context, req *resultv1.GetElectionResultsRequest) (*resultv1.ElectionResult, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultGrpcService.GetElectionResults")
+	defer span.End()
+
+	result, err := s.voteResult.GetElectionResults(ctx, req.GetElectionPairId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toElectionResult(result), nil
+}
+
+func (s *ResultService) GetElectionResultsByRegion(ctx context.Context, req *resultv1.GetElectionResultsByRegionRequest) (*resultv1.ElectionResultList, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultGrpcService.GetElectionResultsByRegion")
+	defer span.End()
+
+	results, err := s.voteResult.GetElectionResultsByRegion(ctx, req.GetRegion())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	list := &resultv1.ElectionResultList{}
+	for _, result := range results {
+		list.Items = append(list.Items, toElectionResult(result))
+	}
+	return list, nil
+}
+
+func (s *ResultService) GetRegionResults(ctx context.Context, req *resultv1.GetRegionResultsRequest) (*resultv1.RegionResult, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultGrpcService.GetRegionResults")
+	defer span.End()
+
+	result, err := s.voteResult.GetRegionResults(ctx, req.GetRegion())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toRegionResult(result), nil
+}
+
+func (s *ResultService) GetRegionStatistics(ctx context.Context, _ *emptypb.Empty) (*resultv1.RegionResultList, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultGrpcService.GetRegionStatistics")
+	defer span.End()
+
+	results, err := s.voteResult.GetRegionStatistics(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	list := &resultv1.RegionResultList{}
+	for _, result := range results {
+		list.Items = append(list.Items, toRegionResult(result))
+	}
+	return list, nil
+}
+
+func (s *ResultService) GetOverallStatistics(ctx context.Context, _ *emptypb.Empty) (*resultv1.VoteStatistics, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultGrpcService.GetOverallStatistics")
+	defer span.End()
+
+	stats, err := s.voteResult.GetOverallStatistics(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toVoteStatistics(stats), nil
+}
+
+func (s *ResultService) GetDailyStatistics(ctx context.Context, req *resultv1.GetDailyStatisticsRequest) (*resultv1.VoteStatisticsList, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultGrpcService.GetDailyStatistics")
+	defer span.End()
+
+	stats, err := s.voteResult.GetDailyStatistics(ctx, fromTimestamp(req.GetStartDate()), fromTimestamp(req.GetEndDate()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	list := &resultv1.VoteStatisticsList{}
+	for _, stat := range stats {
+		list.Items = append(list.Items, toVoteStatistics(stat))
+	}
+	return list, nil
+}
+
+func (s *ResultService) GetVoteBreakdown(ctx context.Context, req *resultv1.GetVoteBreakdownRequest) (*resultv1.VoteBreakdown, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultGrpcService.GetVoteBreakdown")
+	defer span.End()
+
+	breakdown, err := s.voteResult.GetVoteBreakdown(ctx, &request.TimeSeriesRequest{
+		TimeZone:       req.GetTz(),
+		Bucket:         req.GetBucket(),
+		Axis:           req.GetAxis(),
+		StartDate:      formatTimestamp(req.GetStartDate()),
+		EndDate:        formatTimestamp(req.GetEndDate()),
+		ElectionPairID: req.GetElectionPairId(),
+		Region:         req.GetRegion(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toVoteBreakdown(breakdown), nil
+}
+
+func (s *ResultService) CountVotesByStatus(ctx context.Context, req *resultv1.CountVotesByStatusRequest) (*resultv1.CountResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultGrpcService.CountVotesByStatus")
+	defer span.End()
+
+	count, err := s.voteResult.CountVotesByStatus(ctx, req.GetStatus())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &resultv1.CountResponse{Count: count}, nil
+}
+
+func (s *ResultService) CountVotesByElectionPair(ctx context.Context, req *resultv1.CountVotesByElectionPairRequest) (*resultv1.CountResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultGrpcService.CountVotesByElectionPair")
+	defer span.End()
+
+	count, err := s.voteResult.CountVotesByElectionPair(ctx, req.GetElectionPairId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &resultv1.CountResponse{Count: count}, nil
+}
+
+func (s *ResultService) CountVotesByRegion(ctx context.Context, req *resultv1.CountVotesByRegionRequest) (*resultv1.CountResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultGrpcService.CountVotesByRegion")
+	defer span.End()
+
+	count, err := s.voteResult.CountVotesByRegion(ctx, req.GetRegion())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &resultv1.CountResponse{Count: count}, nil
+}
+
+func (s *ResultService) WatchResults(req *resultv1.WatchResultsRequest, stream resultv1.ResultService_WatchResultsServer) error {
+	subscriptions := make(map[websocket.SubscriptionType]*websocket.MessageFilter)
+	for _, sub := range req.GetSubscriptions() {
+		subType := websocket.SubscriptionType(sub.GetType())
+		switch subType {
+		case websocket.SubscriptionAll, websocket.SubscriptionElection, websocket.SubscriptionRegion, websocket.SubscriptionStatistics,
+			websocket.SubscriptionAnomalies:
+		default:
+			return status.Errorf(codes.InvalidArgument, "unsupported subscription type %q", sub.GetType())
+		}
+
+		subscriptions[subType] = &websocket.MessageFilter{
+			ElectionPairID: sub.GetElectionPairId(),
+			Region:         sub.GetRegion(),
+		}
+	}
+
+	if len(subscriptions) == 0 {
+		subscriptions[websocket.SubscriptionAll] = nil
+	}
+
+	listener := s.hub.Listen(subscriptions, watchBufferSize, privacy.Audience(stream.Context()))
+	defer s.hub.Unlisten(listener)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case message, ok := <-listener.Messages:
+			if !ok {
+				return status.Error(codes.Unavailable, "live result hub stopped")
+			}
+
+			update := toResultUpdate(message)
+			if update == nil {
+				continue
+			}
+
+			if err := stream.Send(update); err != nil {
+				return err
+			}
+		}
+	}
+}
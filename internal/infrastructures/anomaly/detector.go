@@ -0,0 +1,245 @@
+package anomaly
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"time"
+)
+
+// Vote is a vote event as the detector sees it.
+type Vote struct {
+	ID              string
+	VoterID         string
+	ElectionPairID  string
+	Region          string
+	Status          string
+	TransactionHash string
+	VotedAt         time.Time
+	ObservedAt      time.Time
+	// New is set when the vote is ingested for the first time, and unset for status updates.
+	New bool
+}
+
+// Detector keeps rolling per-region rates and recent voter IDs and transaction
+// hashes to flag suspicious votes. It is not safe for concurrent use.
+type Detector struct {
+	cfg          config.AnomalyConfig
+	regions      map[string]*regionStats
+	voters       map[string]*sighting
+	transactions map[string]*sighting
+}
+
+type window struct {
+	votes     uint64
+	processed uint64
+	errors    uint64
+}
+
+type regionStats struct {
+	current window
+	// history holds the previous windows, oldest first.
+	history []window
+}
+
+type sighting struct {
+	voteIDs  map[string]struct{}
+	lastSeen time.Time
+	flagged  bool
+}
+
+func NewDetector(cfg config.AnomalyConfig) *Detector {
+	if cfg.BaselineWindows <= 0 {
+		cfg.BaselineWindows = 1
+	}
+	if cfg.MaxVotesPerTransaction <= 0 {
+		cfg.MaxVotesPerTransaction = 1
+	}
+
+	return &Detector{
+		cfg:          cfg,
+		regions:      make(map[string]*regionStats),
+		voters:       make(map[string]*sighting),
+		transactions: make(map[string]*sighting),
+	}
+}
+
+// Observe counts the vote towards the current window and returns the anomalies
+// the vote itself reveals.
+func (d *Detector) Observe(vote *Vote) []*model.Anomaly {
+	var anomalies []*model.Anomaly
+
+	stats := d.region(vote.Region)
+	if vote.New {
+		stats.current.votes++
+	}
+	switch model.VoteStatus(vote.Status) {
+	case model.VoteStatusConfirmed, model.VoteStatusFinalizing, model.VoteStatusError:
+		stats.current.processed++
+		if vote.Status == string(model.VoteStatusError) {
+			stats.current.errors++
+		}
+	}
+
+	if vote.New && !vote.VotedAt.IsZero() && d.cfg.MaxClockSkew > 0 {
+		skew := vote.ObservedAt.Sub(vote.VotedAt)
+		if skew < 0 {
+			skew = -skew
+		}
+		if skew > d.cfg.MaxClockSkew {
+			anomaly := newAnomaly(model.AnomalyKindClockSkew, vote, vote.ObservedAt)
+			anomaly.Value = skew.Seconds()
+			anomaly.Baseline = d.cfg.MaxClockSkew.Seconds()
+			anomaly.Description = fmt.Sprintf("voted_at is %s away from ingestion time", skew.Round(time.Second))
+			anomalies = append(anomalies, anomaly)
+		}
+	}
+
+	if vote.New && vote.VoterID != "" {
+		seen := d.sighting(d.voters, vote.VoterID, vote.ObservedAt)
+		if _, ok := seen.voteIDs[vote.ID]; !ok {
+			seen.voteIDs[vote.ID] = struct{}{}
+			if len(seen.voteIDs) > 1 {
+				anomaly := newAnomaly(model.AnomalyKindDuplicateVoter, vote, vote.ObservedAt)
+				anomaly.Value = float64(len(seen.voteIDs))
+				anomaly.Baseline = 1
+				anomaly.Description = fmt.Sprintf("voter appears with %d different vote IDs", len(seen.voteIDs))
+				anomalies = append(anomalies, anomaly)
+			}
+		}
+	}
+
+	if vote.TransactionHash != "" {
+		seen := d.sighting(d.transactions, vote.TransactionHash, vote.ObservedAt)
+		if _, ok := seen.voteIDs[vote.ID]; !ok && !seen.flagged {
+			seen.voteIDs[vote.ID] = struct{}{}
+			if len(seen.voteIDs) > d.cfg.MaxVotesPerTransaction {
+				seen.flagged = true
+
+				anomaly := newAnomaly(model.AnomalyKindSharedTransactionHash, vote, vote.ObservedAt)
+				anomaly.Value = float64(len(seen.voteIDs))
+				anomaly.Baseline = float64(d.cfg.MaxVotesPerTransaction)
+				anomaly.Description = fmt.Sprintf("%d votes share the transaction hash", len(seen.voteIDs))
+				anomalies = append(anomalies, anomaly)
+			}
+		}
+	}
+
+	return anomalies
+}
+
+// Tick closes the current window, compares every region against its baseline
+// and forgets voter IDs and transaction hashes past their retention.
+func (d *Detector) Tick(now time.Time) []*model.Anomaly {
+	var anomalies []*model.Anomaly
+
+	for region, stats := range d.regions {
+		if anomaly := d.spike(region, stats, now); anomaly != nil {
+			anomalies = append(anomalies, anomaly)
+		}
+		if anomaly := d.errorSurge(region, stats, now); anomaly != nil {
+			anomalies = append(anomalies, anomaly)
+		}
+
+		stats.history = append(stats.history, stats.current)
+		if len(stats.history) > d.cfg.BaselineWindows {
+			stats.history = stats.history[len(stats.history)-d.cfg.BaselineWindows:]
+		}
+		stats.current = window{}
+	}
+
+	cutoff := now.Add(-d.cfg.Retention)
+	for _, sightings := range []map[string]*sighting{d.voters, d.transactions} {
+		for key, seen := range sightings {
+			if seen.lastSeen.Before(cutoff) {
+				delete(sightings, key)
+			}
+		}
+	}
+
+	return anomalies
+}
+
+func (d *Detector) spike(region string, stats *regionStats, now time.Time) *model.Anomaly {
+	if len(stats.history) == 0 || stats.current.votes < d.cfg.MinSpikeVotes {
+		return nil
+	}
+
+	var total uint64
+	for _, w := range stats.history {
+		total += w.votes
+	}
+	baseline := float64(total) / float64(len(stats.history))
+	if float64(stats.current.votes) < d.cfg.SpikeFactor*baseline {
+		return nil
+	}
+
+	anomaly := newAnomaly(model.AnomalyKindRegionSpike, &Vote{Region: region}, now)
+	anomaly.Value = float64(stats.current.votes)
+	anomaly.Baseline = baseline
+	anomaly.Description = fmt.Sprintf("%d new votes in the last window against a baseline of %.1f", stats.current.votes, baseline)
+	return anomaly
+}
+
+func (d *Detector) errorSurge(region string, stats *regionStats, now time.Time) *model.Anomaly {
+	if stats.current.processed == 0 || stats.current.processed < d.cfg.MinErrorSample {
+		return nil
+	}
+
+	rate := float64(stats.current.errors) / float64(stats.current.processed)
+	if rate < d.cfg.ErrorRateThreshold {
+		return nil
+	}
+
+	var failed, processed uint64
+	for _, w := range stats.history {
+		failed += w.errors
+		processed += w.processed
+	}
+	var baseline float64
+	if processed > 0 {
+		baseline = float64(failed) / float64(processed)
+	}
+	if rate < d.cfg.ErrorRateFactor*baseline {
+		return nil
+	}
+
+	anomaly := newAnomaly(model.AnomalyKindErrorRateSurge, &Vote{Region: region}, now)
+	anomaly.Value = rate
+	anomaly.Baseline = baseline
+	anomaly.Description = fmt.Sprintf("%.1f%% of %d processed votes failed against a baseline of %.1f%%", rate*100, stats.current.processed, baseline*100)
+	return anomaly
+}
+
+func (d *Detector) region(region string) *regionStats {
+	stats, ok := d.regions[region]
+	if !ok {
+		stats = &regionStats{}
+		d.regions[region] = stats
+	}
+	return stats
+}
+
+func (d *Detector) sighting(sightings map[string]*sighting, key string, now time.Time) *sighting {
+	seen, ok := sightings[key]
+	if !ok {
+		seen = &sighting{voteIDs: make(map[string]struct{})}
+		sightings[key] = seen
+	}
+	seen.lastSeen = now
+	return seen
+}
+
+func newAnomaly(kind model.AnomalyKind, vote *Vote, detectedAt time.Time) *model.Anomaly {
+	return &model.Anomaly{
+		ID:              uuid.NewString(),
+		Kind:            string(kind),
+		ElectionPairID:  vote.ElectionPairID,
+		Region:          vote.Region,
+		VoteID:          vote.ID,
+		VoterID:         vote.VoterID,
+		TransactionHash: vote.TransactionHash,
+		DetectedAt:      detectedAt,
+	}
+}
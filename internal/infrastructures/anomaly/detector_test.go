@@ -0,0 +1,189 @@
+package anomaly
+
+import (
+	"fmt"
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"testing"
+	"time"
+)
+
+var start = time.Date(2024, 2, 14, 8, 0, 0, 0, time.UTC)
+
+func testConfig() config.AnomalyConfig {
+	return config.AnomalyConfig{
+		Enabled:                true,
+		Window:                 time.Minute,
+		BaselineWindows:        3,
+		SpikeFactor:            3,
+		MinSpikeVotes:          10,
+		ErrorRateThreshold:     0.2,
+		ErrorRateFactor:        2,
+		MinErrorSample:         10,
+		MaxVotesPerTransaction: 1,
+		MaxClockSkew:           10 * time.Minute,
+		Retention:              time.Hour,
+	}
+}
+
+func kinds(anomalies []*model.Anomaly) []string {
+	found := make([]string, 0, len(anomalies))
+	for _, anomaly := range anomalies {
+		found = append(found, anomaly.Kind)
+	}
+	return found
+}
+
+func expectKinds(t *testing.T, anomalies []*model.Anomaly, want ...model.AnomalyKind) {
+	t.Helper()
+	got := kinds(anomalies)
+	if len(got) != len(want) {
+		t.Fatalf("anomalies = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != string(want[i]) {
+			t.Fatalf("anomalies = %v, want %v", got, want)
+		}
+	}
+}
+
+// observeWindow observes new votes of the region, the first failed of them
+// failing, and closes the window.
+func observeWindow(d *Detector, region string, votes, failed int, closedAt time.Time) []*model.Anomaly {
+	for i := 0; i < votes; i++ {
+		status := string(model.VoteStatusConfirmed)
+		if i < failed {
+			status = string(model.VoteStatusError)
+		}
+		d.Observe(&Vote{
+			ID:         fmt.Sprintf("%s-%d-%d", region, closedAt.Unix(), i),
+			Region:     region,
+			Status:     status,
+			VotedAt:    closedAt,
+			ObservedAt: closedAt,
+			New:        true,
+		})
+	}
+	return d.Tick(closedAt)
+}
+
+func TestRegionSpike(t *testing.T) {
+	tests := []struct {
+		name     string
+		baseline int
+		votes    int
+		want     []model.AnomalyKind
+	}{
+		{name: "spike against the baseline", baseline: 5, votes: 15, want: []model.AnomalyKind{model.AnomalyKindRegionSpike}},
+		{name: "below the spike factor", baseline: 5, votes: 14},
+		{name: "below the minimum votes", baseline: 2, votes: 9},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDetector(testConfig())
+			for i := 0; i < 3; i++ {
+				expectKinds(t, observeWindow(d, "region-1", tt.baseline, 0, start.Add(time.Duration(i)*time.Minute)))
+			}
+
+			anomalies := observeWindow(d, "region-1", tt.votes, 0, start.Add(3*time.Minute))
+			expectKinds(t, anomalies, tt.want...)
+			if len(anomalies) == 1 && (anomalies[0].Region != "region-1" || anomalies[0].Value != float64(tt.votes) ||
+				anomalies[0].Baseline != float64(tt.baseline)) {
+				t.Errorf("spike = %+v, want %d votes in region-1 against %d", anomalies[0], tt.votes, tt.baseline)
+			}
+		})
+	}
+}
+
+func TestRegionSpikeNeedsBaseline(t *testing.T) {
+	d := NewDetector(testConfig())
+	expectKinds(t, observeWindow(d, "region-1", 100, 0, start))
+}
+
+func TestErrorRateSurge(t *testing.T) {
+	tests := []struct {
+		name     string
+		baseline int
+		failed   int
+		votes    int
+		want     []model.AnomalyKind
+	}{
+		{name: "surge against the baseline", baseline: 1, failed: 5, votes: 10, want: []model.AnomalyKind{model.AnomalyKindErrorRateSurge}},
+		{name: "surge without earlier errors", failed: 2, votes: 10, want: []model.AnomalyKind{model.AnomalyKindErrorRateSurge}},
+		{name: "below the threshold", failed: 1, votes: 10},
+		{name: "below the baseline factor", baseline: 3, failed: 5, votes: 10},
+		{name: "sample too small", failed: 5, votes: 9},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDetector(testConfig())
+			for i := 0; i < 3; i++ {
+				observeWindow(d, "region-1", 10, tt.baseline, start.Add(time.Duration(i)*time.Minute))
+			}
+
+			expectKinds(t, observeWindow(d, "region-1", tt.votes, tt.failed, start.Add(3*time.Minute)), tt.want...)
+		})
+	}
+}
+
+func TestSharedTransactionHash(t *testing.T) {
+	d := NewDetector(testConfig())
+	vote := func(id string) *Vote {
+		return &Vote{ID: id, Region: "region-1", TransactionHash: "0xabc", ObservedAt: start, New: true}
+	}
+
+	expectKinds(t, d.Observe(vote("vote-1")))
+	// The same vote again, as its status update.
+	expectKinds(t, d.Observe(vote("vote-1")))
+
+	anomalies := d.Observe(vote("vote-2"))
+	expectKinds(t, anomalies, model.AnomalyKindSharedTransactionHash)
+	if anomalies[0].Value != 2 || anomalies[0].TransactionHash != "0xabc" {
+		t.Errorf("shared transaction hash = %+v, want 2 votes on 0xabc", anomalies[0])
+	}
+
+	// A transaction hash is flagged once.
+	expectKinds(t, d.Observe(vote("vote-3")))
+}
+
+func TestDuplicateVoter(t *testing.T) {
+	d := NewDetector(testConfig())
+	vote := func(id string, observedAt time.Time, isNew bool) *Vote {
+		return &Vote{ID: id, VoterID: "voter-1", Region: "region-1", ObservedAt: observedAt, New: isNew}
+	}
+
+	expectKinds(t, d.Observe(vote("vote-1", start, true)))
+	expectKinds(t, d.Observe(vote("vote-1", start, true)))
+	expectKinds(t, d.Observe(vote("vote-2", start, false)))
+
+	anomalies := d.Observe(vote("vote-3", start, true))
+	expectKinds(t, anomalies, model.AnomalyKindDuplicateVoter)
+	if anomalies[0].VoterID != "voter-1" || anomalies[0].VoteID != "vote-3" || anomalies[0].Value != 2 {
+		t.Errorf("duplicate voter = %+v, want vote-3 as the second vote of voter-1", anomalies[0])
+	}
+
+	// Voters are forgotten past their retention.
+	d.Tick(start.Add(2 * time.Hour))
+	expectKinds(t, d.Observe(vote("vote-4", start.Add(2*time.Hour), true)))
+}
+
+func TestClockSkew(t *testing.T) {
+	tests := []struct {
+		name    string
+		votedAt time.Time
+		isNew   bool
+		want    []model.AnomalyKind
+	}{
+		{name: "voted in the past", votedAt: start.Add(-11 * time.Minute), isNew: true, want: []model.AnomalyKind{model.AnomalyKindClockSkew}},
+		{name: "voted in the future", votedAt: start.Add(11 * time.Minute), isNew: true, want: []model.AnomalyKind{model.AnomalyKindClockSkew}},
+		{name: "within the maximum skew", votedAt: start.Add(-9 * time.Minute), isNew: true},
+		{name: "status update", votedAt: start.Add(-time.Hour)},
+		{name: "no voting time", isNew: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDetector(testConfig())
+			expectKinds(t, d.Observe(&Vote{ID: "vote-1", Region: "region-1", VotedAt: tt.votedAt, ObservedAt: start, New: tt.isNew}), tt.want...)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"github.com/nocturna-ta/result/config"
+)
+
+const methodAPIKey = "api_key"
+
+type apiKey struct {
+	name string
+	hash []byte
+	role string
+}
+
+type apiKeyStore struct {
+	keys []apiKey
+}
+
+func newAPIKeyStore(cfg []config.APIKeyConfig) (*apiKeyStore, error) {
+	store := &apiKeyStore{keys: make([]apiKey, 0, len(cfg))}
+	names := make(map[string]bool, len(cfg))
+
+	for _, k := range cfg {
+		if k.Name == "" {
+			return nil, fmt.Errorf("api key name is required")
+		}
+		if names[k.Name] {
+			return nil, fmt.Errorf("duplicate api key name %q", k.Name)
+		}
+		names[k.Name] = true
+
+		hash, err := hex.DecodeString(k.KeyHash)
+		if err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("api key %q: key hash must be a hex encoded sha256", k.Name)
+		}
+		if err := validateRole(k.Role); err != nil {
+			return nil, fmt.Errorf("api key %q: %w", k.Name, err)
+		}
+
+		store.keys = append(store.keys, apiKey{name: k.Name, hash: hash, role: k.Role})
+	}
+
+	return store, nil
+}
+
+// verify compares against every configured key so the response time does not
+// reveal which key, if any, matched.
+func (s *apiKeyStore) verify(key string) (*Principal, error) {
+	sum := sha256.Sum256([]byte(key))
+
+	var match *apiKey
+	for i := range s.keys {
+		if subtle.ConstantTimeCompare(sum[:], s.keys[i].hash) == 1 {
+			match = &s.keys[i]
+		}
+	}
+	if match == nil {
+		return nil, ErrInvalidCredential
+	}
+
+	return &Principal{
+		UserId: principalId(methodAPIKey, match.name),
+		Name:   match.name,
+		Role:   match.role,
+		Method: methodAPIKey,
+	}, nil
+}
@@ -0,0 +1,176 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	libCtx "github.com/nocturna-ta/golib/context"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/pkg/constants"
+	"net/http"
+	"strings"
+)
+
+const (
+	HeaderAPIKey = "X-API-Key"
+
+	bearerPrefix = "Bearer "
+)
+
+var (
+	ErrInvalidCredential = &custerr.ErrChain{
+		Message: "invalid credential",
+		Code:    http.StatusUnauthorized,
+		Type:    response.ErrUnauthorized,
+	}
+
+	ErrUnauthenticated = &custerr.ErrChain{
+		Message: "unauthorized",
+		Code:    http.StatusUnauthorized,
+		Type:    response.ErrUnauthorized,
+	}
+
+	ErrForbiddenRole = &custerr.ErrChain{
+		Message: "forbidden role",
+		Code:    http.StatusForbidden,
+		Type:    response.ErrForbiddenResource,
+	}
+
+	// principalNamespace derives stable user ids for subjects that are not UUIDs,
+	// since the router only accepts a UUID as the authenticated user.
+	principalNamespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("urn:nocturna:result:principal"))
+
+	roleRank = map[string]int{
+		constants.RoleObserver: 1,
+		constants.RoleAdmin:    2,
+	}
+)
+
+// Principal is the identity proven by a bearer token or an API key.
+type Principal struct {
+	UserId string
+	Name   string
+	Role   string
+	Method string
+}
+
+type principalKey struct{}
+
+// PrincipalFromContext returns the principal authenticated by the middleware, or nil
+// for anonymous requests.
+func PrincipalFromContext(ctx context.Context) *Principal {
+	principal, _ := ctx.Value(principalKey{}).(*Principal)
+	return principal
+}
+
+// Authenticator verifies bearer tokens and API keys and turns them into the
+// request context consumed by the router role checks.
+type Authenticator struct {
+	trustIdentityHeaders bool
+	jwt                  *jwtVerifier
+	apiKeys              *apiKeyStore
+}
+
+func New(cfg config.AuthConfig) (*Authenticator, error) {
+	verifier, err := newJWTVerifier(cfg.JWT)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := newAPIKeyStore(cfg.APIKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Authenticator{
+		trustIdentityHeaders: cfg.TrustIdentityHeaders,
+		jwt:                  verifier,
+		apiKeys:              keys,
+	}, nil
+}
+
+// Authenticate resolves the credential sent in the Authorization or X-API-Key header.
+// It returns a nil principal when neither header is present.
+func (a *Authenticator) Authenticate(authorization, apiKey string) (*Principal, error) {
+	switch {
+	case authorization != "":
+		if len(authorization) <= len(bearerPrefix) || !strings.EqualFold(authorization[:len(bearerPrefix)], bearerPrefix) {
+			return nil, ErrInvalidCredential
+		}
+		return a.jwt.verify(authorization[len(bearerPrefix):])
+	case apiKey != "":
+		return a.apiKeys.verify(apiKey)
+	default:
+		return nil, nil
+	}
+}
+
+// WithPrincipal replaces the identity of the request context with the authenticated
+// principal. Identity taken from headers is dropped unless the gateway is trusted.
+func (a *Authenticator) WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	var reqCtx libCtx.RequestContext
+	if rc, err := libCtx.GetRequestContext(ctx); err == nil {
+		reqCtx = *rc
+	}
+
+	switch {
+	case principal != nil:
+		reqCtx.UserId = principal.UserId
+		reqCtx.Role = principal.Role
+	case !a.trustIdentityHeaders:
+		reqCtx.UserId = constants.EmptyString
+		reqCtx.Role = constants.EmptyString
+	}
+
+	ctx = context.WithValue(ctx, principalKey{}, principal)
+	return context.WithValue(ctx, libCtx.RequestContextKey, reqCtx)
+}
+
+// UserID returns the ID of the authenticated user of the request context, or an
+// empty string for anonymous requests.
+func UserID(ctx context.Context) string {
+	reqCtx, err := libCtx.GetRequestContext(ctx)
+	if err != nil {
+		return constants.EmptyString
+	}
+
+	if _, err := uuid.Parse(reqCtx.UserId); err != nil {
+		return constants.EmptyString
+	}
+
+	return reqCtx.UserId
+}
+
+// Authorize checks that the request context holds an authenticated user with one of the roles.
+func Authorize(ctx context.Context, roles ...string) error {
+	reqCtx, err := libCtx.GetRequestContext(ctx)
+	if err != nil {
+		return ErrUnauthenticated
+	}
+
+	if _, err := uuid.Parse(reqCtx.UserId); err != nil {
+		return ErrUnauthenticated
+	}
+
+	if len(roles) > 0 && !reqCtx.HasAnyRole(roles...) {
+		return ErrForbiddenRole
+	}
+
+	return nil
+}
+
+func validateRole(role string) error {
+	if _, ok := roleRank[role]; !ok {
+		return fmt.Errorf("unknown role %q", role)
+	}
+	return nil
+}
+
+func principalId(kind, subject string) string {
+	if id, err := uuid.Parse(subject); err == nil {
+		return id.String()
+	}
+	return uuid.NewSHA1(principalNamespace, []byte(kind+":"+subject)).String()
+}
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"github.com/nocturna-ta/golib/custerr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strings"
+)
+
+// UnaryServerInterceptor authenticates unary calls from the authorization or
+// x-api-key metadata and enforces the roles listed for each full method name.
+// Methods missing from methodRoles stay public.
+func (a *Authenticator) UnaryServerInterceptor(methodRoles map[string][]string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+
+		principal, err := a.Authenticate(firstMetadata(md, "authorization"), firstMetadata(md, strings.ToLower(HeaderAPIKey)))
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+
+		ctx = a.WithPrincipal(ctx, principal)
+
+		if roles, ok := methodRoles[info.FullMethod]; ok {
+			if err := Authorize(ctx, roles...); err != nil {
+				return nil, toStatusError(err)
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of UnaryServerInterceptor.
+func (a *Authenticator) StreamServerInterceptor(methodRoles map[string][]string) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := stream.Context()
+		md, _ := metadata.FromIncomingContext(ctx)
+
+		principal, err := a.Authenticate(firstMetadata(md, "authorization"), firstMetadata(md, strings.ToLower(HeaderAPIKey)))
+		if err != nil {
+			return toStatusError(err)
+		}
+
+		ctx = a.WithPrincipal(ctx, principal)
+
+		if roles, ok := methodRoles[info.FullMethod]; ok {
+			if err := Authorize(ctx, roles...); err != nil {
+				return toStatusError(err)
+			}
+		}
+
+		return handler(srv, &serverStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// serverStream overrides the context of a server stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func firstMetadata(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func toStatusError(err error) error {
+	var e *custerr.ErrChain
+	if errors.As(err, &e) && e.Code == http.StatusForbidden {
+		return status.Error(codes.PermissionDenied, e.Message)
+	}
+	if errors.As(err, &e) {
+		return status.Error(codes.Unauthenticated, e.Message)
+	}
+	return status.Error(codes.Unauthenticated, err.Error())
+}
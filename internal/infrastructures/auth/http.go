@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// Middleware authenticates the request and overwrites the identity placed in the
+// user context by the router, so route roles are checked against verified
+// credentials instead of client supplied headers.
+func (a *Authenticator) Middleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		principal, err := a.Authenticate(c.Get(fiber.HeaderAuthorization), c.Get(HeaderAPIKey))
+		if err != nil {
+			return err
+		}
+
+		c.SetUserContext(a.WithPrincipal(c.UserContext(), principal))
+		return c.Next()
+	}
+}
+
+// RequireRoles guards a router.CustomHandler route, which unlike the JSON
+// handlers does not check router.WithRoles.
+func RequireRoles(handler fiber.Handler, roles ...string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if err := Authorize(c.UserContext(), roles...); err != nil {
+			return err
+		}
+		return handler(c)
+	}
+}
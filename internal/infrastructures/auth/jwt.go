@@ -0,0 +1,164 @@
+package auth
+
+import (
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/result/config"
+	"strings"
+	"time"
+)
+
+const methodJWT = "jwt"
+
+type jwtKey struct {
+	algorithm string
+	key       any
+}
+
+type jwtVerifier struct {
+	issuer    string
+	audience  string
+	roleClaim string
+	leeway    time.Duration
+	keys      map[string]jwtKey
+	methods   []string
+}
+
+func newJWTVerifier(cfg config.JWTConfig) (*jwtVerifier, error) {
+	v := &jwtVerifier{
+		issuer:    cfg.Issuer,
+		audience:  cfg.Audience,
+		roleClaim: cfg.RoleClaim,
+		leeway:    cfg.Leeway,
+		keys:      make(map[string]jwtKey, len(cfg.Keys)),
+	}
+	if v.roleClaim == "" {
+		v.roleClaim = "role"
+	}
+
+	seenMethods := make(map[string]bool)
+	for _, k := range cfg.Keys {
+		if len(cfg.Keys) > 1 && k.KeyID == "" {
+			return nil, fmt.Errorf("jwt key id is required when more than one key is configured")
+		}
+		if _, ok := v.keys[k.KeyID]; ok {
+			return nil, fmt.Errorf("duplicate jwt key id %q", k.KeyID)
+		}
+
+		key, err := parseJWTKey(k)
+		if err != nil {
+			return nil, fmt.Errorf("jwt key %q: %w", k.KeyID, err)
+		}
+		v.keys[k.KeyID] = jwtKey{algorithm: k.Algorithm, key: key}
+
+		if !seenMethods[k.Algorithm] {
+			seenMethods[k.Algorithm] = true
+			v.methods = append(v.methods, k.Algorithm)
+		}
+	}
+
+	return v, nil
+}
+
+func parseJWTKey(k config.JWTKeyConfig) (any, error) {
+	switch {
+	case strings.HasPrefix(k.Algorithm, "HS"):
+		if k.Secret == "" {
+			return nil, fmt.Errorf("secret is required for %s", k.Algorithm)
+		}
+		return []byte(k.Secret), nil
+	case strings.HasPrefix(k.Algorithm, "RS"), strings.HasPrefix(k.Algorithm, "PS"):
+		return jwt.ParseRSAPublicKeyFromPEM([]byte(k.PublicKey))
+	case strings.HasPrefix(k.Algorithm, "ES"):
+		return jwt.ParseECPublicKeyFromPEM([]byte(k.PublicKey))
+	case k.Algorithm == "EdDSA":
+		return jwt.ParseEdPublicKeyFromPEM([]byte(k.PublicKey))
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %q", k.Algorithm)
+	}
+}
+
+func (v *jwtVerifier) verify(token string) (*Principal, error) {
+	if len(v.keys) == 0 {
+		return nil, ErrInvalidCredential
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(v.methods),
+		jwt.WithLeeway(v.leeway),
+		jwt.WithExpirationRequired(),
+	}
+	if v.issuer != "" {
+		opts = append(opts, jwt.WithIssuer(v.issuer))
+	}
+	if v.audience != "" {
+		opts = append(opts, jwt.WithAudience(v.audience))
+	}
+
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, v.keyFunc, opts...); err != nil {
+		return nil, &custerr.ErrChain{
+			Message: ErrInvalidCredential.Message,
+			Code:    ErrInvalidCredential.Code,
+			Type:    ErrInvalidCredential.Type,
+			Cause:   err,
+		}
+	}
+
+	subject, err := claims.GetSubject()
+	if err != nil || subject == "" {
+		return nil, ErrInvalidCredential
+	}
+
+	return &Principal{
+		UserId: principalId(methodJWT, subject),
+		Name:   subject,
+		Role:   v.role(claims),
+		Method: methodJWT,
+	}, nil
+}
+
+func (v *jwtVerifier) keyFunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	key, ok := v.keys[kid]
+	if !ok && kid == "" && len(v.keys) == 1 {
+		for _, k := range v.keys {
+			key, ok = k, true
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+
+	if token.Method.Alg() != key.algorithm {
+		return nil, fmt.Errorf("algorithm %s does not match key %q", token.Method.Alg(), kid)
+	}
+
+	return key.key, nil
+}
+
+// role picks the most privileged known role from the role claim, which may be a
+// single string or a list. Unknown roles are ignored.
+func (v *jwtVerifier) role(claims jwt.MapClaims) string {
+	var roles []string
+	switch raw := claims[v.roleClaim].(type) {
+	case string:
+		roles = append(roles, raw)
+	case []any:
+		for _, r := range raw {
+			if s, ok := r.(string); ok {
+				roles = append(roles, s)
+			}
+		}
+	}
+
+	var best string
+	for _, r := range roles {
+		if roleRank[r] > roleRank[best] {
+			best = r
+		}
+	}
+	return best
+}
@@ -0,0 +1,170 @@
+package cache
+
+import (
+	"context"
+	"golang.org/x/sync/singleflight"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type entry struct {
+	value     any
+	expiresAt time.Time
+	tags      []string
+	versions  []uint64
+}
+
+// Cache is an in-memory read-through cache. Concurrent misses for the same key
+// share one load, and entries are invalidated through tags: bumping a tag
+// version makes every entry stored under the old version stale at once.
+// Cached values are shared between callers and must not be mutated.
+type Cache struct {
+	maxEntries  int
+	entries     map[string]*entry
+	tagVersions map[string]uint64
+	group       singleflight.Group
+	mu          sync.RWMutex
+}
+
+func New(maxEntries int) *Cache {
+	return &Cache{
+		maxEntries:  maxEntries,
+		entries:     make(map[string]*entry),
+		tagVersions: make(map[string]uint64),
+	}
+}
+
+// GetOrLoad returns the cached value for key, or runs load once for all
+// concurrent callers and caches its result for ttl. The boolean reports a hit.
+func (c *Cache) GetOrLoad(ctx context.Context, key string, ttl time.Duration, tags []string, load func(ctx context.Context) (any, error)) (any, bool, error) {
+	now := time.Now()
+
+	c.mu.RLock()
+	e, ok := c.entries[key]
+	valid := ok && c.isValid(e, now)
+	versions := c.versionsOf(tags)
+	c.mu.RUnlock()
+
+	if valid {
+		return e.value, true, nil
+	}
+
+	// Loads started before an invalidation must not be joined by callers arriving
+	// after it, so the tag versions are part of the flight key.
+	flightKey := key + "@" + joinVersions(versions)
+	value, err, _ := c.group.Do(flightKey, func() (any, error) {
+		// The load outlives a single caller, so one client disconnecting does
+		// not fail everyone waiting on the same flight.
+		value, err := load(context.WithoutCancel(ctx))
+		if err != nil {
+			return nil, err
+		}
+		c.store(key, value, ttl, tags, versions)
+		return value, nil
+	})
+
+	return value, false, err
+}
+
+// Load is GetOrLoad for callers that know the type of the cached value.
+func Load[T any](ctx context.Context, c *Cache, key string, ttl time.Duration, tags []string, load func(ctx context.Context) (T, error)) (T, bool, error) {
+	value, hit, err := c.GetOrLoad(ctx, key, ttl, tags, func(ctx context.Context) (any, error) {
+		return load(ctx)
+	})
+	if err != nil {
+		var zero T
+		return zero, false, err
+	}
+	return value.(T), hit, nil
+}
+
+// InvalidateTags marks every entry stored under any of the tags as stale.
+func (c *Cache) InvalidateTags(tags ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, tag := range tags {
+		c.tagVersions[tag]++
+	}
+}
+
+// Purge drops every entry.
+func (c *Cache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]*entry)
+}
+
+func (c *Cache) store(key string, value any, ttl time.Duration, tags []string, versions []uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// An invalidation landed while loading, so the value may already be stale.
+	for i, tag := range tags {
+		if c.tagVersions[tag] != versions[i] {
+			return
+		}
+	}
+
+	if _, exists := c.entries[key]; !exists && c.maxEntries > 0 && len(c.entries) >= c.maxEntries {
+		c.evict(time.Now())
+	}
+
+	c.entries[key] = &entry{
+		value:     value,
+		expiresAt: time.Now().Add(ttl),
+		tags:      tags,
+		versions:  versions,
+	}
+}
+
+// evict removes expired and invalidated entries, falling back to an arbitrary
+// entry when every entry is still valid.
+func (c *Cache) evict(now time.Time) {
+	removed := false
+	for key, e := range c.entries {
+		if !c.isValid(e, now) {
+			delete(c.entries, key)
+			removed = true
+		}
+	}
+	if removed {
+		return
+	}
+
+	for key := range c.entries {
+		delete(c.entries, key)
+		return
+	}
+}
+
+func (c *Cache) isValid(e *entry, now time.Time) bool {
+	if now.After(e.expiresAt) {
+		return false
+	}
+	for i, tag := range e.tags {
+		if c.tagVersions[tag] != e.versions[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Cache) versionsOf(tags []string) []uint64 {
+	versions := make([]uint64, len(tags))
+	for i, tag := range tags {
+		versions[i] = c.tagVersions[tag]
+	}
+	return versions
+}
+
+func joinVersions(versions []uint64) string {
+	parts := make([]string, len(versions))
+	for i, v := range versions {
+		parts[i] = strconv.FormatUint(v, 10)
+	}
+	return strings.Join(parts, ".")
+}
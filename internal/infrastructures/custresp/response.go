@@ -57,6 +57,8 @@ func getErrorCode(err *custerr.ErrChain) int {
 		return http.StatusTooEarly
 	case errors.Is(err.Type, ErrInvalidRequest):
 		return http.StatusNotAcceptable
+	case errors.Is(err.Type, ErrEmbargoed):
+		return http.StatusUnavailableForLegalReasons
 	default:
 		return rest.GetErrorCode(err)
 	}
@@ -8,4 +8,5 @@ var (
 	ErrTooManyRequest  = errors.New("too many request")
 	ErrRequestTooEarly = errors.New("request too early")
 	ErrInvalidRequest  = errors.New("invalid request")
+	ErrEmbargoed       = errors.New("results embargoed")
 )
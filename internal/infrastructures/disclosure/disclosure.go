@@ -0,0 +1,227 @@
+package disclosure
+
+import (
+	"fmt"
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+)
+
+const (
+	ModeSuppress = "suppress"
+	ModeRound    = "round"
+)
+
+// fields names the counts of a row as they appear in responses. The total comes
+// first and is the sum of the others.
+var fields = [...]string{"total_votes", "confirmed_votes", "finalizing_votes", "pending_votes", "error_votes"}
+
+// Control withholds or rounds vote counts small enough to single out voters. A
+// nil control publishes every count as is.
+type Control struct {
+	mode      string
+	threshold uint64
+	base      uint64
+}
+
+// New builds the control from the config, or returns nil when disclosure control is disabled.
+func New(cfg config.DisclosureConfig) (*Control, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	switch cfg.Mode {
+	case "":
+		cfg.Mode = ModeSuppress
+	case ModeSuppress, ModeRound:
+	default:
+		return nil, fmt.Errorf("unknown Disclosure.Mode %q", cfg.Mode)
+	}
+
+	if cfg.Threshold < 2 {
+		return nil, fmt.Errorf("Disclosure.Threshold must be at least 2, got %d", cfg.Threshold)
+	}
+
+	base := cfg.RoundTo
+	if base == 0 {
+		base = cfg.Threshold
+	}
+
+	return &Control{
+		mode:      cfg.Mode,
+		threshold: cfg.Threshold,
+		base:      base,
+	}, nil
+}
+
+// Elections protects election results published together, in place. The rows
+// are treated as one table whose column totals are public elsewhere.
+func (c *Control) Elections(results ...*response.ElectionVoteResultResponse) {
+	if c == nil {
+		return
+	}
+
+	table := make([]*row, len(results))
+	for i, result := range results {
+		table[i] = &row{counts: [len(fields)]*uint64{
+			&result.TotalVotes, &result.ConfirmedVotes, &result.FinalizingVotes, &result.PendingVotes, &result.ErrorVotes,
+		}}
+	}
+
+	c.apply(table)
+
+	for i, result := range results {
+		result.Suppressed = table[i].suppressed()
+		result.RoundedTo = table[i].roundedTo
+	}
+}
+
+// Regions protects region results published together, in place. The rows are
+// treated as one table whose column totals are public elsewhere.
+func (c *Control) Regions(results ...*response.RegionVoteResultResponse) {
+	if c == nil {
+		return
+	}
+
+	table := make([]*row, len(results))
+	for i, result := range results {
+		table[i] = &row{counts: [len(fields)]*uint64{
+			&result.TotalVotes, &result.ConfirmedVotes, &result.FinalizingVotes, &result.PendingVotes, &result.ErrorVotes,
+		}}
+	}
+
+	c.apply(table)
+
+	for i, result := range results {
+		result.Suppressed = table[i].suppressed()
+		result.RoundedTo = table[i].roundedTo
+	}
+}
+
+type row struct {
+	counts    [len(fields)]*uint64
+	hidden    [len(fields)]bool
+	roundedTo uint64
+}
+
+func (r *row) suppressed() []string {
+	var names []string
+	for i, hidden := range r.hidden {
+		if hidden {
+			names = append(names, fields[i])
+		}
+	}
+	return names
+}
+
+func (c *Control) small(count uint64) bool {
+	return count > 0 && count < c.threshold
+}
+
+func (c *Control) apply(table []*row) {
+	if c.mode == ModeRound {
+		c.round(table)
+		return
+	}
+	c.suppress(table)
+}
+
+// round rounds every count of a row holding a small count to the nearest
+// multiple of the base, so the total may no longer match the sum of its parts.
+func (c *Control) round(table []*row) {
+	for _, r := range table {
+		sensitive := false
+		for _, count := range r.counts {
+			sensitive = sensitive || c.small(*count)
+		}
+		if !sensitive {
+			continue
+		}
+
+		for _, count := range r.counts {
+			*count = (*count + c.base/2) / c.base * c.base
+		}
+		r.roundedTo = c.base
+	}
+}
+
+// suppress hides the small counts, then keeps hiding complementary counts until
+// no hidden count is the difference between a published total and published
+// parts, within a row or within a column of the table.
+func (c *Control) suppress(table []*row) {
+	for _, r := range table {
+		for i, count := range r.counts {
+			r.hidden[i] = c.small(*count)
+		}
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for _, r := range table {
+			changed = protectRow(r) || changed
+		}
+		if len(table) > 1 {
+			for i := range fields {
+				changed = protectColumn(table, i) || changed
+			}
+		}
+	}
+
+	for _, r := range table {
+		for i, count := range r.counts {
+			if r.hidden[i] {
+				*count = 0
+			}
+		}
+	}
+}
+
+// protectRow hides every part of a hidden total, and a second part next to a
+// single hidden part, since the total minus the other parts would reveal it.
+func protectRow(r *row) bool {
+	changed := false
+	if r.hidden[0] {
+		for i := 1; i < len(fields); i++ {
+			if !r.hidden[i] {
+				r.hidden[i] = true
+				changed = true
+			}
+		}
+		return changed
+	}
+
+	hidden, next := 0, -1
+	for i := 1; i < len(fields); i++ {
+		switch {
+		case r.hidden[i]:
+			hidden++
+		case next < 0 || *r.counts[i] < *r.counts[next]:
+			next = i
+		}
+	}
+	if hidden != 1 || next < 0 {
+		return false
+	}
+
+	r.hidden[next] = true
+	return true
+}
+
+// protectColumn hides the smallest other count of a column holding a single
+// hidden count, since the column total is published by another query.
+func protectColumn(table []*row, column int) bool {
+	hidden, next := 0, -1
+	for i, r := range table {
+		switch {
+		case r.hidden[column]:
+			hidden++
+		case next < 0 || *r.counts[column] < *table[next].counts[column]:
+			next = i
+		}
+	}
+	if hidden != 1 || next < 0 {
+		return false
+	}
+
+	table[next].hidden[column] = true
+	return true
+}
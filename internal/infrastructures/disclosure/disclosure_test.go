@@ -0,0 +1,162 @@
+package disclosure
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+)
+
+func newControl(t *testing.T, cfg config.DisclosureConfig) *Control {
+	t.Helper()
+	cfg.Enabled = true
+	control, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return control
+}
+
+func election(total, confirmed, finalizing, pending, errors uint64) *response.ElectionVoteResultResponse {
+	return &response.ElectionVoteResultResponse{
+		TotalVotes:      total,
+		ConfirmedVotes:  confirmed,
+		FinalizingVotes: finalizing,
+		PendingVotes:    pending,
+		ErrorVotes:      errors,
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.DisclosureConfig
+		wantNil bool
+		wantErr bool
+	}{
+		{name: "disabled", cfg: config.DisclosureConfig{}, wantNil: true},
+		{name: "default mode", cfg: config.DisclosureConfig{Enabled: true, Threshold: 5}},
+		{name: "unknown mode", cfg: config.DisclosureConfig{Enabled: true, Mode: "blur", Threshold: 5}, wantErr: true},
+		{name: "threshold too low", cfg: config.DisclosureConfig{Enabled: true, Threshold: 1}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			control, err := New(tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("New() error = %v, want error %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (control == nil) != tt.wantNil {
+				t.Fatalf("New() = %v, want nil %v", control, tt.wantNil)
+			}
+		})
+	}
+}
+
+func TestNilControlPublishesCounts(t *testing.T) {
+	var control *Control
+	result := election(12, 9, 0, 3, 0)
+	control.Elections(result)
+
+	if result.PendingVotes != 3 || result.Suppressed != nil {
+		t.Fatalf("nil control changed the result: %+v", result)
+	}
+}
+
+func TestSuppressProtectsRow(t *testing.T) {
+	control := newControl(t, config.DisclosureConfig{Mode: ModeSuppress, Threshold: 10})
+	result := election(120, 90, 20, 3, 7)
+	control.Elections(result)
+
+	if !slices.Contains(result.Suppressed, "pending_votes") || !slices.Contains(result.Suppressed, "error_votes") {
+		t.Fatalf("suppressed = %v, want pending_votes and error_votes", result.Suppressed)
+	}
+	if len(result.Suppressed) != 2 {
+		t.Fatalf("suppressed = %v, want only the small counts", result.Suppressed)
+	}
+	if result.PendingVotes != 0 || result.ErrorVotes != 0 {
+		t.Fatalf("suppressed counts read %d and %d, want 0", result.PendingVotes, result.ErrorVotes)
+	}
+	if result.TotalVotes != 120 || result.ConfirmedVotes != 90 || result.FinalizingVotes != 20 {
+		t.Fatalf("published counts changed: %+v", result)
+	}
+}
+
+func TestSuppressHidesComplementOfSingleSmallCount(t *testing.T) {
+	control := newControl(t, config.DisclosureConfig{Mode: ModeSuppress, Threshold: 10})
+	result := election(120, 80, 20, 3, 17)
+	control.Elections(result)
+
+	// 120 - 80 - 20 - 17 would give the pending count away.
+	if len(result.Suppressed) != 2 || !slices.Contains(result.Suppressed, "pending_votes") {
+		t.Fatalf("suppressed = %v, want pending_votes and one complement", result.Suppressed)
+	}
+	if !slices.Contains(result.Suppressed, "error_votes") {
+		t.Fatalf("suppressed = %v, want the smallest other count as complement", result.Suppressed)
+	}
+}
+
+func TestSuppressHidesSmallTotal(t *testing.T) {
+	control := newControl(t, config.DisclosureConfig{Mode: ModeSuppress, Threshold: 10})
+	result := election(4, 4, 0, 0, 0)
+	control.Elections(result)
+
+	if len(result.Suppressed) != len(fields) {
+		t.Fatalf("suppressed = %v, want every count of a row with a small total", result.Suppressed)
+	}
+}
+
+func TestSuppressProtectsColumn(t *testing.T) {
+	control := newControl(t, config.DisclosureConfig{Mode: ModeSuppress, Threshold: 10})
+	small := election(100, 80, 0, 3, 17)
+	large := election(200, 150, 0, 30, 20)
+	largest := election(300, 210, 0, 50, 40)
+	control.Elections(small, large, largest)
+
+	// The pending column total is public, so one hidden pending count would
+	// be the difference between it and the others.
+	hidden := 0
+	for _, result := range []*response.ElectionVoteResultResponse{small, large, largest} {
+		if slices.Contains(result.Suppressed, "pending_votes") {
+			hidden++
+		}
+	}
+	if hidden < 2 {
+		t.Fatalf("%d pending counts hidden, want at least 2", hidden)
+	}
+	if slices.Contains(largest.Suppressed, "pending_votes") {
+		t.Fatal("the largest pending count was hidden instead of the smallest")
+	}
+}
+
+func TestRoundRoundsSensitiveRows(t *testing.T) {
+	control := newControl(t, config.DisclosureConfig{Mode: ModeRound, Threshold: 10, RoundTo: 5})
+	sensitive := election(123, 98, 20, 3, 2)
+	safe := election(123, 68, 20, 15, 20)
+	control.Elections(sensitive, safe)
+
+	want := election(125, 100, 20, 5, 0)
+	if sensitive.TotalVotes != want.TotalVotes || sensitive.ConfirmedVotes != want.ConfirmedVotes ||
+		sensitive.FinalizingVotes != want.FinalizingVotes || sensitive.PendingVotes != want.PendingVotes ||
+		sensitive.ErrorVotes != want.ErrorVotes {
+		t.Fatalf("rounded row = %+v, want counts %+v", sensitive, want)
+	}
+	if sensitive.RoundedTo != 5 {
+		t.Fatalf("rounded to = %d, want 5", sensitive.RoundedTo)
+	}
+
+	if safe.TotalVotes != 123 || safe.RoundedTo != 0 {
+		t.Fatalf("row without small counts was rounded: %+v", safe)
+	}
+}
+
+func TestRegions(t *testing.T) {
+	control := newControl(t, config.DisclosureConfig{Mode: ModeSuppress, Threshold: 10})
+	result := &response.RegionVoteResultResponse{TotalVotes: 50, ConfirmedVotes: 40, PendingVotes: 5, ErrorVotes: 5}
+	control.Regions(result)
+
+	if !slices.Contains(result.Suppressed, "pending_votes") || !slices.Contains(result.Suppressed, "error_votes") {
+		t.Fatalf("suppressed = %v, want pending_votes and error_votes", result.Suppressed)
+	}
+}
@@ -0,0 +1,192 @@
+package electorate
+
+import (
+	"context"
+	"github.com/nocturna-ta/golib/log"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"math"
+	"sync"
+	"time"
+)
+
+// Register is the registered voter reference dataset turnout is computed
+// against. Counts are read from the repository at most once per refresh
+// interval. A nil register knows no registered voters.
+type Register struct {
+	repo    repository.RegisteredVotersRepository
+	refresh time.Duration
+
+	mu       sync.Mutex
+	loadedAt time.Time
+	counts   map[string]map[string]uint64
+}
+
+func New(repo repository.RegisteredVotersRepository, refresh time.Duration) *Register {
+	return &Register{
+		repo:    repo,
+		refresh: refresh,
+	}
+}
+
+// Invalidate makes the next lookup read the counts again.
+func (r *Register) Invalidate() {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	r.loadedAt = time.Time{}
+	r.mu.Unlock()
+}
+
+// Registered returns the voters registered for the election in the region. A
+// count for the election overrides the region-wide count. Without an election,
+// the region-wide count applies, or else the largest count of any election held
+// there. An empty region sums every region.
+func (r *Register) Registered(ctx context.Context, electionID, region string) (uint64, bool) {
+	counts := r.load(ctx)
+	if counts == nil {
+		return 0, false
+	}
+
+	if region != "" {
+		return lookup(counts, electionID, region)
+	}
+
+	var (
+		total uint64
+		found bool
+	)
+	for _, count := range effective(counts, electionID) {
+		total += count
+		found = true
+	}
+	return total, found
+}
+
+// Counts returns the registered voters in effect for the election in every
+// region with a count, as Registered would for each region.
+func (r *Register) Counts(ctx context.Context, electionID string) map[string]uint64 {
+	counts := r.load(ctx)
+	if counts == nil {
+		return nil
+	}
+	return effective(counts, electionID)
+}
+
+// Regions sets the registered voters and turnout of region results published
+// to the public, after disclosure control. Rows whose total was withheld get no
+// turnout, since it would give the total away.
+func (r *Register) Regions(ctx context.Context, results ...*response.RegionVoteResultResponse) {
+	for _, result := range results {
+		registered, ok := r.Registered(ctx, "", result.Region)
+		if !ok {
+			continue
+		}
+		result.RegisteredVoters = registered
+		if !withheld(result.Suppressed) {
+			result.TurnoutPercent = Percent(result.TotalVotes, registered)
+		}
+	}
+}
+
+// Percent is the share of registered voters who voted, in percent rounded to
+// two decimals, or nil without registered voters.
+func Percent(votes, registered uint64) *float64 {
+	if registered == 0 {
+		return nil
+	}
+	percent := math.Round(float64(votes)*10000/float64(registered)) / 100
+	return &percent
+}
+
+func (r *Register) load(ctx context.Context) map[string]map[string]uint64 {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.counts != nil && time.Since(r.loadedAt) < r.refresh {
+		return r.counts
+	}
+
+	rows, err := r.repo.GetRegisteredVoters(ctx, "")
+	if err != nil {
+		// Keep serving the counts read last rather than dropping turnout.
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[electorate.Register] Failed to read registered voters")
+		return r.counts
+	}
+
+	r.counts = index(rows)
+	r.loadedAt = time.Now()
+	return r.counts
+}
+
+func index(rows []*model.RegisteredVoters) map[string]map[string]uint64 {
+	counts := make(map[string]map[string]uint64)
+	for _, row := range rows {
+		byRegion, ok := counts[row.ElectionID]
+		if !ok {
+			byRegion = make(map[string]uint64)
+			counts[row.ElectionID] = byRegion
+		}
+		byRegion[row.Region] = row.RegisteredVoters
+	}
+	return counts
+}
+
+func lookup(counts map[string]map[string]uint64, electionID, region string) (uint64, bool) {
+	if electionID != "" {
+		if count, ok := counts[electionID][region]; ok {
+			return count, true
+		}
+	}
+	if count, ok := counts[""][region]; ok {
+		return count, true
+	}
+	if electionID != "" {
+		return 0, false
+	}
+
+	var (
+		largest uint64
+		found   bool
+	)
+	for _, byRegion := range counts {
+		if count, ok := byRegion[region]; ok && count >= largest {
+			largest = count
+			found = true
+		}
+	}
+	return largest, found
+}
+
+func effective(counts map[string]map[string]uint64, electionID string) map[string]uint64 {
+	byRegion := make(map[string]uint64)
+	for _, regions := range counts {
+		for region := range regions {
+			if _, ok := byRegion[region]; ok {
+				continue
+			}
+			if count, ok := lookup(counts, electionID, region); ok {
+				byRegion[region] = count
+			}
+		}
+	}
+	return byRegion
+}
+
+func withheld(suppressed []string) bool {
+	for _, field := range suppressed {
+		if field == "total_votes" {
+			return true
+		}
+	}
+	return false
+}
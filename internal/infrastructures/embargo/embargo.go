@@ -0,0 +1,154 @@
+package embargo
+
+import (
+	"context"
+	"fmt"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/infrastructures/auth"
+	"github.com/nocturna-ta/result/internal/infrastructures/custresp"
+	"github.com/nocturna-ta/result/pkg/constants"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// Rule withholds the results of an election pair in a region until LiftAt. An
+// empty election pair or region stands for every election pair or region.
+type Rule struct {
+	ElectionPairID string
+	Region         string
+	LiftAt         time.Time
+}
+
+// Schedule decides which results are still under embargo. A nil schedule
+// embargoes nothing.
+type Schedule struct {
+	rules []Rule
+}
+
+// New builds the schedule from the config, or returns nil when the embargo is disabled.
+func New(cfg config.EmbargoConfig) (*Schedule, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	schedule := &Schedule{}
+	for i, rule := range cfg.Schedules {
+		liftAt, err := time.Parse(time.RFC3339, rule.LiftAt)
+		if err != nil {
+			return nil, fmt.Errorf("embargo schedule %d: invalid LiftAt %q: %w", i, rule.LiftAt, err)
+		}
+
+		schedule.rules = append(schedule.rules, Rule{
+			ElectionPairID: rule.ElectionPairID,
+			Region:         rule.Region,
+			LiftAt:         liftAt,
+		})
+	}
+
+	return schedule, nil
+}
+
+// Until returns when the results of the election pair in the region are
+// released, or the zero time when no rule covers them. An empty election pair or
+// region asks for an aggregate over all of them, which is released only once
+// every rule it draws from is lifted. Otherwise a rule naming the region
+// overrides rules for every region, then a rule naming the election pair
+// overrides rules for every election pair.
+func (s *Schedule) Until(electionPairID, region string) time.Time {
+	if s == nil {
+		return time.Time{}
+	}
+
+	var rules []Rule
+	for _, rule := range s.rules {
+		if overlaps(rule.ElectionPairID, electionPairID) && overlaps(rule.Region, region) {
+			rules = append(rules, rule)
+		}
+	}
+	if region != "" {
+		rules = mostSpecific(rules, func(rule Rule) string { return rule.Region })
+	}
+	if electionPairID != "" {
+		rules = mostSpecific(rules, func(rule Rule) string { return rule.ElectionPairID })
+	}
+
+	var until time.Time
+	for _, rule := range rules {
+		if rule.LiftAt.After(until) {
+			until = rule.LiftAt
+		}
+	}
+	return until
+}
+
+// Embargoed reports whether the results of the election pair in the region are
+// still withheld at now, with the time they are released.
+func (s *Schedule) Embargoed(electionPairID, region string, now time.Time) (time.Time, bool) {
+	until := s.Until(electionPairID, region)
+	return until, now.Before(until)
+}
+
+// NextLift returns the first lift time after the given time and the rules lifted
+// at that moment, or the zero time when no rule is left.
+func (s *Schedule) NextLift(after time.Time) (time.Time, []Rule) {
+	if s == nil {
+		return time.Time{}, nil
+	}
+
+	var (
+		next  time.Time
+		rules []Rule
+	)
+	for _, rule := range s.rules {
+		switch {
+		case !rule.LiftAt.After(after):
+		case next.IsZero() || rule.LiftAt.Before(next):
+			next = rule.LiftAt
+			rules = []Rule{rule}
+		case rule.LiftAt.Equal(next):
+			rules = append(rules, rule)
+		}
+	}
+
+	sort.Slice(rules, func(i, j int) bool {
+		if rules[i].ElectionPairID != rules[j].ElectionPairID {
+			return rules[i].ElectionPairID < rules[j].ElectionPairID
+		}
+		return rules[i].Region < rules[j].Region
+	})
+	return next, rules
+}
+
+// Privileged reports whether the caller may see results under embargo.
+func Privileged(ctx context.Context) bool {
+	return auth.Authorize(ctx, constants.RoleObserver, constants.RoleAdmin) == nil
+}
+
+// Error is returned to public callers asking for results under embargo.
+func Error(until time.Time) error {
+	return &custerr.ErrChain{
+		Message: fmt.Sprintf("results are embargoed until polls close at %s", until.Format(time.RFC3339)),
+		Code:    http.StatusUnavailableForLegalReasons,
+		Type:    custresp.ErrEmbargoed,
+	}
+}
+
+func overlaps(ruleValue, value string) bool {
+	return ruleValue == "" || value == "" || ruleValue == value
+}
+
+// mostSpecific keeps the rules naming the requested value when there are any.
+func mostSpecific(rules []Rule, field func(Rule) string) []Rule {
+	var named []Rule
+	for _, rule := range rules {
+		if field(rule) != "" {
+			named = append(named, rule)
+		}
+	}
+	if len(named) == 0 {
+		return rules
+	}
+	return named
+}
@@ -0,0 +1,101 @@
+package embargo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nocturna-ta/result/config"
+)
+
+func newSchedule(t *testing.T, rules ...config.EmbargoScheduleConfig) *Schedule {
+	t.Helper()
+	schedule, err := New(config.EmbargoConfig{Enabled: true, Schedules: rules})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return schedule
+}
+
+func TestNew(t *testing.T) {
+	schedule, err := New(config.EmbargoConfig{})
+	if err != nil || schedule != nil {
+		t.Fatalf("New() with the embargo disabled = %v, %v, want nil", schedule, err)
+	}
+
+	_, err = New(config.EmbargoConfig{Enabled: true, Schedules: []config.EmbargoScheduleConfig{{LiftAt: "tomorrow"}}})
+	if err == nil {
+		t.Fatal("New() accepted an invalid LiftAt")
+	}
+}
+
+func TestScheduleUntil(t *testing.T) {
+	schedule := newSchedule(t,
+		config.EmbargoScheduleConfig{LiftAt: "2024-02-14T06:00:00Z"},
+		config.EmbargoScheduleConfig{Region: "papua", LiftAt: "2024-02-14T08:00:00Z"},
+		config.EmbargoScheduleConfig{ElectionPairID: "pair-1", LiftAt: "2024-02-14T07:00:00Z"},
+		config.EmbargoScheduleConfig{ElectionPairID: "pair-1", Region: "bali", LiftAt: "2024-02-14T05:00:00Z"},
+	)
+
+	tests := []struct {
+		name           string
+		electionPairID string
+		region         string
+		want           string
+	}{
+		{name: "default rule", electionPairID: "pair-2", region: "jakarta", want: "2024-02-14T06:00:00Z"},
+		{name: "region rule overrides default", electionPairID: "pair-2", region: "papua", want: "2024-02-14T08:00:00Z"},
+		{name: "election rule overrides default", electionPairID: "pair-1", region: "jakarta", want: "2024-02-14T07:00:00Z"},
+		{name: "election and region rule overrides both", electionPairID: "pair-1", region: "bali", want: "2024-02-14T05:00:00Z"},
+		{name: "region rule overrides election rule", electionPairID: "pair-1", region: "papua", want: "2024-02-14T08:00:00Z"},
+		{name: "aggregate waits for every rule", want: "2024-02-14T08:00:00Z"},
+		{name: "election aggregate waits for its regions", electionPairID: "pair-2", want: "2024-02-14T08:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, _ := time.Parse(time.RFC3339, tt.want)
+			if got := schedule.Until(tt.electionPairID, tt.region); !got.Equal(want) {
+				t.Fatalf("Until(%q, %q) = %s, want %s", tt.electionPairID, tt.region, got, want)
+			}
+		})
+	}
+}
+
+func TestScheduleEmbargoed(t *testing.T) {
+	schedule := newSchedule(t, config.EmbargoScheduleConfig{LiftAt: "2024-02-14T06:00:00Z"})
+	liftAt, _ := time.Parse(time.RFC3339, "2024-02-14T06:00:00Z")
+
+	if _, embargoed := schedule.Embargoed("pair", "region", liftAt.Add(-time.Second)); !embargoed {
+		t.Fatal("results released before the lift time")
+	}
+	if _, embargoed := schedule.Embargoed("pair", "region", liftAt); embargoed {
+		t.Fatal("results still embargoed at the lift time")
+	}
+
+	var none *Schedule
+	if _, embargoed := none.Embargoed("pair", "region", liftAt); embargoed {
+		t.Fatal("a nil schedule embargoed results")
+	}
+}
+
+func TestScheduleNextLift(t *testing.T) {
+	schedule := newSchedule(t,
+		config.EmbargoScheduleConfig{Region: "papua", LiftAt: "2024-02-14T08:00:00Z"},
+		config.EmbargoScheduleConfig{Region: "bali", LiftAt: "2024-02-14T06:00:00Z"},
+		config.EmbargoScheduleConfig{Region: "aceh", LiftAt: "2024-02-14T06:00:00Z"},
+	)
+	six, _ := time.Parse(time.RFC3339, "2024-02-14T06:00:00Z")
+	eight, _ := time.Parse(time.RFC3339, "2024-02-14T08:00:00Z")
+
+	next, rules := schedule.NextLift(six.Add(-time.Hour))
+	if !next.Equal(six) || len(rules) != 2 || rules[0].Region != "aceh" || rules[1].Region != "bali" {
+		t.Fatalf("NextLift() = %s, %+v, want the two rules lifted at 06:00 in order", next, rules)
+	}
+
+	if next, _ = schedule.NextLift(six); !next.Equal(eight) {
+		t.Fatalf("NextLift() after 06:00 = %s, want 08:00", next)
+	}
+	if next, rules = schedule.NextLift(eight); !next.IsZero() || rules != nil {
+		t.Fatalf("NextLift() after the last rule = %s, %+v, want nothing", next, rules)
+	}
+}
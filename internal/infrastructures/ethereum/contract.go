@@ -0,0 +1,197 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/nocturna-ta/golib/log"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// votingABI declares the events of the voting contract that are ingested.
+const votingABI = `[
+	{
+		"type": "event",
+		"name": "VoteCast",
+		"anonymous": false,
+		"inputs": [
+			{"name": "voteId", "type": "string", "indexed": false},
+			{"name": "voterId", "type": "string", "indexed": false},
+			{"name": "electionPairId", "type": "string", "indexed": false},
+			{"name": "region", "type": "string", "indexed": false}
+		]
+	}
+]`
+
+const voteCastEvent = "VoteCast"
+
+var (
+	// ErrReorganized is returned when blocks read in one scan are no longer on
+	// the canonical chain.
+	ErrReorganized = errors.New("chain reorganized during the scan")
+	// ErrBlockNotFound is returned for blocks above the head of the chain.
+	ErrBlockNotFound = errors.New("block not found")
+)
+
+// LogChain is what ingestion reads from a node. The ethclient behind the golib
+// client and the client of go-ethereum's simulated backend both implement it.
+type LogChain interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error)
+}
+
+// Block is a block of the canonical chain.
+type Block struct {
+	Number uint64
+	Hash   string
+	Time   time.Time
+}
+
+// VoteCast is a vote recorded by the voting contract.
+type VoteCast struct {
+	VoteID          string
+	VoterID         string
+	ElectionPairID  string
+	Region          string
+	TransactionHash string
+	LogIndex        uint
+	Block           Block
+}
+
+// EventSource reads the events of the voting contract.
+type EventSource struct {
+	chain   LogChain
+	address common.Address
+	abi     abi.ABI
+}
+
+func NewEventSource(chain LogChain, contractAddress string) (*EventSource, error) {
+	if !common.IsHexAddress(contractAddress) {
+		return nil, fmt.Errorf("invalid contract address %q", contractAddress)
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(votingABI))
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventSource{
+		chain:   chain,
+		address: common.HexToAddress(contractAddress),
+		abi:     parsed,
+	}, nil
+}
+
+// Address returns the checksummed address of the contract.
+func (s *EventSource) Address() string {
+	return s.address.Hex()
+}
+
+// Head returns the number of the latest block.
+func (s *EventSource) Head(ctx context.Context) (uint64, error) {
+	return s.chain.BlockNumber(ctx)
+}
+
+// Block returns the canonical block at number.
+func (s *EventSource) Block(ctx context.Context, number uint64) (*Block, error) {
+	header, err := s.chain.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+	if errors.Is(err, ethereum.NotFound) {
+		return nil, ErrBlockNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toBlock(header), nil
+}
+
+// VoteCasts returns the votes cast in blocks from through to, in chain order.
+// Events that do not decode are skipped. ErrReorganized is returned when a
+// block of the events is replaced while they are read.
+func (s *EventSource) VoteCasts(ctx context.Context, from, to uint64) ([]*VoteCast, error) {
+	logs, err := s.chain.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: []common.Address{s.address},
+		Topics:    [][]common.Hash{{s.abi.Events[voteCastEvent].ID}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make(map[uint64]*Block)
+	casts := make([]*VoteCast, 0, len(logs))
+	for _, entry := range logs {
+		if entry.Removed {
+			continue
+		}
+
+		block, ok := blocks[entry.BlockNumber]
+		if !ok {
+			block, err = s.Block(ctx, entry.BlockNumber)
+			if err != nil {
+				return nil, err
+			}
+			blocks[entry.BlockNumber] = block
+		}
+		if block.Hash != entry.BlockHash.Hex() {
+			return nil, ErrReorganized
+		}
+
+		cast, err := s.decodeVoteCast(entry)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error":            err,
+				"transaction_hash": entry.TxHash.Hex(),
+				"log_index":        entry.Index,
+			}).WarnWithCtx(ctx, "[ethereum.EventSource] Skipping VoteCast event that does not decode")
+			continue
+		}
+		cast.Block = *block
+		casts = append(casts, cast)
+	}
+
+	return casts, nil
+}
+
+func (s *EventSource) decodeVoteCast(entry types.Log) (*VoteCast, error) {
+	values, err := s.abi.Unpack(voteCastEvent, entry.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]string, len(values))
+	for i, value := range values {
+		field, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("argument %d is %T, not string", i, value)
+		}
+		fields[i] = field
+	}
+	if fields[0] == "" {
+		return nil, errors.New("empty vote ID")
+	}
+
+	return &VoteCast{
+		VoteID:          fields[0],
+		VoterID:         fields[1],
+		ElectionPairID:  fields[2],
+		Region:          fields[3],
+		TransactionHash: entry.TxHash.Hex(),
+		LogIndex:        entry.Index,
+	}, nil
+}
+
+func toBlock(header *types.Header) *Block {
+	return &Block{
+		Number: header.Number.Uint64(),
+		Hash:   header.Hash().Hex(),
+		Time:   time.Unix(int64(header.Time), 0).UTC(),
+	}
+}
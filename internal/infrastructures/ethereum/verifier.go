@@ -0,0 +1,117 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// Chain is what verification reads from a node. The ethclient behind the golib
+// client and the client of go-ethereum's simulated backend both implement it.
+type Chain interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// Verification is the state of a transaction on chain. Block fields are set
+// when it is mined.
+type Verification struct {
+	Status      model.ChainStatus
+	BlockNumber uint64
+	BlockHash   string
+	BlockTime   time.Time
+}
+
+// Record sets the chain status of a vote checked at checkedAt. A vote whose
+// transaction was dropped by a reorg keeps the block it was dropped from.
+func (v *Verification) Record(vote *model.VoteResult, checkedAt time.Time) {
+	vote.ChainStatus = string(v.Status)
+	vote.ChainCheckedAt = &checkedAt
+
+	switch v.Status {
+	case model.ChainStatusVerified, model.ChainStatusFailed:
+		blockNumber, blockTime := v.BlockNumber, v.BlockTime
+		vote.BlockNumber = &blockNumber
+		vote.BlockHash = v.BlockHash
+		vote.BlockTime = &blockTime
+	case model.ChainStatusMissing:
+		vote.BlockNumber = nil
+		vote.BlockHash = ""
+		vote.BlockTime = nil
+	}
+}
+
+// Verifier checks vote transactions against the canonical chain.
+type Verifier struct {
+	chain Chain
+}
+
+func NewVerifier(chain Chain) *Verifier {
+	return &Verifier{chain: chain}
+}
+
+// Head returns the number of the latest block.
+func (v *Verifier) Head(ctx context.Context) (uint64, error) {
+	return v.chain.BlockNumber(ctx)
+}
+
+// Verify checks the transaction of a vote. previousBlockHash is the block it
+// was verified in before, if any, so a transaction dropped by a reorg reads as
+// reverted rather than missing.
+func (v *Verifier) Verify(ctx context.Context, transactionHash, previousBlockHash string) (*Verification, error) {
+	if !isTransactionHash(transactionHash) {
+		return &Verification{Status: model.ChainStatusMissing}, nil
+	}
+
+	receipt, err := v.chain.TransactionReceipt(ctx, common.HexToHash(transactionHash))
+	if errors.Is(err, ethereum.NotFound) {
+		if previousBlockHash != "" {
+			return &Verification{Status: model.ChainStatusReverted}, nil
+		}
+		return &Verification{Status: model.ChainStatusMissing}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := v.chain.HeaderByNumber(ctx, receipt.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	// A receipt from a block since replaced is not on the canonical chain.
+	if header.Hash() != receipt.BlockHash {
+		return &Verification{Status: model.ChainStatusReverted}, nil
+	}
+
+	verification := &Verification{
+		Status:      model.ChainStatusVerified,
+		BlockNumber: receipt.BlockNumber.Uint64(),
+		BlockHash:   receipt.BlockHash.Hex(),
+		BlockTime:   time.Unix(int64(header.Time), 0).UTC(),
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		verification.Status = model.ChainStatusFailed
+	}
+
+	return verification, nil
+}
+
+func isTransactionHash(hash string) bool {
+	hash = strings.TrimPrefix(strings.TrimPrefix(hash, "0x"), "0X")
+	if len(hash) != 2*common.HashLength {
+		return false
+	}
+	for _, c := range hash {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return false
+		}
+	}
+	return true
+}
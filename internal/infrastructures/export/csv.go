@@ -0,0 +1,67 @@
+package export
+
+import (
+	"encoding/csv"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"io"
+	"time"
+)
+
+var csvHeader = []string{
+	"id", "voter_id", "election_pair_id", "region", "status", "transaction_hash",
+	"error_message", "voted_at", "processed_at", "created_at", "updated_at",
+}
+
+type csvEncoder struct {
+	writer *csv.Writer
+	record []string
+}
+
+func newCSVEncoder(w io.Writer) (*csvEncoder, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return nil, err
+	}
+
+	return &csvEncoder{
+		writer: writer,
+		record: make([]string, len(csvHeader)),
+	}, nil
+}
+
+func (e *csvEncoder) Encode(result *response.VoteResultResponse) error {
+	e.record[0] = result.ID
+	e.record[1] = result.VoterID
+	e.record[2] = result.ElectionPairID
+	e.record[3] = result.Region
+	e.record[4] = result.Status
+	e.record[5] = result.TransactionHash
+	e.record[6] = result.ErrorMessage
+	e.record[7] = formatTime(result.VotedAt)
+	e.record[8] = ""
+	if result.ProcessedAt != nil {
+		e.record[8] = formatTime(*result.ProcessedAt)
+	}
+	e.record[9] = formatTime(result.CreatedAt)
+	e.record[10] = formatTime(result.UpdatedAt)
+
+	if err := e.writer.Write(e.record); err != nil {
+		return err
+	}
+
+	// csv.Writer only reports write failures on flush, so surface them per
+	// row instead of streaming into a closed connection until the end.
+	return e.writer.Error()
+}
+
+func (e *csvEncoder) Close() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
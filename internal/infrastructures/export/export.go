@@ -0,0 +1,65 @@
+package export
+
+import (
+	"errors"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"io"
+	"strings"
+)
+
+type Format string
+
+const (
+	FormatCSV     Format = "csv"
+	FormatNDJSON  Format = "ndjson"
+	FormatParquet Format = "parquet"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported export format")
+
+// Encoder writes vote results to an underlying writer one row at a time.
+// Close must be called once all rows are written to flush trailing data
+// (the CSV buffer or the parquet footer); it does not close the underlying writer.
+type Encoder interface {
+	Encode(result *response.VoteResultResponse) error
+	Close() error
+}
+
+func ParseFormat(value string) (Format, error) {
+	switch format := Format(strings.ToLower(strings.TrimSpace(value))); format {
+	case FormatCSV, FormatNDJSON, FormatParquet:
+		return format, nil
+	case "":
+		return FormatCSV, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+func NewEncoder(format Format, w io.Writer) (Encoder, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVEncoder(w)
+	case FormatNDJSON:
+		return newNDJSONEncoder(w), nil
+	case FormatParquet:
+		return newParquetEncoder(w), nil
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+func (f Format) ContentType() string {
+	switch f {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatNDJSON:
+		return "application/x-ndjson"
+	default:
+		return "application/vnd.apache.parquet"
+	}
+}
+
+func (f Format) FileExtension() string {
+	return string(f)
+}
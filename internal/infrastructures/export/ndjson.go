@@ -0,0 +1,25 @@
+package export
+
+import (
+	"encoding/json"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"io"
+)
+
+type ndjsonEncoder struct {
+	encoder *json.Encoder
+}
+
+func newNDJSONEncoder(w io.Writer) *ndjsonEncoder {
+	return &ndjsonEncoder{
+		encoder: json.NewEncoder(w),
+	}
+}
+
+func (e *ndjsonEncoder) Encode(result *response.VoteResultResponse) error {
+	return e.encoder.Encode(result)
+}
+
+func (e *ndjsonEncoder) Close() error {
+	return nil
+}
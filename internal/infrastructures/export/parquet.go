@@ -0,0 +1,71 @@
+package export
+
+import (
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"github.com/parquet-go/parquet-go"
+	"io"
+	"time"
+)
+
+// parquetRowGroupSize bounds how many rows are buffered before a row group is
+// flushed, which caps the memory held by a parquet export.
+const parquetRowGroupSize = 10000
+
+type parquetRow struct {
+	ID              string     `parquet:"id"`
+	VoterID         string     `parquet:"voter_id"`
+	ElectionPairID  string     `parquet:"election_pair_id"`
+	Region          string     `parquet:"region"`
+	Status          string     `parquet:"status"`
+	TransactionHash string     `parquet:"transaction_hash"`
+	ErrorMessage    string     `parquet:"error_message"`
+	VotedAt         time.Time  `parquet:"voted_at,timestamp(millisecond)"`
+	ProcessedAt     *time.Time `parquet:"processed_at,optional"`
+	CreatedAt       time.Time  `parquet:"created_at,timestamp(millisecond)"`
+	UpdatedAt       time.Time  `parquet:"updated_at,timestamp(millisecond)"`
+}
+
+type parquetEncoder struct {
+	writer   *parquet.GenericWriter[parquetRow]
+	row      []parquetRow
+	buffered int
+}
+
+func newParquetEncoder(w io.Writer) *parquetEncoder {
+	return &parquetEncoder{
+		writer: parquet.NewGenericWriter[parquetRow](w, parquet.Compression(&parquet.Snappy)),
+		row:    make([]parquetRow, 1),
+	}
+}
+
+func (e *parquetEncoder) Encode(result *response.VoteResultResponse) error {
+	e.row[0] = parquetRow{
+		ID:              result.ID,
+		VoterID:         result.VoterID,
+		ElectionPairID:  result.ElectionPairID,
+		Region:          result.Region,
+		Status:          result.Status,
+		TransactionHash: result.TransactionHash,
+		ErrorMessage:    result.ErrorMessage,
+		VotedAt:         result.VotedAt,
+		ProcessedAt:     result.ProcessedAt,
+		CreatedAt:       result.CreatedAt,
+		UpdatedAt:       result.UpdatedAt,
+	}
+
+	if _, err := e.writer.Write(e.row); err != nil {
+		return err
+	}
+
+	e.buffered++
+	if e.buffered >= parquetRowGroupSize {
+		e.buffered = 0
+		return e.writer.Flush()
+	}
+
+	return nil
+}
+
+func (e *parquetEncoder) Close() error {
+	return e.writer.Close()
+}
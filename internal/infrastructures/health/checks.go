@@ -0,0 +1,40 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/nocturna-ta/golib/database/sql"
+	"time"
+)
+
+var ErrLoopNotStarted = errors.New("loop not started")
+
+func DatabaseMasterCheck(db *sql.Store) CheckFunc {
+	return func(ctx context.Context) error {
+		return db.GetMaster().PingContext(ctx)
+	}
+}
+
+func DatabaseSlaveCheck(db *sql.Store) CheckFunc {
+	return func(ctx context.Context) error {
+		return db.GetSlave().PingContext(ctx)
+	}
+}
+
+// LoopCheck reports a long running loop as down when lastTick, the time it
+// last made progress, is zero or older than maxAge.
+func LoopCheck(lastTick func() time.Time, maxAge time.Duration) CheckFunc {
+	return func(ctx context.Context) error {
+		last := lastTick()
+		if last.IsZero() {
+			return ErrLoopNotStarted
+		}
+
+		if age := time.Since(last); age > maxAge {
+			return fmt.Errorf("loop stalled, last tick %s ago", age.Truncate(time.Millisecond))
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,125 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusUp   Status = "up"
+	StatusDown Status = "down"
+
+	defaultTimeout = 2 * time.Second
+)
+
+var ErrCheckTimeout = errors.New("health check timed out")
+
+// CheckFunc reports a component as healthy by returning nil.
+type CheckFunc func(ctx context.Context) error
+
+type ComponentStatus struct {
+	Status    Status  `json:"status"`
+	LatencyMs float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+}
+
+type Report struct {
+	Status     Status                     `json:"status"`
+	CheckedAt  time.Time                  `json:"checked_at"`
+	Components map[string]ComponentStatus `json:"components"`
+}
+
+type namedCheck struct {
+	name  string
+	check CheckFunc
+}
+
+// Checker runs a set of named component checks concurrently. The overall
+// status is down as soon as any component is down.
+type Checker struct {
+	timeout time.Duration
+	checks  []namedCheck
+}
+
+func NewChecker(timeout time.Duration) *Checker {
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	return &Checker{
+		timeout: timeout,
+	}
+}
+
+func (c *Checker) Register(name string, check CheckFunc) *Checker {
+	c.checks = append(c.checks, namedCheck{
+		name:  name,
+		check: check,
+	})
+	return c
+}
+
+func (c *Checker) Check(ctx context.Context) *Report {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	report := &Report{
+		Status:     StatusUp,
+		CheckedAt:  time.Now(),
+		Components: make(map[string]ComponentStatus, len(c.checks)),
+	}
+
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+
+	for _, nc := range c.checks {
+		wg.Add(1)
+		go func(nc namedCheck) {
+			defer wg.Done()
+
+			start := time.Now()
+			err := runCheck(ctx, nc.check)
+			status := ComponentStatus{
+				Status:    StatusUp,
+				LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+			}
+			if err != nil {
+				status.Status = StatusDown
+				status.Error = err.Error()
+			}
+
+			mu.Lock()
+			report.Components[nc.name] = status
+			if err != nil {
+				report.Status = StatusDown
+			}
+			mu.Unlock()
+		}(nc)
+	}
+
+	wg.Wait()
+
+	return report
+}
+
+// runCheck bounds a check by the context deadline even when the check itself
+// ignores cancellation.
+func runCheck(ctx context.Context, check CheckFunc) error {
+	result := make(chan error, 1)
+	go func() {
+		result <- check(ctx)
+	}()
+
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return ErrCheckTimeout
+	}
+}
@@ -0,0 +1,23 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// HTTPHandler serves a checker report for listeners outside the Fiber API,
+// answering 503 when any component is down.
+func HTTPHandler(checker *Checker) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := checker.Check(r.Context())
+
+		code := http.StatusOK
+		if report.Status != StatusUp {
+			code = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(report)
+	})
+}
@@ -0,0 +1,151 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"github.com/IBM/sarama"
+	"github.com/nocturna-ta/result/config"
+	"sync"
+)
+
+// GroupHealth checks that the consumer group has live members and that its
+// committed offsets are within maxLag of the newest offset on every partition
+// of the consumed topics.
+type GroupHealth struct {
+	brokers []string
+	version string
+	group   string
+	topics  []string
+	maxLag  int64
+
+	mu     sync.Mutex
+	client sarama.Client
+	admin  sarama.ClusterAdmin
+}
+
+func NewGroupHealth(cfg config.KafkaConsumerConfig, topics []string, maxLag int64) *GroupHealth {
+	return &GroupHealth{
+		brokers: cfg.Brokers,
+		version: cfg.ClusterVersion,
+		group:   cfg.ConsumerGroup,
+		topics:  topics,
+		maxLag:  maxLag,
+	}
+}
+
+func (g *GroupHealth) Check(ctx context.Context) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if err := g.connect(); err != nil {
+		return err
+	}
+
+	groups, err := g.admin.DescribeConsumerGroups([]string{g.group})
+	if err != nil {
+		g.reset()
+		return fmt.Errorf("describe consumer group: %w", err)
+	}
+	if len(groups) == 0 {
+		return fmt.Errorf("consumer group %s not found", g.group)
+	}
+	if groups[0].State != "Stable" || len(groups[0].Members) == 0 {
+		return fmt.Errorf("consumer group %s is %s with %d members", g.group, groups[0].State, len(groups[0].Members))
+	}
+
+	topicPartitions := make(map[string][]int32, len(g.topics))
+	for _, topic := range g.topics {
+		partitions, err := g.client.Partitions(topic)
+		if err != nil {
+			return fmt.Errorf("list partitions of %s: %w", topic, err)
+		}
+		topicPartitions[topic] = partitions
+	}
+
+	offsets, err := g.admin.ListConsumerGroupOffsets(g.group, topicPartitions)
+	if err != nil {
+		g.reset()
+		return fmt.Errorf("list consumer group offsets: %w", err)
+	}
+
+	for topic, partitions := range topicPartitions {
+		var lag int64
+		for _, partition := range partitions {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			newest, err := g.client.GetOffset(topic, partition, sarama.OffsetNewest)
+			if err != nil {
+				return fmt.Errorf("get newest offset of %s/%d: %w", topic, partition, err)
+			}
+
+			committed := int64(0)
+			if block := offsets.GetBlock(topic, partition); block != nil && block.Offset >= 0 {
+				committed = block.Offset
+			}
+			lag += newest - committed
+		}
+
+		if g.maxLag > 0 && lag > g.maxLag {
+			return fmt.Errorf("consumer lag on %s is %d, above %d", topic, lag, g.maxLag)
+		}
+	}
+
+	return nil
+}
+
+func (g *GroupHealth) Close() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.admin == nil {
+		return nil
+	}
+
+	// Closing the admin also closes the client it was created from.
+	err := g.admin.Close()
+	g.admin = nil
+	g.client = nil
+	return err
+}
+
+func (g *GroupHealth) connect() error {
+	if g.admin != nil {
+		return nil
+	}
+
+	cfg := sarama.NewConfig()
+	if g.version != "" {
+		version, err := sarama.ParseKafkaVersion(g.version)
+		if err != nil {
+			return fmt.Errorf("parse kafka version: %w", err)
+		}
+		cfg.Version = version
+	}
+
+	client, err := sarama.NewClient(g.brokers, cfg)
+	if err != nil {
+		return fmt.Errorf("connect to kafka: %w", err)
+	}
+
+	admin, err := sarama.NewClusterAdminFromClient(client)
+	if err != nil {
+		_ = client.Close()
+		return fmt.Errorf("create kafka admin: %w", err)
+	}
+
+	g.client = client
+	g.admin = admin
+	return nil
+}
+
+// reset drops the connection so the next check reconnects, in case the
+// failure was caused by a broken broker connection.
+func (g *GroupHealth) reset() {
+	if g.admin != nil {
+		_ = g.admin.Close()
+	}
+	g.admin = nil
+	g.client = nil
+}
@@ -0,0 +1,139 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/IBM/sarama"
+	"github.com/nocturna-ta/golib/log"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/config"
+)
+
+// Producer publishes keyed messages as they are, without the event envelope of
+// golib, so topics it writes can be read by any Kafka client.
+type Producer struct {
+	client   sarama.Client
+	producer sarama.SyncProducer
+}
+
+// NewProducer connects to the brokers of cfg, falling back to the consumer's
+// brokers and cluster version when cfg leaves them empty.
+func NewProducer(cfg config.KafkaProducerConfig, consumer config.KafkaConsumerConfig) (*Producer, error) {
+	brokers := cfg.Brokers
+	if len(brokers) == 0 {
+		brokers = consumer.Brokers
+	}
+	clusterVersion := cfg.ClusterVersion
+	if clusterVersion == "" {
+		clusterVersion = consumer.ClusterVersion
+	}
+
+	saramaCfg := sarama.NewConfig()
+	if clusterVersion != "" {
+		version, err := sarama.ParseKafkaVersion(clusterVersion)
+		if err != nil {
+			return nil, fmt.Errorf("parse kafka version: %w", err)
+		}
+		saramaCfg.Version = version
+	}
+	saramaCfg.Producer.RequiredAcks = sarama.WaitForAll
+	saramaCfg.Producer.Return.Successes = true
+	saramaCfg.Producer.Partitioner = sarama.NewHashPartitioner
+	if cfg.Timeout > 0 {
+		saramaCfg.Producer.Timeout = cfg.Timeout
+	}
+	if cfg.MaxRetry > 0 {
+		saramaCfg.Producer.Retry.Max = cfg.MaxRetry
+	}
+
+	client, err := sarama.NewClient(brokers, saramaCfg)
+	if err != nil {
+		return nil, fmt.Errorf("create kafka client: %w", err)
+	}
+
+	producer, err := sarama.NewSyncProducerFromClient(client)
+	if err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("create kafka producer: %w", err)
+	}
+
+	return &Producer{
+		client:   client,
+		producer: producer,
+	}, nil
+}
+
+// EnsureCompactedTopic creates the topic with cleanup.policy=compact when it
+// does not exist. An existing topic is left alone, with a warning if it is not
+// compacted.
+func (p *Producer) EnsureCompactedTopic(topic string, partitions int32, replicationFactor int16) error {
+	admin, err := sarama.NewClusterAdminFromClient(p.client)
+	if err != nil {
+		return fmt.Errorf("create kafka admin: %w", err)
+	}
+	// Closing the admin would close the client shared with the producer.
+
+	compact := "compact"
+	err = admin.CreateTopic(topic, &sarama.TopicDetail{
+		NumPartitions:     partitions,
+		ReplicationFactor: replicationFactor,
+		ConfigEntries: map[string]*string{
+			"cleanup.policy": &compact,
+		},
+	}, false)
+	if err == nil {
+		log.WithFields(log.Fields{
+			"topic":      topic,
+			"partitions": partitions,
+		}).Info("[kafka.Producer] Created compacted topic")
+		return nil
+	}
+
+	var topicErr *sarama.TopicError
+	if !errors.As(err, &topicErr) || topicErr.Err != sarama.ErrTopicAlreadyExists {
+		return fmt.Errorf("create topic %s: %w", topic, err)
+	}
+
+	entries, err := admin.DescribeConfig(sarama.ConfigResource{
+		Type:        sarama.TopicResource,
+		Name:        topic,
+		ConfigNames: []string{"cleanup.policy"},
+	})
+	if err != nil {
+		return fmt.Errorf("describe topic %s: %w", topic, err)
+	}
+	for _, entry := range entries {
+		if entry.Name == "cleanup.policy" && entry.Value != compact && entry.Value != "compact,delete" {
+			log.WithFields(log.Fields{
+				"topic":          topic,
+				"cleanup_policy": entry.Value,
+			}).Warn("[kafka.Producer] Topic is not compacted, older states will not be replaced by the latest one")
+		}
+	}
+
+	return nil
+}
+
+// Publish writes value under key and waits for every in-sync replica.
+func (p *Producer) Publish(ctx context.Context, topic, key string, value []byte) error {
+	span, _ := tracing.StartSpanFromContext(ctx, "kafka.Producer.Publish")
+	defer span.End()
+
+	_, _, err := p.producer.SendMessage(&sarama.ProducerMessage{
+		Topic: topic,
+		Key:   sarama.StringEncoder(key),
+		Value: sarama.ByteEncoder(value),
+	})
+	return err
+}
+
+func (p *Producer) Close() error {
+	if err := p.producer.Close(); err != nil {
+		return err
+	}
+	if p.client.Closed() {
+		return nil
+	}
+	return p.client.Close()
+}
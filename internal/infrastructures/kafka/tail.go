@@ -0,0 +1,117 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"github.com/IBM/sarama"
+	"github.com/nocturna-ta/golib/log"
+	"github.com/nocturna-ta/result/config"
+	"sync"
+)
+
+// TailHandler receives every message read by a Tail.
+type TailHandler func(ctx context.Context, topic string, data []byte)
+
+// Tail reads topics from the newest offset on every partition without joining a
+// consumer group, so each process sees every message published while it runs.
+// It suits broadcast style work such as cache invalidation, where nothing has to
+// be replayed after a restart.
+type Tail struct {
+	brokers []string
+	version string
+	topics  []string
+	handler TailHandler
+
+	consumer   sarama.Consumer
+	partitions []sarama.PartitionConsumer
+	wg         sync.WaitGroup
+}
+
+func NewTail(cfg config.KafkaConsumerConfig, topics []string, handler TailHandler) *Tail {
+	return &Tail{
+		brokers: cfg.Brokers,
+		version: cfg.ClusterVersion,
+		topics:  topics,
+		handler: handler,
+	}
+}
+
+// Start attaches to every partition of the topics and returns once reading has begun.
+func (t *Tail) Start(ctx context.Context) error {
+	saramaCfg := sarama.NewConfig()
+	if t.version != "" {
+		version, err := sarama.ParseKafkaVersion(t.version)
+		if err != nil {
+			return fmt.Errorf("parse kafka version: %w", err)
+		}
+		saramaCfg.Version = version
+	}
+
+	consumer, err := sarama.NewConsumer(t.brokers, saramaCfg)
+	if err != nil {
+		return fmt.Errorf("create kafka consumer: %w", err)
+	}
+	t.consumer = consumer
+
+	for _, topic := range t.topics {
+		partitions, err := consumer.Partitions(topic)
+		if err != nil {
+			_ = t.Close()
+			return fmt.Errorf("list partitions of %s: %w", topic, err)
+		}
+
+		for _, partition := range partitions {
+			pc, err := consumer.ConsumePartition(topic, partition, sarama.OffsetNewest)
+			if err != nil {
+				_ = t.Close()
+				return fmt.Errorf("consume %s/%d: %w", topic, partition, err)
+			}
+			t.partitions = append(t.partitions, pc)
+
+			t.wg.Add(1)
+			go t.read(ctx, pc)
+		}
+	}
+
+	return nil
+}
+
+func (t *Tail) read(ctx context.Context, pc sarama.PartitionConsumer) {
+	defer t.wg.Done()
+
+	for {
+		select {
+		case msg, ok := <-pc.Messages():
+			if !ok {
+				return
+			}
+			t.handler(ctx, msg.Topic, msg.Value)
+		case err, ok := <-pc.Errors():
+			if !ok {
+				return
+			}
+			log.WithFields(log.Fields{
+				"error":     err.Err,
+				"topic":     err.Topic,
+				"partition": err.Partition,
+			}).ErrorWithCtx(ctx, "[kafka.Tail] Failed to read message")
+		}
+	}
+}
+
+// Close stops every partition reader and waits for in-flight handlers.
+func (t *Tail) Close() error {
+	if t.consumer == nil {
+		return nil
+	}
+	// Partition consumers must be closed before their parent consumer.
+	for _, pc := range t.partitions {
+		pc.AsyncClose()
+	}
+	t.wg.Wait()
+	t.partitions = nil
+
+	err := t.consumer.Close()
+	t.consumer = nil
+	return err
+}
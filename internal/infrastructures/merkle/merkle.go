@@ -0,0 +1,118 @@
+package merkle
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+)
+
+// Leaves and inner nodes are hashed with distinct prefixes, as in RFC 6962, so
+// an inner node can never be passed off as a leaf.
+const (
+	leafPrefix = 0x00
+	nodePrefix = 0x01
+	// separator joins the fields of a leaf. IDs never contain it.
+	separator = 0x00
+)
+
+var ErrLeafOutOfRange = errors.New("leaf index out of range")
+
+// Step is one sibling on the path from a leaf to the root. Left reports
+// whether the sibling is hashed on the left of the running hash.
+type Step struct {
+	Hash []byte
+	Left bool
+}
+
+// Tree is a binary Merkle tree over SHA-256. A node without a sibling is
+// promoted to the next level unchanged rather than paired with itself, so no
+// two leaf lists share a root.
+type Tree struct {
+	levels [][][]byte
+}
+
+// LeafHash hashes a confirmed vote into a leaf:
+// SHA-256(0x00 || vote_id || 0x00 || election_pair_id || 0x00 || region || 0x00 || transaction_hash).
+func LeafHash(voteID, electionPairID, region, transactionHash string) []byte {
+	h := sha256.New()
+	h.Write([]byte{leafPrefix})
+	for i, field := range []string{voteID, electionPairID, region, transactionHash} {
+		if i > 0 {
+			h.Write([]byte{separator})
+		}
+		h.Write([]byte(field))
+	}
+	return h.Sum(nil)
+}
+
+// NodeHash hashes two children into their parent: SHA-256(0x01 || left || right).
+func NodeHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{nodePrefix})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// Build builds the tree over the leaf hashes in order.
+func Build(leaves [][]byte) *Tree {
+	t := &Tree{levels: [][][]byte{leaves}}
+	for level := leaves; len(level) > 1; {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			next = append(next, NodeHash(level[i], level[i+1]))
+		}
+		t.levels = append(t.levels, next)
+		level = next
+	}
+	return t
+}
+
+// Size returns the number of leaves.
+func (t *Tree) Size() int {
+	return len(t.levels[0])
+}
+
+// Root returns the root hash, or the hash of nothing for an empty tree.
+func (t *Tree) Root() []byte {
+	top := t.levels[len(t.levels)-1]
+	if len(top) == 0 {
+		empty := sha256.Sum256(nil)
+		return empty[:]
+	}
+	return top[0]
+}
+
+// Proof returns the inclusion path of the leaf at index, from the leaf up.
+func (t *Tree) Proof(index int) ([]Step, error) {
+	if index < 0 || index >= t.Size() {
+		return nil, ErrLeafOutOfRange
+	}
+
+	var path []Step
+	for _, level := range t.levels[:len(t.levels)-1] {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			path = append(path, Step{Hash: level[sibling], Left: sibling < index})
+		}
+		index /= 2
+	}
+	return path, nil
+}
+
+// Verify reports whether the path leads from the leaf to the root.
+func Verify(leaf []byte, path []Step, root []byte) bool {
+	hash := leaf
+	for _, step := range path {
+		if step.Left {
+			hash = NodeHash(step.Hash, hash)
+		} else {
+			hash = NodeHash(hash, step.Hash)
+		}
+	}
+	return bytes.Equal(hash, root)
+}
@@ -0,0 +1,99 @@
+package merkle
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func leaves(n int) [][]byte {
+	hashes := make([][]byte, n)
+	for i := range hashes {
+		hashes[i] = LeafHash(fmt.Sprintf("vote-%d", i), "pair", "region", fmt.Sprintf("0x%x", i))
+	}
+	return hashes
+}
+
+func TestBuildRoot(t *testing.T) {
+	l := leaves(3)
+
+	tests := []struct {
+		name   string
+		leaves [][]byte
+		want   []byte
+	}{
+		{name: "one leaf", leaves: l[:1], want: l[0]},
+		{name: "two leaves", leaves: l[:2], want: NodeHash(l[0], l[1])},
+		// The odd leaf is promoted unchanged, not paired with itself.
+		{name: "three leaves", leaves: l, want: NodeHash(NodeHash(l[0], l[1]), l[2])},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Build(tt.leaves).Root(); !bytes.Equal(got, tt.want) {
+				t.Fatalf("Root() = %x, want %x", got, tt.want)
+			}
+		})
+	}
+
+	empty := sha256.Sum256(nil)
+	if got := Build(nil).Root(); !bytes.Equal(got, empty[:]) {
+		t.Fatalf("Root() of an empty tree = %x, want the hash of nothing", got)
+	}
+}
+
+func TestDuplicatedLastLeafChangesRoot(t *testing.T) {
+	l := leaves(3)
+	if bytes.Equal(Build(l).Root(), Build(append(l, l[2])).Root()) {
+		t.Fatal("duplicating the last leaf kept the root")
+	}
+}
+
+func TestLeafHashSeparatesFields(t *testing.T) {
+	if bytes.Equal(LeafHash("ab", "c", "r", "tx"), LeafHash("a", "bc", "r", "tx")) {
+		t.Fatal("moving a character across fields kept the leaf hash")
+	}
+}
+
+func TestProofVerifies(t *testing.T) {
+	for size := 1; size <= 9; size++ {
+		l := leaves(size)
+		tree := Build(l)
+		root := tree.Root()
+
+		for index := range l {
+			path, err := tree.Proof(index)
+			if err != nil {
+				t.Fatalf("size %d: Proof(%d) error = %v", size, index, err)
+			}
+			if !Verify(l[index], path, root) {
+				t.Fatalf("size %d: proof of leaf %d does not verify", size, index)
+			}
+			if size > 1 && Verify(l[(index+1)%size], path, root) {
+				t.Fatalf("size %d: proof of leaf %d verifies another leaf", size, index)
+			}
+		}
+	}
+}
+
+func TestVerifyRejectsTamperedPath(t *testing.T) {
+	l := leaves(4)
+	tree := Build(l)
+	path, _ := tree.Proof(1)
+
+	path[0].Left = !path[0].Left
+	if Verify(l[1], path, tree.Root()) {
+		t.Fatal("a path with a flipped side verifies")
+	}
+}
+
+func TestProofOutOfRange(t *testing.T) {
+	tree := Build(leaves(2))
+	for _, index := range []int{-1, 2} {
+		if _, err := tree.Proof(index); !errors.Is(err, ErrLeafOutOfRange) {
+			t.Fatalf("Proof(%d) error = %v, want ErrLeafOutOfRange", index, err)
+		}
+	}
+}
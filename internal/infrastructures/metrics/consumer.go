@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"context"
+	libCtx "github.com/nocturna-ta/golib/context"
+	"github.com/nocturna-ta/golib/event"
+	"github.com/nocturna-ta/result/pkg/constants"
+	"sync"
+	"time"
+)
+
+const (
+	defaultOperation  = "default"
+	failedAttemptsTTL = 30 * time.Minute
+)
+
+// InstrumentConsumer wraps a consumer handler with delivery, failure and retry
+// counters. Retries are detected both from the retry attempt metadata set by
+// topic based retries and from in-process retries, which hand the same message
+// back to the handler.
+func InstrumentConsumer(handler event.ConsumerHandler) event.ConsumerHandler {
+	var failed sync.Map
+
+	return func(ctx context.Context, message *event.EventConsumeMessage) error {
+		operation := defaultOperation
+		if op, ok := message.Metadata[constants.MetaDataOperation].(string); ok && op != "" {
+			operation = op
+		}
+
+		ConsumerMessagesConsumed.WithLabelValues(message.Topic, operation).Inc()
+
+		_, retriedInProcess := failed.LoadAndDelete(message)
+		if retriedInProcess || isTopicRetry(message) {
+			ConsumerMessagesRetried.WithLabelValues(message.Topic, operation).Inc()
+		}
+
+		err := handler(ctx, message)
+		if err != nil {
+			ConsumerMessagesFailed.WithLabelValues(message.Topic, operation).Inc()
+			pruneFailedAttempts(&failed)
+			failed.Store(message, time.Now())
+		}
+
+		return err
+	}
+}
+
+func isTopicRetry(message *event.EventConsumeMessage) bool {
+	attempts, ok := message.Metadata[libCtx.MetadataRetryAttempts].(float64)
+	return ok && attempts > 0
+}
+
+// pruneFailedAttempts forgets messages whose retries were exhausted, since the
+// handler never sees them again to clear their entry.
+func pruneFailedAttempts(failed *sync.Map) {
+	threshold := time.Now().Add(-failedAttemptsTTL)
+	failed.Range(func(key, value any) bool {
+		if value.(time.Time).Before(threshold) {
+			failed.Delete(key)
+		}
+		return true
+	})
+}
@@ -0,0 +1,96 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	"github.com/nocturna-ta/golib/log"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Handler exposes the default registry in the Prometheus text format.
+func Handler() fiber.Handler {
+	return adaptor.HTTPHandler(promhttp.Handler())
+}
+
+// Middleware records request count and latency labelled by the matched route
+// pattern rather than the raw path, so path parameters do not explode the
+// label cardinality. WebSocket upgrades are skipped since their duration is
+// the lifetime of the connection.
+func Middleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if strings.EqualFold(c.Get(fiber.HeaderUpgrade), "websocket") {
+			return c.Next()
+		}
+
+		start := time.Now()
+		err := c.Next()
+
+		status := c.Response().StatusCode()
+		var fiberErr *fiber.Error
+		if errors.As(err, &fiberErr) {
+			status = fiberErr.Code
+		} else if err != nil && status < http.StatusBadRequest {
+			status = http.StatusInternalServerError
+		}
+
+		route := c.Route().Path
+		method := c.Method()
+
+		HTTPRequestsTotal.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+		HTTPRequestDuration.WithLabelValues(method, route).Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
+
+// Server is a standalone metrics listener for processes that do not run the
+// Fiber API, such as the Kafka consumer.
+type Server struct {
+	mux    *http.ServeMux
+	server *http.Server
+}
+
+func NewServer(port uint, path string) *Server {
+	if path == "" {
+		path = "/metrics"
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(path, promhttp.Handler())
+
+	return &Server{
+		mux: mux,
+		server: &http.Server{
+			Addr:              fmt.Sprintf(":%d", port),
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+	}
+}
+
+// Handle registers an additional handler on the listener, such as health probes.
+// It must be called before Run.
+func (s *Server) Handle(path string, handler http.Handler) {
+	s.mux.Handle(path, handler)
+}
+
+func (s *Server) Run() {
+	log.Infof("Metrics Listening on %s", s.server.Addr)
+	if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.WithFields(log.Fields{
+			"error": err,
+			"addr":  s.server.Addr,
+		}).Error("[MetricsServer] Failed to serve metrics")
+	}
+}
+
+func (s *Server) Stop(ctx context.Context) error {
+	return s.server.Shutdown(ctx)
+}
@@ -0,0 +1,195 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"time"
+)
+
+const namespace = "result"
+
+var (
+	HTTPRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Number of HTTP requests handled, by method, route and status code.",
+	}, []string{"method", "route", "status"})
+
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "HTTP request latency, by method and route.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	DBQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_duration_seconds",
+		Help:      "ClickHouse query latency, by repository and method.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	}, []string{"repository", "method"})
+
+	ConsumerMessagesConsumed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "consumer",
+		Name:      "messages_consumed_total",
+		Help:      "Number of Kafka message deliveries handed to a consumer handler, by topic and operation.",
+	}, []string{"topic", "operation"})
+
+	ConsumerMessagesFailed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "consumer",
+		Name:      "messages_failed_total",
+		Help:      "Number of Kafka message deliveries whose handler returned an error, by topic and operation.",
+	}, []string{"topic", "operation"})
+
+	ConsumerMessagesRetried = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "consumer",
+		Name:      "messages_retried_total",
+		Help:      "Number of Kafka message deliveries that are a retry of a failed attempt, by topic and operation.",
+	}, []string{"topic", "operation"})
+
+	VoteStatusTransitions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "vote",
+		Name:      "status_transitions_total",
+		Help:      "Number of vote status transitions applied by the consumer. New votes transition from \"none\".",
+	}, []string{"from", "to"})
+
+	VoteBroadcastLatency = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "vote",
+		Name:      "broadcast_latency_seconds",
+		Help:      "End-to-end latency from a vote's voted_at timestamp to its live update broadcast.",
+		Buckets:   []float64{.1, .25, .5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600},
+	})
+
+	WebSocketConnectedClients = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "websocket",
+		Name:      "connected_clients",
+		Help:      "Number of WebSocket clients currently registered with the live result hub.",
+	})
+
+	WebSocketSubscriptions = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "websocket",
+		Name:      "subscriptions",
+		Help:      "Number of active WebSocket subscriptions, by subscription type.",
+	}, []string{"type"})
+
+	WebSocketDroppedMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "websocket",
+		Name:      "dropped_messages_total",
+		Help:      "Number of live messages dropped or replaced before delivery, by message type and reason.",
+	}, []string{"message_type", "reason"})
+
+	WebSocketDisconnects = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "websocket",
+		Name:      "disconnects_total",
+		Help:      "Number of WebSocket clients removed from the live result hub, by reason.",
+	}, []string{"reason"})
+
+	CacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "requests_total",
+		Help:      "Number of aggregate cache lookups, by query and result (hit or miss).",
+	}, []string{"query", "result"})
+
+	CacheInvalidations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "invalidations_total",
+		Help:      "Number of cache tag invalidations, by tag kind.",
+	}, []string{"tag"})
+
+	AnomaliesDetected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "anomaly",
+		Name:      "detected_total",
+		Help:      "Number of anomalies flagged in the vote stream, by kind.",
+	}, []string{"kind"})
+
+	AnomalyVotesDropped = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "anomaly",
+		Name:      "votes_dropped_total",
+		Help:      "Number of vote events the anomaly detector skipped because its queue was full.",
+	})
+
+	WebhookDeliveries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "webhook",
+		Name:      "deliveries_total",
+		Help:      "Number of webhook delivery attempts, by outcome: succeeded, retrying, failed or dropped.",
+	}, []string{"status"})
+
+	ResultUpdatesPublished = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "result_feed",
+		Name:      "updates_total",
+		Help:      "Number of ResultUpdated events, by outcome: published or failed.",
+	}, []string{"status"})
+
+	EventsQuarantined = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "certification",
+		Name:      "events_quarantined_total",
+		Help:      "Number of vote events held back because they would change a certified tally, by topic.",
+	}, []string{"topic"})
+
+	MerkleCommitments = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "merkle",
+		Name:      "commitments_total",
+		Help:      "Number of Merkle roots built over confirmed votes, by outcome: published or failed.",
+	}, []string{"status"})
+
+	VotesReconciled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "reconciliation",
+		Name:      "votes_total",
+		Help:      "Number of confirmed votes checked against the chain, by chain status, or error when the node or the database could not be read.",
+	}, []string{"status"})
+
+	ChainVotes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "chain_ingestion",
+		Name:      "votes_total",
+		Help:      "Number of votes read from VoteCast events, by action: ingested, or rolled_back when their block was replaced by a reorg.",
+	}, []string{"action"})
+
+	ChainReorgs = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "chain_ingestion",
+		Name:      "reorgs_total",
+		Help:      "Number of chain reorganizations detected below the last ingested block.",
+	})
+
+	ChainIngestedBlock = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "chain_ingestion",
+		Name:      "block",
+		Help:      "Number of the last block whose events were ingested.",
+	})
+
+	VotesFinalized = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "finality",
+		Name:      "votes_total",
+		Help:      "Number of finalizing votes checked against the chain, by outcome: final, pending when their block is not deep enough yet, held when their transaction is not verified, frozen when their tally is certified, or error when the node or the database could not be read.",
+	}, []string{"outcome"})
+)
+
+// ObserveQuery records the latency of a repository method. It is meant to be
+// deferred right after the method starts: defer metrics.ObserveQuery(repo, method, time.Now()).
+func ObserveQuery(repository, method string, start time.Time) {
+	DBQueryDuration.WithLabelValues(repository, method).Observe(time.Since(start).Seconds())
+}
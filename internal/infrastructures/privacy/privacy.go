@@ -0,0 +1,173 @@
+package privacy
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/infrastructures/auth"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"github.com/nocturna-ta/result/pkg/constants"
+	"strings"
+)
+
+const (
+	PseudonymizeAtResponse  = "response"
+	PseudonymizeAtIngestion = "ingestion"
+
+	// AudiencePublic is the audience of callers without an observer or admin role.
+	AudiencePublic = "public"
+
+	FieldVoterID         = "voter_id"
+	FieldTransactionHash = "transaction_hash"
+	FieldErrorMessage    = "error_message"
+	FieldRegion          = "region"
+
+	pseudonymPrefix = "vp_"
+	pseudonymBytes  = 16
+)
+
+var defaultRedaction = map[string][]string{
+	AudiencePublic: {FieldVoterID, FieldErrorMessage},
+}
+
+// Policy pseudonymizes voter IDs with a keyed HMAC and hides vote fields
+// depending on the audience of a response.
+type Policy struct {
+	key            []byte
+	pseudonymizeAt string
+	redact         map[string]map[string]bool
+}
+
+func New(cfg config.PrivacyConfig) (*Policy, error) {
+	switch cfg.PseudonymizeAt {
+	case "", PseudonymizeAtResponse, PseudonymizeAtIngestion:
+	default:
+		return nil, fmt.Errorf("unknown Privacy.PseudonymizeAt %q", cfg.PseudonymizeAt)
+	}
+
+	if cfg.PseudonymizeAt != "" && cfg.PseudonymKey == "" {
+		return nil, errors.New("Privacy.PseudonymKey is required to pseudonymize voter IDs")
+	}
+
+	redaction := cfg.Redact
+	if redaction == nil {
+		redaction = defaultRedaction
+	}
+
+	policy := &Policy{
+		key:            []byte(cfg.PseudonymKey),
+		pseudonymizeAt: cfg.PseudonymizeAt,
+		redact:         make(map[string]map[string]bool, len(redaction)),
+	}
+	for audience, fields := range redaction {
+		switch audience {
+		case AudiencePublic, constants.RoleObserver, constants.RoleAdmin:
+		default:
+			return nil, fmt.Errorf("unknown Privacy.Redact audience %q", audience)
+		}
+
+		policy.redact[audience] = make(map[string]bool, len(fields))
+		for _, field := range fields {
+			switch field {
+			case FieldVoterID, FieldTransactionHash, FieldErrorMessage, FieldRegion:
+			default:
+				return nil, fmt.Errorf("unknown Privacy.Redact field %q", field)
+			}
+			policy.redact[audience][field] = true
+		}
+	}
+
+	return policy, nil
+}
+
+// Enabled reports whether voter IDs are pseudonymized at all.
+func (p *Policy) Enabled() bool {
+	return p.pseudonymizeAt != ""
+}
+
+// AtIngestion reports whether only pseudonyms are stored.
+func (p *Policy) AtIngestion() bool {
+	return p.pseudonymizeAt == PseudonymizeAtIngestion
+}
+
+// Pseudonym returns the stable pseudonym of a voter ID. Empty IDs and IDs that
+// already are pseudonyms are returned as is.
+func (p *Policy) Pseudonym(voterID string) string {
+	if voterID == "" || IsPseudonym(voterID) {
+		return voterID
+	}
+
+	mac := hmac.New(sha256.New, p.key)
+	mac.Write([]byte(voterID))
+	return pseudonymPrefix + hex.EncodeToString(mac.Sum(nil)[:pseudonymBytes])
+}
+
+// IsPseudonym reports whether the value has the shape of a pseudonym.
+func IsPseudonym(value string) bool {
+	if !strings.HasPrefix(value, pseudonymPrefix) || len(value) != len(pseudonymPrefix)+2*pseudonymBytes {
+		return false
+	}
+	_, err := hex.DecodeString(value[len(pseudonymPrefix):])
+	return err == nil
+}
+
+// Audience returns the audience of the caller: admin, observer or public.
+func Audience(ctx context.Context) string {
+	switch {
+	case auth.Authorize(ctx, constants.RoleAdmin) == nil:
+		return constants.RoleAdmin
+	case auth.Authorize(ctx, constants.RoleObserver) == nil:
+		return constants.RoleObserver
+	default:
+		return AudiencePublic
+	}
+}
+
+// Apply redacts freshly built votes in place for the audience of the caller.
+func (p *Policy) Apply(ctx context.Context, votes ...*response.VoteResultResponse) {
+	audience := Audience(ctx)
+	for _, vote := range votes {
+		p.Redact(audience, vote)
+	}
+}
+
+// Redact pseudonymizes the voter ID when responses carry pseudonyms and clears
+// the fields hidden from the audience, in place.
+func (p *Policy) Redact(audience string, vote *response.VoteResultResponse) {
+	if vote == nil {
+		return
+	}
+
+	if p.pseudonymizeAt == PseudonymizeAtResponse {
+		vote.VoterID = p.Pseudonym(vote.VoterID)
+	}
+
+	hidden := p.redact[audience]
+	if hidden[FieldVoterID] {
+		vote.VoterID = ""
+	}
+	if hidden[FieldTransactionHash] {
+		vote.TransactionHash = ""
+	}
+	if hidden[FieldErrorMessage] {
+		vote.ErrorMessage = ""
+	}
+	if hidden[FieldRegion] {
+		vote.Region = ""
+	}
+}
+
+// View returns a redacted copy of a vote shared between audiences.
+func (p *Policy) View(audience string, vote *response.VoteResultResponse) *response.VoteResultResponse {
+	if vote == nil {
+		return nil
+	}
+
+	view := *vote
+	p.Redact(audience, &view)
+	return &view
+}
@@ -0,0 +1,48 @@
+package registry
+
+import (
+	"fmt"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"gopkg.in/yaml.v3"
+	"os"
+)
+
+// File is the layout of an election pair registry file.
+type File struct {
+	ElectionPairs []*model.ElectionPair `yaml:"election_pairs"`
+}
+
+// LoadElectionPairs reads the election pairs of a registry file.
+func LoadElectionPairs(path string) ([]*model.ElectionPair, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read election pairs file: %w", err)
+	}
+
+	var file File
+	if err = yaml.Unmarshal(raw, &file); err != nil {
+		return nil, fmt.Errorf("parse election pairs file %s: %w", path, err)
+	}
+
+	return file.ElectionPairs, nil
+}
+
+// RegisteredVotersFile is the layout of a registered voters reference file.
+type RegisteredVotersFile struct {
+	RegisteredVoters []*model.RegisteredVoters `yaml:"registered_voters"`
+}
+
+// LoadRegisteredVoters reads the registered voter counts of a reference file.
+func LoadRegisteredVoters(path string) ([]*model.RegisteredVoters, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read registered voters file: %w", err)
+	}
+
+	var file RegisteredVotersFile
+	if err = yaml.Unmarshal(raw, &file); err != nil {
+		return nil, fmt.Errorf("parse registered voters file %s: %w", path, err)
+	}
+
+	return file.RegisteredVoters, nil
+}
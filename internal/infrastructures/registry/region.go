@@ -0,0 +1,142 @@
+package registry
+
+import (
+	"fmt"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"gopkg.in/yaml.v3"
+	"os"
+	"strings"
+)
+
+// RegionFile is the layout of a region hierarchy reference file.
+type RegionFile struct {
+	Regions []*model.RegionNode `yaml:"regions"`
+}
+
+// RegionHierarchy is the province, regency, district and polling station tree
+// votes are rolled up along. A nil hierarchy has no regions.
+type RegionHierarchy struct {
+	roots  []*model.RegionNode
+	nodes  map[string]*model.RegionNode
+	leaves map[string]*model.RegionNode
+}
+
+// LoadRegionHierarchy reads and checks the region hierarchy of a reference file.
+func LoadRegionHierarchy(path string) (*RegionHierarchy, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read regions file: %w", err)
+	}
+
+	var file RegionFile
+	if err = yaml.Unmarshal(raw, &file); err != nil {
+		return nil, fmt.Errorf("parse regions file %s: %w", path, err)
+	}
+
+	return NewRegionHierarchy(file.Regions)
+}
+
+// NewRegionHierarchy indexes the tree under roots. Codes must be unique, every
+// node must sit at the level of its depth and every leaf must be a polling
+// station, so each vote maps to exactly one leaf and each parent counts the
+// votes of its children only.
+func NewRegionHierarchy(roots []*model.RegionNode) (*RegionHierarchy, error) {
+	h := &RegionHierarchy{
+		roots:  roots,
+		nodes:  make(map[string]*model.RegionNode),
+		leaves: make(map[string]*model.RegionNode),
+	}
+
+	for _, root := range roots {
+		if err := h.index(root, "", 0); err != nil {
+			return nil, err
+		}
+	}
+
+	return h, nil
+}
+
+func (h *RegionHierarchy) index(node *model.RegionNode, parentCode string, depth int) error {
+	node.Code = strings.TrimSpace(node.Code)
+	if node.Code == "" {
+		return fmt.Errorf("region under %q has no code", parentCode)
+	}
+	if _, ok := h.nodes[node.Code]; ok {
+		return fmt.Errorf("region %s is listed twice", node.Code)
+	}
+
+	level := model.RegionLevels[depth]
+	if node.Level == "" {
+		node.Level = level
+	}
+	if node.Level != level {
+		return fmt.Errorf("region %s is a %s but sits at the %s level", node.Code, node.Level, level)
+	}
+	if node.Leaf() && level != model.RegionLevelPollingStation {
+		return fmt.Errorf("%s %s has no %s", level, node.Code, model.RegionLevels[depth+1])
+	}
+	if !node.Leaf() && level == model.RegionLevelPollingStation {
+		return fmt.Errorf("polling station %s has children", node.Code)
+	}
+
+	node.ParentCode = parentCode
+	h.nodes[node.Code] = node
+
+	if node.Leaf() {
+		for _, key := range append([]string{node.Code}, node.Aliases...) {
+			key = normalize(key)
+			if other, ok := h.leaves[key]; ok {
+				return fmt.Errorf("polling stations %s and %s both match region %q", other.Code, node.Code, key)
+			}
+			h.leaves[key] = node
+		}
+		return nil
+	}
+
+	for _, child := range node.Children {
+		if err := h.index(child, node.Code, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Roots returns the provinces.
+func (h *RegionHierarchy) Roots() []*model.RegionNode {
+	if h == nil {
+		return nil
+	}
+	return h.roots
+}
+
+// Node returns the region with the code.
+func (h *RegionHierarchy) Node(code string) (*model.RegionNode, bool) {
+	if h == nil {
+		return nil, false
+	}
+	node, ok := h.nodes[strings.TrimSpace(code)]
+	return node, ok
+}
+
+// Leaf returns the polling station whose code or alias matches the region a
+// vote carries, ignoring case and surrounding space.
+func (h *RegionHierarchy) Leaf(region string) (*model.RegionNode, bool) {
+	if h == nil {
+		return nil, false
+	}
+	node, ok := h.leaves[normalize(region)]
+	return node, ok
+}
+
+// Path returns the regions from the province down to the node with the code.
+func (h *RegionHierarchy) Path(code string) []*model.RegionNode {
+	var path []*model.RegionNode
+	for node, ok := h.Node(code); ok; node, ok = h.Node(node.ParentCode) {
+		path = append([]*model.RegionNode{node}, path...)
+	}
+	return path
+}
+
+func normalize(region string) string {
+	return strings.ToLower(strings.TrimSpace(region))
+}
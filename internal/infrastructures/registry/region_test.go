@@ -0,0 +1,154 @@
+package registry
+
+import (
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"testing"
+)
+
+// district returns a province, regency and district holding the stations.
+func district(stations ...*model.RegionNode) []*model.RegionNode {
+	return []*model.RegionNode{{
+		Code: "31",
+		Children: []*model.RegionNode{{
+			Code: "31.71",
+			Children: []*model.RegionNode{{
+				Code:     "31.71.01",
+				Children: stations,
+			}},
+		}},
+	}}
+}
+
+func TestNewRegionHierarchyErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		roots []*model.RegionNode
+		want  string
+	}{
+		{
+			name:  "no code",
+			roots: district(&model.RegionNode{Code: " "}),
+			want:  `region under "31.71.01" has no code`,
+		},
+		{
+			name:  "duplicate code",
+			roots: district(&model.RegionNode{Code: "31.71.01.001"}, &model.RegionNode{Code: " 31.71.01.001 "}),
+			want:  "region 31.71.01.001 is listed twice",
+		},
+		{
+			name: "wrong level",
+			roots: []*model.RegionNode{{
+				Code:     "31",
+				Level:    model.RegionLevelRegency,
+				Children: district(&model.RegionNode{Code: "31.71.01.001"})[0].Children,
+			}},
+			want: "region 31 is a regency but sits at the province level",
+		},
+		{
+			name:  "leaf that is not a polling station",
+			roots: district(),
+			want:  "district 31.71.01 has no polling_station",
+		},
+		{
+			name: "polling station with children",
+			roots: district(&model.RegionNode{
+				Code:     "31.71.01.001",
+				Children: []*model.RegionNode{{Code: "31.71.01.001.1"}},
+			}),
+			want: "polling station 31.71.01.001 has children",
+		},
+		{
+			name: "alias collision",
+			roots: district(
+				&model.RegionNode{Code: "31.71.01.001", Aliases: []string{"TPS 001 Gambir"}},
+				&model.RegionNode{Code: "31.71.01.002", Aliases: []string{" tps 001 gambir"}},
+			),
+			want: `polling stations 31.71.01.001 and 31.71.01.002 both match region "tps 001 gambir"`,
+		},
+		{
+			name: "alias matching another code",
+			roots: district(
+				&model.RegionNode{Code: "31.71.01.001"},
+				&model.RegionNode{Code: "31.71.01.002", Aliases: []string{"31.71.01.001"}},
+			),
+			want: `polling stations 31.71.01.001 and 31.71.01.002 both match region "31.71.01.001"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRegionHierarchy(tt.roots)
+			if err == nil || err.Error() != tt.want {
+				t.Errorf("NewRegionHierarchy() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegionHierarchyLeaf(t *testing.T) {
+	h, err := NewRegionHierarchy(district(
+		&model.RegionNode{Code: "31.71.01.001", Aliases: []string{"TPS 001 Gambir"}},
+		&model.RegionNode{Code: "31.71.01.002", Aliases: []string{"TPS 002 Gambir", "Gambir II"}},
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		region string
+		want   string
+	}{
+		{region: "31.71.01.001", want: "31.71.01.001"},
+		{region: " 31.71.01.002 ", want: "31.71.01.002"},
+		{region: "TPS 001 Gambir", want: "31.71.01.001"},
+		{region: "tps 001 gambir", want: "31.71.01.001"},
+		{region: "  TPS 002 GAMBIR\t", want: "31.71.01.002"},
+		{region: "gambir ii", want: "31.71.01.002"},
+		{region: "31.71.01"},
+		{region: "TPS 003 Gambir"},
+		{region: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.region, func(t *testing.T) {
+			leaf, ok := h.Leaf(tt.region)
+			switch {
+			case tt.want == "" && ok:
+				t.Errorf("Leaf(%q) = %s, want no polling station", tt.region, leaf.Code)
+			case tt.want != "" && (!ok || leaf.Code != tt.want):
+				t.Errorf("Leaf(%q) = %v, %t, want %s", tt.region, leaf, ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegionHierarchyPath(t *testing.T) {
+	h, err := NewRegionHierarchy(district(&model.RegionNode{Code: "31.71.01.001"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := h.Path("31.71.01.001")
+	want := []struct{ code, level, parentCode string }{
+		{"31", model.RegionLevelProvince, ""},
+		{"31.71", model.RegionLevelRegency, "31"},
+		{"31.71.01", model.RegionLevelDistrict, "31.71"},
+		{"31.71.01.001", model.RegionLevelPollingStation, "31.71.01"},
+	}
+	if len(path) != len(want) {
+		t.Fatalf("Path() has %d regions, want %d", len(path), len(want))
+	}
+	for i, node := range path {
+		if node.Code != want[i].code || node.Level != want[i].level || node.ParentCode != want[i].parentCode {
+			t.Errorf("Path()[%d] = %s %s under %q, want %s %s under %q",
+				i, node.Level, node.Code, node.ParentCode, want[i].level, want[i].code, want[i].parentCode)
+		}
+	}
+
+	if path = h.Path("32"); len(path) != 0 {
+		t.Errorf("Path() of an unknown region = %v, want none", path)
+	}
+
+	var none *RegionHierarchy
+	if _, ok := none.Leaf("31.71.01.001"); ok || len(none.Roots()) != 0 || len(none.Path("31")) != 0 {
+		t.Error("a nil hierarchy has regions")
+	}
+}
@@ -0,0 +1,67 @@
+package throttle
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/result/internal/infrastructures/auth"
+	"github.com/nocturna-ta/result/internal/infrastructures/custresp"
+	"github.com/nocturna-ta/result/pkg/ratelimit"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	HeaderRateLimitLimit     = "RateLimit-Limit"
+	HeaderRateLimitRemaining = "RateLimit-Remaining"
+	HeaderRateLimitReset     = "RateLimit-Reset"
+)
+
+// Middleware rejects requests over the client's limit with 429 and reports the
+// bucket state in RateLimit-* headers. Clients are keyed by authenticated principal,
+// falling back to the client IP, so it must run after the auth middleware.
+// WebSocket upgrades are left to the live results admission control.
+func (t *Throttle) Middleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if strings.EqualFold(c.Get(fiber.HeaderUpgrade), "websocket") {
+			return c.Next()
+		}
+
+		clientKey := "ip:" + c.IP()
+		var principalName string
+		if principal := auth.PrincipalFromContext(c.UserContext()); principal != nil {
+			clientKey = principal.Method + ":" + principal.Name
+			principalName = principal.Name
+		}
+
+		decision := t.Take(c.Method(), c.Path(), clientKey, principalName, time.Now())
+		setHeaders(c, decision)
+
+		if !decision.Allowed {
+			c.Set(fiber.HeaderRetryAfter, strconv.Itoa(ceilSeconds(decision.RetryAfter)))
+			resp, _ := custresp.CustomErrorResponse(&custerr.ErrChain{
+				Message: "rate limit exceeded",
+				Code:    http.StatusTooManyRequests,
+				Type:    custresp.ErrTooManyRequest,
+			})
+			return resp.Send(c)
+		}
+
+		return c.Next()
+	}
+}
+
+func setHeaders(c *fiber.Ctx, decision ratelimit.Decision) {
+	c.Set(HeaderRateLimitLimit, strconv.Itoa(decision.Limit))
+	c.Set(HeaderRateLimitRemaining, strconv.Itoa(decision.Remaining))
+	c.Set(HeaderRateLimitReset, strconv.Itoa(ceilSeconds(decision.Reset)))
+}
+
+func ceilSeconds(d time.Duration) int {
+	if d <= 0 {
+		return 0
+	}
+	return int(math.Ceil(d.Seconds()))
+}
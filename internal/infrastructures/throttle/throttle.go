@@ -0,0 +1,79 @@
+package throttle
+
+import (
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/pkg/ratelimit"
+	"strings"
+	"time"
+)
+
+type routeRule struct {
+	method  string
+	path    string
+	prefix  bool
+	limiter *ratelimit.Limiter
+}
+
+func (r *routeRule) matches(method, path string) bool {
+	if r.method != "" && !strings.EqualFold(r.method, method) {
+		return false
+	}
+	if r.prefix {
+		return strings.HasPrefix(path, r.path)
+	}
+	return path == r.path
+}
+
+// Throttle applies token bucket limits per client. The bucket is picked from the first
+// matching route rule, then the client quota, then the default limit.
+type Throttle struct {
+	prefix  string
+	routes  []*routeRule
+	clients map[string]*ratelimit.Limiter
+	global  *ratelimit.Limiter
+}
+
+func New(cfg config.RateLimitConfig, prefix string) *Throttle {
+	t := &Throttle{
+		prefix:  prefix,
+		clients: make(map[string]*ratelimit.Limiter, len(cfg.Clients)),
+		global:  ratelimit.NewLimiter(cfg.Rate, cfg.Burst, cfg.IdleTTL),
+	}
+
+	for _, r := range cfg.Routes {
+		rule := &routeRule{
+			method:  r.Method,
+			path:    r.Path,
+			limiter: ratelimit.NewLimiter(r.Rate, r.Burst, cfg.IdleTTL),
+		}
+		if strings.HasSuffix(rule.path, "*") {
+			rule.path = strings.TrimSuffix(rule.path, "*")
+			rule.prefix = true
+		}
+		t.routes = append(t.routes, rule)
+	}
+
+	for _, c := range cfg.Clients {
+		t.clients[c.Name] = ratelimit.NewLimiter(c.Rate, c.Burst, cfg.IdleTTL)
+	}
+
+	return t
+}
+
+// Take consumes a token for the client identified by clientKey. principalName is the
+// authenticated API key name or JWT subject and is empty for anonymous clients.
+func (t *Throttle) Take(method, path, clientKey, principalName string, now time.Time) ratelimit.Decision {
+	path = strings.TrimPrefix(path, t.prefix)
+
+	for _, rule := range t.routes {
+		if rule.matches(method, path) {
+			return rule.limiter.Take(clientKey, now)
+		}
+	}
+
+	if limiter, ok := t.clients[principalName]; ok && principalName != "" {
+		return limiter.Take(clientKey, now)
+	}
+
+	return t.global.Take(clientKey, now)
+}
@@ -0,0 +1,123 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	HeaderDeliveryID = "X-Webhook-Id"
+	HeaderEvent      = "X-Webhook-Event"
+	HeaderTimestamp  = "X-Webhook-Timestamp"
+	// HeaderSignature carries "sha256=" and the hex HMAC-SHA256 of the timestamp
+	// header, a dot and the raw body, keyed with the subscription secret.
+	HeaderSignature = "X-Webhook-Signature"
+
+	signaturePrefix = "sha256="
+	userAgent       = "nocturna-result-webhook/1"
+)
+
+// Event is the body POSTed to webhook endpoints. It mirrors the live message
+// the hub broadcast, with the ID of the delivery.
+type Event struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	Data      any       `json:"data,omitempty"`
+}
+
+// Request is one signed POST of an encoded event.
+type Request struct {
+	URL        string
+	Secret     string
+	DeliveryID string
+	EventType  string
+	Payload    []byte
+}
+
+// Sender POSTs signed events to webhook endpoints.
+type Sender struct {
+	client *http.Client
+}
+
+// NewSender returns a sender giving up on an endpoint after timeout. A nil
+// client uses a new http.Client.
+func NewSender(client *http.Client, timeout time.Duration) *Sender {
+	if client == nil {
+		client = &http.Client{}
+	}
+	if timeout > 0 {
+		client.Timeout = timeout
+	}
+	return &Sender{client: client}
+}
+
+// Send delivers the request and returns the response status code. Any status
+// outside 2xx is an error.
+func (s *Sender) Send(ctx context.Context, req *Request) (int, error) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Payload))
+	if err != nil {
+		return 0, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", userAgent)
+	httpReq.Header.Set(HeaderDeliveryID, req.DeliveryID)
+	httpReq.Header.Set(HeaderEvent, req.EventType)
+	httpReq.Header.Set(HeaderTimestamp, timestamp)
+	httpReq.Header.Set(HeaderSignature, Sign(req.Secret, timestamp, req.Payload))
+
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("endpoint responded %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// Sign returns the signature header value of a payload sent at timestamp.
+func Sign(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is the signature of the payload sent at timestamp.
+func Verify(secret, timestamp string, payload []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, payload)), []byte(signature))
+}
+
+// Backoff returns how long to wait before the given retry, counting from 1. It
+// doubles from initial up to max, with up to a fifth of jitter.
+func Backoff(retry int, initial, max time.Duration) time.Duration {
+	if initial <= 0 {
+		initial = time.Second
+	}
+
+	delay := initial
+	for i := 1; i < retry && delay < max; i++ {
+		delay *= 2
+	}
+	if max > 0 && delay > max {
+		delay = max
+	}
+
+	return delay + time.Duration(rand.Int63n(int64(delay)/5+1))
+}
@@ -0,0 +1,113 @@
+package websocket
+
+import (
+	"errors"
+	"github.com/nocturna-ta/result/config"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrTooManyConnections      = errors.New("too many live result connections")
+	ErrTooManyConnectionsPerIP = errors.New("too many live result connections from this address")
+	ErrOriginNotAllowed        = errors.New("origin not allowed")
+)
+
+// Admission enforces connection caps and the origin allowlist for the live results endpoint.
+type Admission struct {
+	maxConnections      int
+	maxConnectionsPerIP int
+	allowAllOrigins     bool
+	allowedOrigins      map[string]struct{}
+	messageRateLimit    float64
+	messageBurst        int
+	maxMessageSize      int64
+
+	total int
+	perIP map[string]int
+	mu    sync.Mutex
+}
+
+func NewAdmission(cfg config.AdmissionConfig, cors config.CorsConfig) *Admission {
+	origins := cfg.AllowedOrigins
+	if origins == "" {
+		origins = cors.AllowOrigins
+	}
+
+	admission := &Admission{
+		maxConnections:      cfg.MaxConnections,
+		maxConnectionsPerIP: cfg.MaxConnectionsPerIP,
+		allowedOrigins:      make(map[string]struct{}),
+		messageRateLimit:    cfg.MessageRateLimit,
+		messageBurst:        cfg.MessageBurst,
+		maxMessageSize:      cfg.MaxMessageSize,
+		perIP:               make(map[string]int),
+	}
+
+	for _, origin := range strings.Split(origins, ",") {
+		origin = strings.TrimSpace(origin)
+		switch origin {
+		case "":
+		case "*":
+			admission.allowAllOrigins = true
+		default:
+			admission.allowedOrigins[strings.ToLower(origin)] = struct{}{}
+		}
+	}
+
+	if len(admission.allowedOrigins) == 0 {
+		admission.allowAllOrigins = true
+	}
+
+	return admission
+}
+
+// Acquire reserves a connection slot for ip if a connection with the given
+// Origin header is admitted. Requests without an Origin header come from
+// non-browser clients and are not origin checked.
+func (a *Admission) Acquire(ip, origin string) error {
+	if origin != "" && !a.allowAllOrigins {
+		if _, ok := a.allowedOrigins[strings.ToLower(origin)]; !ok {
+			return ErrOriginNotAllowed
+		}
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.checkCapacity(ip); err != nil {
+		return err
+	}
+
+	a.total++
+	a.perIP[ip]++
+	return nil
+}
+
+// Release frees a slot reserved with Acquire.
+func (a *Admission) Release(ip string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.total > 0 {
+		a.total--
+	}
+
+	if a.perIP[ip] <= 1 {
+		delete(a.perIP, ip)
+		return
+	}
+	a.perIP[ip]--
+}
+
+func (a *Admission) checkCapacity(ip string) error {
+	if a.maxConnections > 0 && a.total >= a.maxConnections {
+		return ErrTooManyConnections
+	}
+
+	if a.maxConnectionsPerIP > 0 && a.perIP[ip] >= a.maxConnectionsPerIP {
+		return ErrTooManyConnectionsPerIP
+	}
+
+	return nil
+}
@@ -2,28 +2,56 @@ package websocket
 
 import (
 	"encoding/json"
+	"errors"
 	"github.com/gofiber/contrib/websocket"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	"github.com/nocturna-ta/golib/custerr"
 	"github.com/nocturna-ta/golib/log"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/result/internal/infrastructures/custresp"
+	"github.com/nocturna-ta/result/internal/infrastructures/privacy"
+	"github.com/nocturna-ta/result/pkg/ratelimit"
+	"net/http"
 	"time"
 )
 
+const (
+	localsClientIP = "ws_client_ip"
+	localsAudience = "ws_audience"
+)
+
 type Handler struct {
-	hub *Hub
+	hub       *Hub
+	admission *Admission
 }
 
-func NewHandler(hub *Hub) *Handler {
+func NewHandler(hub *Hub, admission *Admission) *Handler {
 	return &Handler{
-		hub: hub,
+		hub:       hub,
+		admission: admission,
 	}
 }
 
 func (h *Handler) HandleConnection(c *websocket.Conn) {
+	// The slot was reserved by WebSocketMiddleware before the upgrade.
+	ip, _ := c.Locals(localsClientIP).(string)
+	defer h.admission.Release(ip)
+
+	if h.admission.maxMessageSize > 0 {
+		c.SetReadLimit(h.admission.maxMessageSize)
+	}
+
 	clientID := uuid.New().String()
-	client := NewClient(clientID, c)
+	client := h.hub.NewClient(clientID, c)
+	if audience, ok := c.Locals(localsAudience).(string); ok {
+		client.SetAudience(audience)
+	}
+	if h.admission.messageRateLimit > 0 {
+		client.limiter = ratelimit.NewTokenBucket(h.admission.messageRateLimit, h.admission.messageBurst)
+	}
 
-	h.hub.register <- client
+	h.hub.Register(client)
 
 	go h.writePump(client)
 	h.readPump(client)
@@ -31,14 +59,14 @@ func (h *Handler) HandleConnection(c *websocket.Conn) {
 
 func (h *Handler) readPump(client *Client) {
 	defer func() {
-		h.hub.unregister <- client
+		h.hub.Unregister(client)
 		client.Conn.Close()
 	}()
 
-	client.Conn.SetReadDeadline(time.Now().Add(60 * time.Second))
+	client.Conn.SetReadDeadline(time.Now().Add(h.hub.readDeadline))
 	client.Conn.SetPongHandler(func(string) error {
 		client.UpdateLastSeen()
-		client.Conn.SetReadDeadline(time.Now().Add(60 * time.Second))
+		client.Conn.SetReadDeadline(time.Now().Add(h.hub.readDeadline))
 		return nil
 	})
 
@@ -56,6 +84,17 @@ func (h *Handler) readPump(client *Client) {
 
 		client.UpdateLastSeen()
 
+		if client.limiter != nil && !client.limiter.Allow() {
+			h.hub.sendToClient(client, &LiveMessage{
+				Type:      MessageTypeError,
+				Timestamp: time.Now(),
+				Data: map[string]interface{}{
+					"error": "rate limit exceeded, message dropped",
+				},
+			})
+			continue
+		}
+
 		if messageType == websocket.TextMessage {
 			h.handleTextMessage(client, message)
 		}
@@ -63,7 +102,7 @@ func (h *Handler) readPump(client *Client) {
 }
 
 func (h *Handler) writePump(client *Client) {
-	ticker := time.NewTicker(54 * time.Second)
+	ticker := time.NewTicker(h.hub.pingPeriod)
 	defer func() {
 		ticker.Stop()
 		client.Conn.Close()
@@ -72,7 +111,7 @@ func (h *Handler) writePump(client *Client) {
 	for {
 		select {
 		case message, ok := <-client.Send:
-			client.Conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+			client.Conn.SetWriteDeadline(time.Now().Add(h.hub.writeTimeout))
 			if !ok {
 				client.Conn.WriteMessage(websocket.CloseMessage, []byte{})
 				return
@@ -86,8 +125,41 @@ func (h *Handler) writePump(client *Client) {
 				return
 			}
 
+		case <-client.flush:
+			// Messages still on the send buffer are older than the conflated ones.
+			for drained := false; !drained; {
+				select {
+				case message, ok := <-client.Send:
+					client.Conn.SetWriteDeadline(time.Now().Add(h.hub.writeTimeout))
+					if !ok {
+						client.Conn.WriteMessage(websocket.CloseMessage, []byte{})
+						return
+					}
+					if err := client.Conn.WriteMessage(websocket.TextMessage, message); err != nil {
+						log.WithFields(log.Fields{
+							"client_id": client.ID,
+							"error":     err,
+						}).Error("[WebSocketHandler] Write message error")
+						return
+					}
+				default:
+					drained = true
+				}
+			}
+
+			for _, message := range client.TakePending() {
+				client.Conn.SetWriteDeadline(time.Now().Add(h.hub.writeTimeout))
+				if err := client.Conn.WriteMessage(websocket.TextMessage, message); err != nil {
+					log.WithFields(log.Fields{
+						"client_id": client.ID,
+						"error":     err,
+					}).Error("[WebSocketHandler] Write conflated message error")
+					return
+				}
+			}
+
 		case <-ticker.C:
-			client.Conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+			client.Conn.SetWriteDeadline(time.Now().Add(h.hub.writeTimeout))
 			if err := client.Conn.WriteMessage(websocket.PingMessage, nil); err != nil {
 				return
 			}
@@ -176,9 +248,43 @@ func (h *Handler) UpgradeHandler() fiber.Handler {
 
 func (h *Handler) WebSocketMiddleware() fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		if websocket.IsWebSocketUpgrade(c) {
-			return c.Next()
+		if !websocket.IsWebSocketUpgrade(c) {
+			return fiber.ErrUpgradeRequired
+		}
+
+		ip := c.IP()
+		if err := h.admission.Acquire(ip, c.Get(fiber.HeaderOrigin)); err != nil {
+			log.WithFields(log.Fields{
+				"ip":     ip,
+				"origin": c.Get(fiber.HeaderOrigin),
+				"error":  err,
+			}).Warn("[WebSocketHandler] Connection rejected")
+			return h.reject(c, err)
 		}
-		return fiber.ErrUpgradeRequired
+
+		c.Locals(localsClientIP, ip)
+		c.Locals(localsAudience, privacy.Audience(c.UserContext()))
+		err := c.Next()
+		// HandleConnection releases the slot once the connection is upgraded.
+		if c.Response().StatusCode() != fiber.StatusSwitchingProtocols {
+			h.admission.Release(ip)
+		}
+		return err
 	}
 }
+
+func (h *Handler) reject(c *fiber.Ctx, err error) error {
+	errChain := &custerr.ErrChain{
+		Message: err.Error(),
+		Code:    http.StatusTooManyRequests,
+		Type:    custresp.ErrTooManyRequest,
+	}
+
+	if errors.Is(err, ErrOriginNotAllowed) {
+		errChain.Code = http.StatusForbidden
+		errChain.Type = response2.ErrForbiddenResource
+	}
+
+	resp, _ := custresp.CustomErrorResponse(errChain)
+	return resp.Send(c)
+}
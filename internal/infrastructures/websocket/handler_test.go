@@ -0,0 +1,96 @@
+package websocket
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+
+	fasthttpws "github.com/fasthttp/websocket"
+	"github.com/gofiber/fiber/v2"
+	"github.com/nocturna-ta/result/config"
+)
+
+func TestAdmissionAcquire(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.AdmissionConfig
+		held    []string
+		ip      string
+		origin  string
+		wantErr error
+	}{
+		{name: "admitted", cfg: config.AdmissionConfig{MaxConnections: 2}, ip: "10.0.0.1"},
+		{name: "total cap", cfg: config.AdmissionConfig{MaxConnections: 1}, held: []string{"10.0.0.2"}, ip: "10.0.0.1", wantErr: ErrTooManyConnections},
+		{name: "per ip cap", cfg: config.AdmissionConfig{MaxConnectionsPerIP: 1}, held: []string{"10.0.0.1"}, ip: "10.0.0.1", wantErr: ErrTooManyConnectionsPerIP},
+		{name: "other ip under per ip cap", cfg: config.AdmissionConfig{MaxConnectionsPerIP: 1}, held: []string{"10.0.0.2"}, ip: "10.0.0.1"},
+		{name: "allowed origin", cfg: config.AdmissionConfig{AllowedOrigins: "https://a.example"}, ip: "10.0.0.1", origin: "https://A.example"},
+		{name: "origin not allowed", cfg: config.AdmissionConfig{AllowedOrigins: "https://a.example"}, ip: "10.0.0.1", origin: "https://b.example", wantErr: ErrOriginNotAllowed},
+		{name: "no origin header", cfg: config.AdmissionConfig{AllowedOrigins: "https://a.example"}, ip: "10.0.0.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			admission := NewAdmission(tt.cfg, config.CorsConfig{})
+			for _, ip := range tt.held {
+				if err := admission.Acquire(ip, ""); err != nil {
+					t.Fatalf("Acquire(%s) = %v", ip, err)
+				}
+			}
+
+			if err := admission.Acquire(tt.ip, tt.origin); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Acquire() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAdmissionRelease(t *testing.T) {
+	admission := NewAdmission(config.AdmissionConfig{MaxConnections: 1, MaxConnectionsPerIP: 1}, config.CorsConfig{})
+	if err := admission.Acquire("10.0.0.1", ""); err != nil {
+		t.Fatal(err)
+	}
+	admission.Release("10.0.0.1")
+
+	if err := admission.Acquire("10.0.0.1", ""); err != nil {
+		t.Fatalf("Acquire() after Release = %v", err)
+	}
+}
+
+// TestConnectionOverCapIsRejectedBeforeUpgrade checks that a connection over
+// the cap gets HTTP 429 instead of being upgraded and closed.
+func TestConnectionOverCapIsRejectedBeforeUpgrade(t *testing.T) {
+	hub := NewHub(context.Background(), config.LiveResultsConfig{}, nil)
+	go hub.Run()
+	defer hub.Stop()
+
+	handler := NewHandler(hub, NewAdmission(config.AdmissionConfig{MaxConnections: 1}, config.CorsConfig{}))
+	app := fiber.New()
+	app.Use("/ws", handler.WebSocketMiddleware())
+	app.Get("/ws", handler.UpgradeHandler())
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go app.Listener(listener)
+	defer app.Shutdown()
+
+	url := "ws://" + listener.Addr().String() + "/ws"
+	first, resp, err := fasthttpws.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatalf("first connection: %v", err)
+	}
+	defer first.Close()
+	resp.Body.Close()
+
+	_, resp, err = fasthttpws.DefaultDialer.Dial(url, nil)
+	if err == nil {
+		t.Fatal("second connection was upgraded")
+	}
+	if resp == nil || resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("second connection got %v, want HTTP 429", resp)
+	}
+	resp.Body.Close()
+}
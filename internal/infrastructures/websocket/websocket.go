@@ -4,9 +4,16 @@ import (
 	"context"
 	"encoding/json"
 	"github.com/gofiber/contrib/websocket"
+	"github.com/google/uuid"
 	"github.com/nocturna-ta/golib/log"
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
+	"github.com/nocturna-ta/result/internal/infrastructures/privacy"
 	"github.com/nocturna-ta/result/internal/usecases/response"
+	"github.com/nocturna-ta/result/pkg/constants"
+	"github.com/nocturna-ta/result/pkg/ratelimit"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -17,9 +24,12 @@ const (
 	MessageTypeElectionUpdate MessageType = "election_update"
 	MessageTypeRegionUpdate   MessageType = "region_update"
 	MessageTypeStatistics     MessageType = "statistics_update"
+	MessageTypePollsClosed    MessageType = "polls_closed"
+	MessageTypeAnomalyAlert   MessageType = "anomaly_alert"
 	MessageTypeHeartbeat      MessageType = "heartbeat"
 	MessageTypeSubscribe      MessageType = "subscribe"
 	MessageTypeUnsubscribe    MessageType = "unsubscribe"
+	MessageTypeError          MessageType = "error"
 )
 
 type SubscriptionType string
@@ -29,6 +39,30 @@ const (
 	SubscriptionElection   SubscriptionType = "election"
 	SubscriptionRegion     SubscriptionType = "region"
 	SubscriptionStatistics SubscriptionType = "statistics"
+	SubscriptionAnomalies  SubscriptionType = "anomalies"
+)
+
+// OverflowPolicy decides what happens to a message when a client's send buffer is full.
+type OverflowPolicy string
+
+const (
+	// OverflowDisconnect unregisters the slow client.
+	OverflowDisconnect OverflowPolicy = "disconnect"
+	// OverflowDropOldest discards the oldest queued message to make room for the new one.
+	OverflowDropOldest OverflowPolicy = "drop_oldest"
+	// OverflowConflate keeps only the latest message per type and filter until the client catches up.
+	OverflowConflate OverflowPolicy = "conflate"
+)
+
+const (
+	defaultBroadcastInterval    = 30 * time.Second
+	defaultHeartbeatInterval    = 30 * time.Second
+	defaultReadDeadline         = 60 * time.Second
+	defaultPingPeriod           = 54 * time.Second
+	defaultWriteTimeout         = 10 * time.Second
+	defaultStaleThreshold       = 2 * time.Minute
+	defaultBroadcastBufferSize  = 256
+	defaultClientSendBufferSize = 256
 )
 
 type LiveMessage struct {
@@ -36,6 +70,19 @@ type LiveMessage struct {
 	Timestamp time.Time      `json:"timestamp"`
 	Data      interface{}    `json:"data,omitempty"`
 	Filter    *MessageFilter `json:"filter,omitempty"`
+
+	// restricted messages carry results under embargo and only reach observers and admins
+	restricted bool
+	// adminOnly messages only reach admins
+	adminOnly bool
+}
+
+// PollsClosed announces that the embargo on the results of an election pair in a
+// region has lifted. An empty election pair or region stands for all of them.
+type PollsClosed struct {
+	ElectionPairID string    `json:"election_pair_id,omitempty"`
+	Region         string    `json:"region,omitempty"`
+	LiftedAt       time.Time `json:"lifted_at"`
 }
 
 type MessageFilter struct {
@@ -57,34 +104,94 @@ type Client struct {
 	Subscriptions map[SubscriptionType]*MessageFilter
 	LastSeen      time.Time
 	mu            sync.RWMutex
+
+	// pending holds conflated messages keyed by type and filter, flushed by the write pump
+	pending      map[string][]byte
+	pendingOrder []string
+	flush        chan struct{}
+	closed       bool
+	limiter      *ratelimit.TokenBucket
+	audience     string
+}
+
+// Listener receives broadcasts in-process, for consumers that are not websocket connections
+// such as gRPC streams. A listener whose buffer is full loses its oldest message.
+type Listener struct {
+	ID            string
+	Messages      chan *LiveMessage
+	subscriptions map[SubscriptionType]*MessageFilter
+	audience      string
+	closed        bool
+	mu            sync.Mutex
 }
 
 type Hub struct {
 	clients    map[string]*Client
+	listeners  map[string]*Listener
 	broadcast  chan *LiveMessage
 	register   chan *Client
 	unregister chan *Client
 	mu         sync.RWMutex
 	ctx        context.Context
 	cancel     context.CancelFunc
+
+	heartbeatInterval    time.Duration
+	readDeadline         time.Duration
+	pingPeriod           time.Duration
+	writeTimeout         time.Duration
+	staleThreshold       time.Duration
+	clientSendBufferSize int
+	defaultPolicy        OverflowPolicy
+	policies             map[MessageType]OverflowPolicy
+	privacy              *privacy.Policy
+
+	lastTick atomic.Int64
 }
 
-func NewHub(ctx context.Context) *Hub {
+func NewHub(ctx context.Context, cfg config.LiveResultsConfig, privacyPolicy *privacy.Policy) *Hub {
 	hubCtx, cancel := context.WithCancel(ctx)
+
+	broadcastBufferSize := cfg.BroadcastBufferSize
+	if broadcastBufferSize <= 0 {
+		broadcastBufferSize = defaultBroadcastBufferSize
+	}
+
+	policies := make(map[MessageType]OverflowPolicy, len(cfg.OverflowPolicies))
+	for messageType, policy := range cfg.OverflowPolicies {
+		policies[MessageType(messageType)] = parseOverflowPolicy(policy)
+	}
+
 	return &Hub{
-		clients:    make(map[string]*Client),
-		broadcast:  make(chan *LiveMessage, 256),
-		register:   make(chan *Client),
-		unregister: make(chan *Client),
-		ctx:        hubCtx,
-		cancel:     cancel,
+		clients:              make(map[string]*Client),
+		listeners:            make(map[string]*Listener),
+		broadcast:            make(chan *LiveMessage, broadcastBufferSize),
+		register:             make(chan *Client),
+		unregister:           make(chan *Client),
+		ctx:                  hubCtx,
+		cancel:               cancel,
+		heartbeatInterval:    durationOrDefault(cfg.HeartbeatInterval, defaultHeartbeatInterval),
+		readDeadline:         durationOrDefault(cfg.ReadDeadline, defaultReadDeadline),
+		pingPeriod:           durationOrDefault(cfg.PingPeriod, defaultPingPeriod),
+		writeTimeout:         durationOrDefault(cfg.WriteTimeout, defaultWriteTimeout),
+		staleThreshold:       durationOrDefault(cfg.StaleThreshold, defaultStaleThreshold),
+		clientSendBufferSize: cfg.ClientSendBufferSize,
+		defaultPolicy:        parseOverflowPolicy(cfg.DefaultOverflowPolicy),
+		policies:             policies,
+		privacy:              privacyPolicy,
 	}
 }
 
+// BroadcastInterval returns the configured interval for periodic statistics broadcasts.
+func BroadcastInterval(cfg config.LiveResultsConfig) time.Duration {
+	return durationOrDefault(cfg.BroadcastInterval, defaultBroadcastInterval)
+}
+
 func (h *Hub) Run() {
-	ticker := time.NewTicker(30 * time.Second)
+	ticker := time.NewTicker(h.heartbeatInterval)
 	defer ticker.Stop()
 
+	h.lastTick.Store(time.Now().UnixNano())
+
 	for {
 		select {
 		case <-h.ctx.Done():
@@ -93,6 +200,7 @@ func (h *Hub) Run() {
 			h.mu.Lock()
 			h.clients[client.ID] = client
 			h.mu.Unlock()
+			metrics.WebSocketConnectedClients.Inc()
 
 			log.WithFields(log.Fields{
 				"client_id":     client.ID,
@@ -110,52 +218,166 @@ func (h *Hub) Run() {
 			h.sendToClient(client, welcome)
 
 		case client := <-h.unregister:
-			h.mu.Lock()
-			if _, ok := h.clients[client.ID]; ok {
-				delete(h.clients, client.ID)
-				close(client.Send)
-			}
-			h.mu.Unlock()
-
-			log.WithFields(log.Fields{
-				"client_id":     client.ID,
-				"total_clients": len(h.clients),
-			}).Info("[Websocket Hub] Client unregistered")
+			h.removeClient(client, "closed")
 
 		case msg := <-h.broadcast:
+			// Each audience sees its own redaction of the message, encoded once.
+			payloads := make(map[string][]byte)
+
 			h.mu.RLock()
+			var slowClients []*Client
 			for _, client := range h.clients {
 				if h.shouldSendToClient(client, msg) {
-					select {
-					case client.Send <- h.messageToBytes(msg):
-					default:
-						h.mu.RUnlock()
-						h.unregister <- client
-						h.mu.RLock()
+					payload, ok := payloads[client.audience]
+					if !ok {
+						payload = h.messageToBytes(h.view(msg, client.audience))
+						payloads[client.audience] = payload
+					}
+
+					if !h.deliver(client, msg, payload) {
+						slowClients = append(slowClients, client)
 					}
 				}
 			}
+			for _, listener := range h.listeners {
+				if listener.accepts(msg) {
+					listener.deliver(h.view(msg, listener.audience))
+				}
+			}
 			h.mu.RUnlock()
+
+			for _, client := range slowClients {
+				h.removeClient(client, "slow_client")
+			}
 		case <-ticker.C:
+			h.lastTick.Store(time.Now().UnixNano())
 			h.sendHeartbeat()
 			h.cleanupStaleConnections()
 		}
 	}
 }
 
+// Unregister removes the client from the hub, giving up once the hub is stopped.
+func (h *Hub) Unregister(client *Client) {
+	select {
+	case h.unregister <- client:
+	case <-h.ctx.Done():
+	}
+}
+
+// Register adds the client to the hub, giving up once the hub is stopped.
+func (h *Hub) Register(client *Client) {
+	select {
+	case h.register <- client:
+	case <-h.ctx.Done():
+	}
+}
+
+func (h *Hub) removeClient(client *Client, reason string) {
+	h.mu.Lock()
+	_, ok := h.clients[client.ID]
+	if ok {
+		delete(h.clients, client.ID)
+		client.close()
+	}
+	total := len(h.clients)
+	h.mu.Unlock()
+
+	if !ok {
+		return
+	}
+
+	metrics.WebSocketConnectedClients.Dec()
+	metrics.WebSocketDisconnects.WithLabelValues(reason).Inc()
+
+	log.WithFields(log.Fields{
+		"client_id":     client.ID,
+		"reason":        reason,
+		"total_clients": total,
+	}).Info("[Websocket Hub] Client unregistered")
+}
+
+// deliver queues the payload on the client's send buffer, applying the overflow policy of the
+// message type when the buffer is full. It returns false when the client must be disconnected.
+func (h *Hub) deliver(client *Client, message *LiveMessage, payload []byte) bool {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+
+	if client.closed {
+		return true
+	}
+
+	policy := h.overflowPolicy(message.Type)
+	// Once messages are conflated, later ones wait with them, so none is written
+	// before an older message of the same key still queued.
+	if policy == OverflowConflate && len(client.pending) > 0 {
+		client.conflate(message, payload)
+		return true
+	}
+
+	select {
+	case client.Send <- payload:
+		return true
+	default:
+	}
+
+	switch policy {
+	case OverflowDropOldest:
+		select {
+		case <-client.Send:
+			metrics.WebSocketDroppedMessages.WithLabelValues(string(message.Type), "drop_oldest").Inc()
+		default:
+		}
+
+		select {
+		case client.Send <- payload:
+		default:
+			metrics.WebSocketDroppedMessages.WithLabelValues(string(message.Type), "buffer_full").Inc()
+			log.WithFields(log.Fields{
+				"client_id":    client.ID,
+				"message_type": message.Type,
+			}).Warn("[WebSocketHub] Send buffer still full, dropping message")
+		}
+		return true
+	case OverflowConflate:
+		client.conflate(message, payload)
+		return true
+	default:
+		return false
+	}
+}
+
+func (h *Hub) overflowPolicy(messageType MessageType) OverflowPolicy {
+	if policy, ok := h.policies[messageType]; ok {
+		return policy
+	}
+	return h.defaultPolicy
+}
+
 func (h *Hub) shouldSendToClient(client *Client, message *LiveMessage) bool {
 	client.mu.RLock()
 	defer client.mu.RUnlock()
 
-	if message.Type == MessageTypeHeartbeat {
+	if message.restricted && !privileged(client.audience) {
+		return false
+	}
+	if message.adminOnly && client.audience != constants.RoleAdmin {
+		return false
+	}
+
+	if message.Type == MessageTypeHeartbeat || message.Type == MessageTypePollsClosed {
 		return true
 	}
 
-	if len(client.Subscriptions) == 0 {
+	return matchesSubscriptions(client.Subscriptions, message)
+}
+
+func matchesSubscriptions(subscriptions map[SubscriptionType]*MessageFilter, message *LiveMessage) bool {
+	if len(subscriptions) == 0 {
 		return false
 	}
 
-	for subType, filter := range client.Subscriptions {
+	for subType, filter := range subscriptions {
 		switch subType {
 		case SubscriptionAll:
 			return true
@@ -183,16 +405,18 @@ func (h *Hub) shouldSendToClient(client *Client, message *LiveMessage) bool {
 			if message.Type == MessageTypeStatistics {
 				return true
 			}
+		case SubscriptionAnomalies:
+			if message.Type == MessageTypeAnomalyAlert {
+				return true
+			}
 		}
 	}
 	return false
 }
 
 func (h *Hub) sendToClient(client *Client, message *LiveMessage) {
-	select {
-	case client.Send <- h.messageToBytes(message):
-	default:
-		h.unregister <- client
+	if !h.deliver(client, message, h.messageToBytes(message)) {
+		h.removeClient(client, "slow_client")
 	}
 }
 
@@ -202,41 +426,49 @@ func (h *Hub) messageToBytes(message *LiveMessage) []byte {
 }
 
 func (h *Hub) sendHeartbeat() {
+	clients := h.snapshotClients()
+
 	heartbeat := &LiveMessage{
 		Type:      MessageTypeHeartbeat,
 		Timestamp: time.Now(),
 		Data: map[string]interface{}{
 			"status":  "alive",
-			"clients": len(h.clients),
+			"clients": len(clients),
 		},
 	}
 
-	h.mu.RLock()
-	for _, client := range h.clients {
+	for _, client := range clients {
 		h.sendToClient(client, heartbeat)
 	}
-	h.mu.RUnlock()
 }
 
 func (h *Hub) cleanupStaleConnections() {
 	now := time.Now()
-	staleThreshold := 2 * time.Minute
 
-	h.mu.RLock()
 	var staleClients []*Client
-	for _, client := range h.clients {
-		if now.Sub(client.LastSeen) > staleThreshold {
+	for _, client := range h.snapshotClients() {
+		if now.Sub(client.GetLastSeen()) > h.staleThreshold {
 			staleClients = append(staleClients, client)
 		}
 	}
-	h.mu.RUnlock()
 
 	for _, client := range staleClients {
-		h.unregister <- client
+		h.removeClient(client, "stale")
+	}
+}
+
+func (h *Hub) snapshotClients() []*Client {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	clients := make([]*Client, 0, len(h.clients))
+	for _, client := range h.clients {
+		clients = append(clients, client)
 	}
+	return clients
 }
 
-func (h *Hub) BroadcastVoteUpdate(voteResult *response.VoteResultResponse) {
+func (h *Hub) BroadcastVoteUpdate(voteResult *response.VoteResultResponse, restricted bool) {
 	message := &LiveMessage{
 		Type:      MessageTypeVoteUpdate,
 		Timestamp: time.Now(),
@@ -245,16 +477,18 @@ func (h *Hub) BroadcastVoteUpdate(voteResult *response.VoteResultResponse) {
 			ElectionPairID: voteResult.ElectionPairID,
 			Region:         voteResult.Region,
 		},
+		restricted: restricted,
 	}
 
 	select {
 	case h.broadcast <- message:
 	default:
+		metrics.WebSocketDroppedMessages.WithLabelValues(string(MessageTypeVoteUpdate), "broadcast_channel_full").Inc()
 		log.Warn("[WebSocketHub] Broadcast channel full, dropping vote update message")
 	}
 }
 
-func (h *Hub) BroadcastElectionUpdate(electionResult *response.ElectionVoteResultResponse) {
+func (h *Hub) BroadcastElectionUpdate(electionResult *response.ElectionVoteResultResponse, restricted bool) {
 	message := &LiveMessage{
 		Type:      MessageTypeElectionUpdate,
 		Timestamp: time.Now(),
@@ -263,16 +497,18 @@ func (h *Hub) BroadcastElectionUpdate(electionResult *response.ElectionVoteResul
 			ElectionPairID: electionResult.ElectionPairID,
 			Region:         electionResult.Region,
 		},
+		restricted: restricted,
 	}
 
 	select {
 	case h.broadcast <- message:
 	default:
+		metrics.WebSocketDroppedMessages.WithLabelValues(string(MessageTypeElectionUpdate), "broadcast_channel_full").Inc()
 		log.Warn("[WebSocketHub] Broadcast channel full, dropping election update message")
 	}
 }
 
-func (h *Hub) BroadcastRegionUpdate(regionResult *response.RegionVoteResultResponse) {
+func (h *Hub) BroadcastRegionUpdate(regionResult *response.RegionVoteResultResponse, restricted bool) {
 	message := &LiveMessage{
 		Type:      MessageTypeRegionUpdate,
 		Timestamp: time.Now(),
@@ -280,56 +516,304 @@ func (h *Hub) BroadcastRegionUpdate(regionResult *response.RegionVoteResultRespo
 		Filter: &MessageFilter{
 			Region: regionResult.Region,
 		},
+		restricted: restricted,
 	}
 
 	select {
 	case h.broadcast <- message:
 	default:
+		metrics.WebSocketDroppedMessages.WithLabelValues(string(MessageTypeRegionUpdate), "broadcast_channel_full").Inc()
 		log.Warn("[WebSocketHub] Broadcast channel full, dropping region update message")
 	}
 }
 
-func (h *Hub) BroadcastStatisticsUpdate(stats *response.VoteStatisticsResponse) {
+func (h *Hub) BroadcastStatisticsUpdate(stats *response.VoteStatisticsResponse, restricted bool) {
 	message := &LiveMessage{
-		Type:      MessageTypeStatistics,
-		Timestamp: time.Now(),
-		Data:      stats,
+		Type:       MessageTypeStatistics,
+		Timestamp:  time.Now(),
+		Data:       stats,
+		restricted: restricted,
 	}
 
 	select {
 	case h.broadcast <- message:
 	default:
+		metrics.WebSocketDroppedMessages.WithLabelValues(string(MessageTypeStatistics), "broadcast_channel_full").Inc()
 		log.Warn("[WebSocketHub] Broadcast channel full, dropping statistics update message")
 	}
 }
 
+// BroadcastPollsClosed tells every client and listener that an embargo has lifted.
+func (h *Hub) BroadcastPollsClosed(pollsClosed *PollsClosed) {
+	message := &LiveMessage{
+		Type:      MessageTypePollsClosed,
+		Timestamp: time.Now(),
+		Data:      pollsClosed,
+		Filter: &MessageFilter{
+			ElectionPairID: pollsClosed.ElectionPairID,
+			Region:         pollsClosed.Region,
+		},
+	}
+
+	select {
+	case h.broadcast <- message:
+	default:
+		metrics.WebSocketDroppedMessages.WithLabelValues(string(MessageTypePollsClosed), "broadcast_channel_full").Inc()
+		log.Warn("[WebSocketHub] Broadcast channel full, dropping polls closed message")
+	}
+}
+
+// BroadcastAnomalyAlert pushes an anomaly found in the vote stream to admins
+// subscribed to anomalies.
+func (h *Hub) BroadcastAnomalyAlert(anomaly *response.AnomalyResponse) {
+	message := &LiveMessage{
+		Type:      MessageTypeAnomalyAlert,
+		Timestamp: time.Now(),
+		Data:      anomaly,
+		Filter: &MessageFilter{
+			ElectionPairID: anomaly.ElectionPairID,
+			Region:         anomaly.Region,
+		},
+		adminOnly: true,
+	}
+
+	select {
+	case h.broadcast <- message:
+	default:
+		metrics.WebSocketDroppedMessages.WithLabelValues(string(MessageTypeAnomalyAlert), "broadcast_channel_full").Inc()
+		log.Warn("[WebSocketHub] Broadcast channel full, dropping anomaly alert")
+	}
+}
+
+// view returns the message as the audience sees it.
+func (h *Hub) view(message *LiveMessage, audience string) *LiveMessage {
+	vote, ok := message.Data.(*response.VoteResultResponse)
+	if !ok {
+		return message
+	}
+
+	view := *message
+	view.Data = h.privacy.View(audience, vote)
+	return &view
+}
+
 func (h *Hub) GetClientCount() int {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
 	return len(h.clients)
 }
 
+// LastTick returns when the run loop last went through its heartbeat tick, or the zero time
+// when the loop is not running.
+func (h *Hub) LastTick() time.Time {
+	if h.ctx.Err() != nil {
+		return time.Time{}
+	}
+
+	nanos := h.lastTick.Load()
+	if nanos == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nanos)
+}
+
+// HeartbeatInterval is the period of the run loop tick reported by LastTick.
+func (h *Hub) HeartbeatInterval() time.Duration {
+	return h.heartbeatInterval
+}
+
+// HasSubscribers reports whether any websocket client or in-process listener would receive a broadcast.
+func (h *Hub) HasSubscribers() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.clients) > 0 || len(h.listeners) > 0
+}
+
+// Listen registers an in-process listener for the given subscriptions. Messages
+// are redacted for the audience, and only observers and admins receive results
+// under embargo.
+func (h *Hub) Listen(subscriptions map[SubscriptionType]*MessageFilter, bufferSize int, audience string) *Listener {
+	if bufferSize <= 0 {
+		bufferSize = defaultClientSendBufferSize
+	}
+
+	listener := &Listener{
+		ID:            uuid.New().String(),
+		Messages:      make(chan *LiveMessage, bufferSize),
+		subscriptions: subscriptions,
+		audience:      audience,
+	}
+
+	h.mu.Lock()
+	h.listeners[listener.ID] = listener
+	h.mu.Unlock()
+
+	return listener
+}
+
+// Unlisten removes the listener and closes its message channel.
+func (h *Hub) Unlisten(listener *Listener) {
+	h.mu.Lock()
+	delete(h.listeners, listener.ID)
+	h.mu.Unlock()
+
+	listener.close()
+}
+
+// Done is closed once the hub is stopped.
+func (h *Hub) Done() <-chan struct{} {
+	return h.ctx.Done()
+}
+
 func (h *Hub) Stop() {
 	h.cancel()
 
 	h.mu.Lock()
 	for _, client := range h.clients {
-		close(client.Send)
+		client.close()
+	}
+	for _, listener := range h.listeners {
+		listener.close()
 	}
 	h.clients = make(map[string]*Client)
+	h.listeners = make(map[string]*Listener)
 	h.mu.Unlock()
+
+	metrics.WebSocketConnectedClients.Set(0)
+}
+
+func (l *Listener) accepts(message *LiveMessage) bool {
+	switch {
+	case message.restricted && !privileged(l.audience):
+		return false
+	case message.adminOnly && l.audience != constants.RoleAdmin:
+		return false
+	case message.Type == MessageTypeHeartbeat:
+		return false
+	case message.Type == MessageTypePollsClosed:
+		return true
+	default:
+		return matchesSubscriptions(l.subscriptions, message)
+	}
 }
 
-func NewClient(id string, conn *websocket.Conn) *Client {
+func (l *Listener) deliver(message *LiveMessage) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return
+	}
+
+	select {
+	case l.Messages <- message:
+		return
+	default:
+	}
+
+	select {
+	case <-l.Messages:
+		metrics.WebSocketDroppedMessages.WithLabelValues(string(message.Type), "listener_overflow").Inc()
+	default:
+	}
+
+	select {
+	case l.Messages <- message:
+	default:
+	}
+}
+
+func (l *Listener) close() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return
+	}
+	l.closed = true
+	close(l.Messages)
+}
+
+// NewClient creates a client bound to this hub's buffer configuration.
+func (h *Hub) NewClient(id string, conn *websocket.Conn) *Client {
+	return NewClient(id, conn, h.clientSendBufferSize)
+}
+
+// SetAudience sets the audience messages are redacted for. It must be called
+// before the client is registered.
+func (c *Client) SetAudience(audience string) {
+	c.audience = audience
+}
+
+func NewClient(id string, conn *websocket.Conn, sendBufferSize int) *Client {
+	if sendBufferSize <= 0 {
+		sendBufferSize = defaultClientSendBufferSize
+	}
+
 	return &Client{
 		ID:            id,
 		Conn:          conn,
-		Send:          make(chan []byte, 256),
+		Send:          make(chan []byte, sendBufferSize),
 		Subscriptions: make(map[SubscriptionType]*MessageFilter),
 		LastSeen:      time.Now(),
+		audience:      privacy.AudiencePublic,
+		pending:       make(map[string][]byte),
+		flush:         make(chan struct{}, 1),
 	}
 }
 
+func (c *Client) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return
+	}
+	c.closed = true
+	close(c.Send)
+
+	for subType := range c.Subscriptions {
+		metrics.WebSocketSubscriptions.WithLabelValues(string(subType)).Dec()
+	}
+}
+
+// conflate keeps the payload as the latest message of its key and signals the
+// write pump. The caller holds the client lock.
+func (c *Client) conflate(message *LiveMessage, payload []byte) {
+	key := conflationKey(message)
+	if _, ok := c.pending[key]; ok {
+		metrics.WebSocketDroppedMessages.WithLabelValues(string(message.Type), "conflated").Inc()
+	} else {
+		c.pendingOrder = append(c.pendingOrder, key)
+	}
+	c.pending[key] = payload
+
+	select {
+	case c.flush <- struct{}{}:
+	default:
+	}
+}
+
+// TakePending returns the conflated messages queued while the send buffer was full, oldest key first.
+func (c *Client) TakePending() [][]byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	messages := make([][]byte, 0, len(c.pendingOrder))
+	for _, key := range c.pendingOrder {
+		messages = append(messages, c.pending[key])
+	}
+	c.pending = make(map[string][]byte)
+	c.pendingOrder = nil
+	return messages
+}
+
+func (c *Client) GetLastSeen() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.LastSeen
+}
+
 func (c *Client) UpdateLastSeen() {
 	c.mu.Lock()
 	c.LastSeen = time.Now()
@@ -338,14 +822,22 @@ func (c *Client) UpdateLastSeen() {
 
 func (c *Client) AddSubscription(subType SubscriptionType, filter *MessageFilter) {
 	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.Subscriptions[subType]; !ok && !c.closed {
+		metrics.WebSocketSubscriptions.WithLabelValues(string(subType)).Inc()
+	}
 	c.Subscriptions[subType] = filter
-	c.mu.Unlock()
 }
 
 func (c *Client) RemoveSubscription(subType SubscriptionType) {
 	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.Subscriptions[subType]; ok && !c.closed {
+		metrics.WebSocketSubscriptions.WithLabelValues(string(subType)).Dec()
+	}
 	delete(c.Subscriptions, subType)
-	c.mu.Unlock()
 }
 
 func (c *Client) GetSubscriptions() map[SubscriptionType]*MessageFilter {
@@ -358,3 +850,36 @@ func (c *Client) GetSubscriptions() map[SubscriptionType]*MessageFilter {
 	}
 	return subs
 }
+
+func conflationKey(message *LiveMessage) string {
+	key := string(message.Type)
+	if message.Filter != nil {
+		key += "|" + message.Filter.ElectionPairID + "|" + message.Filter.Region
+	}
+	return key
+}
+
+func parseOverflowPolicy(policy string) OverflowPolicy {
+	switch OverflowPolicy(policy) {
+	case OverflowDisconnect, OverflowDropOldest, OverflowConflate:
+		return OverflowPolicy(policy)
+	case "":
+		return OverflowDisconnect
+	default:
+		log.WithFields(log.Fields{
+			"policy": policy,
+		}).Warn("[WebSocketHub] Unknown overflow policy, falling back to disconnect")
+		return OverflowDisconnect
+	}
+}
+
+func privileged(audience string) bool {
+	return audience == constants.RoleObserver || audience == constants.RoleAdmin
+}
+
+func durationOrDefault(value, fallback time.Duration) time.Duration {
+	if value <= 0 {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,74 @@
+package websocket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nocturna-ta/result/config"
+)
+
+func TestDeliverConflatedMessagesStayBehindQueuedOnes(t *testing.T) {
+	hub := NewHub(context.Background(), config.LiveResultsConfig{DefaultOverflowPolicy: string(OverflowConflate)}, nil)
+	defer hub.Stop()
+
+	client := NewClient("client", nil, 1)
+	message := &LiveMessage{Type: MessageTypeElectionUpdate, Filter: &MessageFilter{ElectionPairID: "pair"}}
+
+	for _, payload := range []string{"v1", "v2", "v3"} {
+		if !hub.deliver(client, message, []byte(payload)) {
+			t.Fatalf("deliver(%s) asked to disconnect the client", payload)
+		}
+	}
+
+	if got := string(<-client.Send); got != "v1" {
+		t.Fatalf("send buffer holds %q, want v1", got)
+	}
+
+	// The buffer has room again, but v4 must not overtake the conflated v3.
+	hub.deliver(client, message, []byte("v4"))
+	select {
+	case payload := <-client.Send:
+		t.Fatalf("v4 was queued on the send buffer as %q while v3 is pending", payload)
+	default:
+	}
+
+	pending := client.TakePending()
+	if len(pending) != 1 || string(pending[0]) != "v4" {
+		t.Fatalf("pending = %q, want [v4]", pending)
+	}
+
+	hub.deliver(client, message, []byte("v5"))
+	if got := string(<-client.Send); got != "v5" {
+		t.Fatalf("send buffer holds %q after the flush, want v5", got)
+	}
+}
+
+func TestDeliverDropOldest(t *testing.T) {
+	hub := NewHub(context.Background(), config.LiveResultsConfig{DefaultOverflowPolicy: string(OverflowDropOldest)}, nil)
+	defer hub.Stop()
+
+	client := NewClient("client", nil, 1)
+	message := &LiveMessage{Type: MessageTypeStatistics}
+
+	hub.deliver(client, message, []byte("old"))
+	hub.deliver(client, message, []byte("new"))
+
+	if got := string(<-client.Send); got != "new" {
+		t.Fatalf("send buffer holds %q, want new", got)
+	}
+}
+
+func TestDeliverDisconnectsSlowClient(t *testing.T) {
+	hub := NewHub(context.Background(), config.LiveResultsConfig{}, nil)
+	defer hub.Stop()
+
+	client := NewClient("client", nil, 1)
+	message := &LiveMessage{Type: MessageTypeStatistics}
+
+	if !hub.deliver(client, message, []byte("first")) {
+		t.Fatal("first message asked to disconnect the client")
+	}
+	if hub.deliver(client, message, []byte("second")) {
+		t.Fatal("full buffer under the disconnect policy kept the client")
+	}
+}
@@ -0,0 +1,121 @@
+package dao
+
+import (
+	"context"
+	"github.com/nocturna-ta/golib/database/sql"
+	"github.com/nocturna-ta/golib/log"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/golib/txmanager/utils"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
+	"time"
+)
+
+type AnomalyRepository struct {
+	db *sql.Store
+}
+
+type OptsAnomalyRepository struct {
+	DB *sql.Store
+}
+
+func NewAnomalyRepository(opts *OptsAnomalyRepository) repository.AnomalyRepository {
+	return &AnomalyRepository{
+		db: opts.DB,
+	}
+}
+
+const (
+	insertAnomalyQuery = `
+		INSERT INTO vote_anomalies (
+			id, kind, election_pair_id, region, vote_id, voter_id, transaction_hash, value, baseline, description, detected_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	selectAnomaliesQuery = `
+		SELECT id, kind, election_pair_id, region, vote_id, voter_id, transaction_hash, value, baseline, description, detected_at
+		FROM vote_anomalies WHERE 1 = 1`
+)
+
+func (a *AnomalyRepository) InsertAnomaly(ctx context.Context, anomaly *model.Anomaly) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "AnomalyRepository.InsertAnomaly")
+	defer span.End()
+	defer metrics.ObserveQuery("AnomalyRepository", "InsertAnomaly", time.Now())
+
+	var err error
+
+	args := []any{
+		anomaly.ID, anomaly.Kind, anomaly.ElectionPairID, anomaly.Region, anomaly.VoteID, anomaly.VoterID,
+		anomaly.TransactionHash, anomaly.Value, anomaly.Baseline, anomaly.Description, anomaly.DetectedAt,
+	}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, insertAnomalyQuery, args...)
+	} else {
+		_, err = a.db.GetMaster().ExecContext(ctx, insertAnomalyQuery, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"kind":  anomaly.Kind,
+		}).ErrorWithCtx(ctx, "[AnomalyRepository.InsertAnomaly] failed to insert anomaly")
+		return err
+	}
+
+	return nil
+}
+
+func (a *AnomalyRepository) GetAnomalies(ctx context.Context, filter model.AnomalyFilter, limit, offset int) ([]*model.Anomaly, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "AnomalyRepository.GetAnomalies")
+	defer span.End()
+	defer metrics.ObserveQuery("AnomalyRepository", "GetAnomalies", time.Now())
+
+	var (
+		results []*model.Anomaly
+		err     error
+		args    []any
+	)
+
+	query := selectAnomaliesQuery
+	if filter.Kind != "" {
+		query += ` AND kind = ?`
+		args = append(args, filter.Kind)
+	}
+	if filter.ElectionPairID != "" {
+		query += ` AND election_pair_id = ?`
+		args = append(args, filter.ElectionPairID)
+	}
+	if filter.Region != "" {
+		query += ` AND region = ?`
+		args = append(args, filter.Region)
+	}
+	if !filter.StartDate.IsZero() {
+		query += ` AND detected_at >= ?`
+		args = append(args, filter.StartDate)
+	}
+	if !filter.EndDate.IsZero() {
+		query += ` AND detected_at <= ?`
+		args = append(args, filter.EndDate)
+	}
+	query += ` ORDER BY detected_at DESC, id ASC LIMIT ? OFFSET ?`
+	args = append(args, limit, offset)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &results, query, args...)
+	} else {
+		err = a.db.GetMaster().SelectContext(ctx, &results, query, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":  err,
+			"filter": filter,
+		}).ErrorWithCtx(ctx, "[AnomalyRepository.GetAnomalies] failed to get anomalies")
+		return nil, err
+	}
+
+	return results, nil
+}
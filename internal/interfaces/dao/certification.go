@@ -0,0 +1,205 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	"github.com/nocturna-ta/golib/database/sql"
+	"github.com/nocturna-ta/golib/log"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/golib/txmanager/utils"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
+	"time"
+)
+
+type CertificationRepository struct {
+	db *sql.Store
+}
+
+type OptsCertificationRepository struct {
+	DB *sql.Store
+}
+
+func NewCertificationRepository(opts *OptsCertificationRepository) repository.CertificationRepository {
+	return &CertificationRepository{
+		db: opts.DB,
+	}
+}
+
+const (
+	certificationColumns = `id, scope, scope_id, snapshot, content_hash, certified_by, certifier_name, certified_at`
+
+	insertCertificationQuery = `
+		INSERT INTO certifications (` + certificationColumns + `) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+
+	selectCertificationsQuery = `SELECT ` + certificationColumns + ` FROM certifications`
+
+	quarantinedEventColumns = `id, certification_id, topic, message_key, vote_id, election_pair_id, region, reason,
+		payload, quarantined_at`
+
+	insertQuarantinedEventQuery = `
+		INSERT INTO quarantined_events (` + quarantinedEventColumns + `) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	selectQuarantinedEventsQuery = `SELECT ` + quarantinedEventColumns + ` FROM quarantined_events`
+)
+
+func (c *CertificationRepository) InsertCertification(ctx context.Context, certification *model.Certification) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "CertificationRepository.InsertCertification")
+	defer span.End()
+	defer metrics.ObserveQuery("CertificationRepository", "InsertCertification", time.Now())
+
+	args := []any{
+		certification.ID, certification.Scope, certification.ScopeID, certification.Snapshot,
+		certification.ContentHash, certification.CertifiedBy, certification.CertifierName, certification.CertifiedAt,
+	}
+
+	if err := c.exec(ctx, insertCertificationQuery, args...); err != nil {
+		log.WithFields(log.Fields{
+			"error":    err,
+			"scope":    certification.Scope,
+			"scope_id": certification.ScopeID,
+		}).ErrorWithCtx(ctx, "[CertificationRepository.InsertCertification] failed to insert certification")
+		return err
+	}
+
+	return nil
+}
+
+func (c *CertificationRepository) GetCertification(ctx context.Context, id string) (*model.Certification, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "CertificationRepository.GetCertification")
+	defer span.End()
+	defer metrics.ObserveQuery("CertificationRepository", "GetCertification", time.Now())
+
+	return c.get(ctx, "[CertificationRepository.GetCertification]", selectCertificationsQuery+` WHERE id = ? LIMIT 1`, id)
+}
+
+func (c *CertificationRepository) GetCertificationByScope(ctx context.Context, scope, scopeID string) (*model.Certification, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "CertificationRepository.GetCertificationByScope")
+	defer span.End()
+	defer metrics.ObserveQuery("CertificationRepository", "GetCertificationByScope", time.Now())
+
+	query := selectCertificationsQuery + ` WHERE scope = ? AND scope_id = ? ORDER BY certified_at ASC LIMIT 1`
+	return c.get(ctx, "[CertificationRepository.GetCertificationByScope]", query, scope, scopeID)
+}
+
+func (c *CertificationRepository) GetCertifications(ctx context.Context) ([]*model.Certification, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "CertificationRepository.GetCertifications")
+	defer span.End()
+	defer metrics.ObserveQuery("CertificationRepository", "GetCertifications", time.Now())
+
+	var (
+		results []*model.Certification
+		err     error
+	)
+
+	query := selectCertificationsQuery + ` ORDER BY certified_at DESC, id ASC`
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &results, query)
+	} else {
+		err = c.db.GetMaster().SelectContext(ctx, &results, query)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[CertificationRepository.GetCertifications] failed to get certifications")
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (c *CertificationRepository) InsertQuarantinedEvent(ctx context.Context, quarantined *model.QuarantinedEvent) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "CertificationRepository.InsertQuarantinedEvent")
+	defer span.End()
+	defer metrics.ObserveQuery("CertificationRepository", "InsertQuarantinedEvent", time.Now())
+
+	args := []any{
+		quarantined.ID, quarantined.CertificationID, quarantined.Topic, quarantined.MessageKey, quarantined.VoteID,
+		quarantined.ElectionPairID, quarantined.Region, quarantined.Reason, quarantined.Payload,
+		quarantined.QuarantinedAt,
+	}
+
+	if err := c.exec(ctx, insertQuarantinedEventQuery, args...); err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"vote_id": quarantined.VoteID,
+		}).ErrorWithCtx(ctx, "[CertificationRepository.InsertQuarantinedEvent] failed to insert quarantined event")
+		return err
+	}
+
+	return nil
+}
+
+func (c *CertificationRepository) GetQuarantinedEvents(ctx context.Context, limit, offset int) ([]*model.QuarantinedEvent, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "CertificationRepository.GetQuarantinedEvents")
+	defer span.End()
+	defer metrics.ObserveQuery("CertificationRepository", "GetQuarantinedEvents", time.Now())
+
+	var (
+		results []*model.QuarantinedEvent
+		err     error
+	)
+
+	query := selectQuarantinedEventsQuery + ` ORDER BY quarantined_at DESC, id ASC LIMIT ? OFFSET ?`
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &results, query, limit, offset)
+	} else {
+		err = c.db.GetMaster().SelectContext(ctx, &results, query, limit, offset)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[CertificationRepository.GetQuarantinedEvents] failed to get quarantined events")
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (c *CertificationRepository) get(ctx context.Context, caller, query string, args ...any) (*model.Certification, error) {
+	var (
+		result model.Certification
+		err    error
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &result, query, args...)
+	} else {
+		err = c.db.GetMaster().GetContext(ctx, &result, query, args...)
+	}
+
+	if errors.Is(err, sql2.ErrNoRows) {
+		return nil, ErrNoResult
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"args":  args,
+		}).ErrorWithCtx(ctx, caller+" failed to get certification")
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *CertificationRepository) exec(ctx context.Context, query string, args ...any) error {
+	var err error
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = c.db.GetMaster().ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
@@ -0,0 +1,158 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	"github.com/nocturna-ta/golib/database/sql"
+	"github.com/nocturna-ta/golib/log"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/golib/txmanager/utils"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
+	"time"
+)
+
+type ChainCheckpointRepository struct {
+	db *sql.Store
+}
+
+type OptsChainCheckpointRepository struct {
+	DB *sql.Store
+}
+
+func NewChainCheckpointRepository(opts *OptsChainCheckpointRepository) repository.ChainCheckpointRepository {
+	return &ChainCheckpointRepository{
+		db: opts.DB,
+	}
+}
+
+const (
+	chainCheckpointColumns = `contract, block_number, block_hash, created_at`
+
+	insertChainCheckpointQuery = `
+		INSERT INTO chain_checkpoints (` + chainCheckpointColumns + `) VALUES (?, ?, ?, ?)`
+
+	selectLatestChainCheckpointQuery = `
+		SELECT ` + chainCheckpointColumns + ` FROM chain_checkpoints
+		WHERE contract = ?
+		ORDER BY created_at DESC, block_number DESC
+		LIMIT 1`
+
+	selectChainCheckpointsQuery = `
+		SELECT ` + chainCheckpointColumns + ` FROM chain_checkpoints
+		WHERE contract = ? AND block_number >= ? AND block_number <= ?
+		ORDER BY block_number DESC, created_at DESC`
+
+	deleteChainCheckpointsQuery = `ALTER TABLE chain_checkpoints DELETE WHERE contract = ? AND block_number > ?`
+)
+
+func (c *ChainCheckpointRepository) InsertChainCheckpoint(ctx context.Context, checkpoint *model.ChainCheckpoint) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ChainCheckpointRepository.InsertChainCheckpoint")
+	defer span.End()
+	defer metrics.ObserveQuery("ChainCheckpointRepository", "InsertChainCheckpoint", time.Now())
+
+	err := c.exec(ctx, insertChainCheckpointQuery, checkpoint.Contract, checkpoint.BlockNumber, checkpoint.BlockHash, checkpoint.CreatedAt)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":        err,
+			"contract":     checkpoint.Contract,
+			"block_number": checkpoint.BlockNumber,
+		}).ErrorWithCtx(ctx, "[ChainCheckpointRepository.InsertChainCheckpoint] failed to insert chain checkpoint")
+		return err
+	}
+
+	return nil
+}
+
+func (c *ChainCheckpointRepository) GetLatestChainCheckpoint(ctx context.Context, contract string) (*model.ChainCheckpoint, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ChainCheckpointRepository.GetLatestChainCheckpoint")
+	defer span.End()
+	defer metrics.ObserveQuery("ChainCheckpointRepository", "GetLatestChainCheckpoint", time.Now())
+
+	var (
+		result model.ChainCheckpoint
+		err    error
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &result, selectLatestChainCheckpointQuery, contract)
+	} else {
+		err = c.db.GetMaster().GetContext(ctx, &result, selectLatestChainCheckpointQuery, contract)
+	}
+
+	if errors.Is(err, sql2.ErrNoRows) {
+		return nil, ErrNoResult
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":    err,
+			"contract": contract,
+		}).ErrorWithCtx(ctx, "[ChainCheckpointRepository.GetLatestChainCheckpoint] failed to get latest chain checkpoint")
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *ChainCheckpointRepository) GetChainCheckpoints(ctx context.Context, contract string, fromBlock, toBlock uint64) ([]*model.ChainCheckpoint, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ChainCheckpointRepository.GetChainCheckpoints")
+	defer span.End()
+	defer metrics.ObserveQuery("ChainCheckpointRepository", "GetChainCheckpoints", time.Now())
+
+	var (
+		results []*model.ChainCheckpoint
+		err     error
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &results, selectChainCheckpointsQuery, contract, fromBlock, toBlock)
+	} else {
+		err = c.db.GetMaster().SelectContext(ctx, &results, selectChainCheckpointsQuery, contract, fromBlock, toBlock)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":      err,
+			"contract":   contract,
+			"from_block": fromBlock,
+			"to_block":   toBlock,
+		}).ErrorWithCtx(ctx, "[ChainCheckpointRepository.GetChainCheckpoints] failed to get chain checkpoints")
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (c *ChainCheckpointRepository) DeleteChainCheckpoints(ctx context.Context, contract string, block uint64) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ChainCheckpointRepository.DeleteChainCheckpoints")
+	defer span.End()
+	defer metrics.ObserveQuery("ChainCheckpointRepository", "DeleteChainCheckpoints", time.Now())
+
+	if err := c.exec(ctx, deleteChainCheckpointsQuery, contract, block); err != nil {
+		log.WithFields(log.Fields{
+			"error":    err,
+			"contract": contract,
+			"block":    block,
+		}).ErrorWithCtx(ctx, "[ChainCheckpointRepository.DeleteChainCheckpoints] failed to delete chain checkpoints")
+		return err
+	}
+
+	return nil
+}
+
+func (c *ChainCheckpointRepository) exec(ctx context.Context, query string, args ...any) error {
+	var err error
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = c.db.GetMaster().ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
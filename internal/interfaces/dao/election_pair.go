@@ -0,0 +1,183 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	"github.com/nocturna-ta/golib/database/sql"
+	"github.com/nocturna-ta/golib/log"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/golib/txmanager/utils"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
+	"time"
+)
+
+type ElectionPairRepository struct {
+	db *sql.Store
+}
+
+type OptsElectionPairRepository struct {
+	DB *sql.Store
+}
+
+func NewElectionPairRepository(opts *OptsElectionPairRepository) repository.ElectionPairRepository {
+	return &ElectionPairRepository{
+		db: opts.DB,
+	}
+}
+
+const (
+	electionPairColumns = `id, election_id, pair_number, candidate_name, running_mate_name, party, created_at, updated_at`
+
+	insertElectionPairQuery = `
+		INSERT INTO election_pairs (` + electionPairColumns + `) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+
+	updateElectionPairQuery = `
+		ALTER TABLE election_pairs UPDATE election_id = ?, pair_number = ?, candidate_name = ?, running_mate_name = ?,
+		party = ?, updated_at = ? WHERE id = ?`
+
+	deleteElectionPairQuery = `ALTER TABLE election_pairs DELETE WHERE id = ?`
+
+	selectElectionPairsQuery = `SELECT ` + electionPairColumns + ` FROM election_pairs`
+)
+
+func (e *ElectionPairRepository) InsertElectionPair(ctx context.Context, pair *model.ElectionPair) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ElectionPairRepository.InsertElectionPair")
+	defer span.End()
+	defer metrics.ObserveQuery("ElectionPairRepository", "InsertElectionPair", time.Now())
+
+	args := []any{
+		pair.ID, pair.ElectionID, pair.PairNumber, pair.CandidateName, pair.RunningMateName, pair.Party,
+		pair.CreatedAt, pair.UpdatedAt,
+	}
+
+	if err := e.exec(ctx, insertElectionPairQuery, args...); err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    pair.ID,
+		}).ErrorWithCtx(ctx, "[ElectionPairRepository.InsertElectionPair] failed to insert election pair")
+		return err
+	}
+
+	return nil
+}
+
+func (e *ElectionPairRepository) UpdateElectionPair(ctx context.Context, pair *model.ElectionPair) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ElectionPairRepository.UpdateElectionPair")
+	defer span.End()
+	defer metrics.ObserveQuery("ElectionPairRepository", "UpdateElectionPair", time.Now())
+
+	args := []any{
+		pair.ElectionID, pair.PairNumber, pair.CandidateName, pair.RunningMateName, pair.Party, pair.UpdatedAt, pair.ID,
+	}
+
+	if err := e.exec(ctx, updateElectionPairQuery, args...); err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    pair.ID,
+		}).ErrorWithCtx(ctx, "[ElectionPairRepository.UpdateElectionPair] failed to update election pair")
+		return err
+	}
+
+	return nil
+}
+
+func (e *ElectionPairRepository) DeleteElectionPair(ctx context.Context, id string) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ElectionPairRepository.DeleteElectionPair")
+	defer span.End()
+	defer metrics.ObserveQuery("ElectionPairRepository", "DeleteElectionPair", time.Now())
+
+	if err := e.exec(ctx, deleteElectionPairQuery, id); err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[ElectionPairRepository.DeleteElectionPair] failed to delete election pair")
+		return err
+	}
+
+	return nil
+}
+
+func (e *ElectionPairRepository) GetElectionPair(ctx context.Context, id string) (*model.ElectionPair, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ElectionPairRepository.GetElectionPair")
+	defer span.End()
+	defer metrics.ObserveQuery("ElectionPairRepository", "GetElectionPair", time.Now())
+
+	var (
+		result model.ElectionPair
+		err    error
+	)
+
+	query := selectElectionPairsQuery + ` WHERE id = ? LIMIT 1`
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &result, query, id)
+	} else {
+		err = e.db.GetMaster().GetContext(ctx, &result, query, id)
+	}
+
+	if errors.Is(err, sql2.ErrNoRows) {
+		return nil, ErrNoResult
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[ElectionPairRepository.GetElectionPair] failed to get election pair")
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (e *ElectionPairRepository) GetElectionPairs(ctx context.Context, electionID string) ([]*model.ElectionPair, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ElectionPairRepository.GetElectionPairs")
+	defer span.End()
+	defer metrics.ObserveQuery("ElectionPairRepository", "GetElectionPairs", time.Now())
+
+	var (
+		results []*model.ElectionPair
+		err     error
+		args    []any
+	)
+
+	query := selectElectionPairsQuery + ` WHERE TRUE`
+	if electionID != "" {
+		query += ` AND election_id = ?`
+		args = append(args, electionID)
+	}
+	query += ` ORDER BY election_id ASC, pair_number ASC, id ASC`
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &results, query, args...)
+	} else {
+		err = e.db.GetMaster().SelectContext(ctx, &results, query, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"election_id": electionID,
+		}).ErrorWithCtx(ctx, "[ElectionPairRepository.GetElectionPairs] failed to get election pairs")
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (e *ElectionPairRepository) exec(ctx context.Context, query string, args ...any) error {
+	var err error
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = e.db.GetMaster().ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
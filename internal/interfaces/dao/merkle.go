@@ -0,0 +1,260 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	"fmt"
+	"github.com/nocturna-ta/golib/database/sql"
+	"github.com/nocturna-ta/golib/log"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/golib/txmanager/utils"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
+	"strings"
+	"time"
+)
+
+type MerkleRepository struct {
+	db *sql.Store
+}
+
+type OptsMerkleRepository struct {
+	DB *sql.Store
+}
+
+func NewMerkleRepository(opts *OptsMerkleRepository) repository.MerkleRepository {
+	return &MerkleRepository{
+		db: opts.DB,
+	}
+}
+
+// merkleLeavesBatchSize caps the rows of one insert statement.
+const merkleLeavesBatchSize = 1000
+
+const (
+	merkleCommitmentColumns = `id, election_id, region, root, leaf_count, published_at`
+
+	insertMerkleCommitmentQuery = `
+		INSERT INTO merkle_commitments (` + merkleCommitmentColumns + `) VALUES (?, ?, ?, ?, ?, ?)`
+
+	selectMerkleCommitmentQuery = `SELECT ` + merkleCommitmentColumns + ` FROM merkle_commitments WHERE id = ? LIMIT 1`
+
+	selectLatestMerkleCommitmentsQuery = `
+		SELECT argMax(id, published_at) AS id, election_id, region, argMax(root, published_at) AS root,
+			argMax(leaf_count, published_at) AS leaf_count, max(published_at) AS published_at
+		FROM merkle_commitments
+		WHERE TRUE %s
+		GROUP BY election_id, region
+		ORDER BY election_id ASC, region ASC`
+
+	merkleLeafColumns = `commitment_id, leaf_index, vote_id, election_pair_id, region, transaction_hash, leaf_hash, published_at`
+
+	insertMerkleLeavesQuery = `INSERT INTO merkle_leaves (` + merkleLeafColumns + `) VALUES `
+
+	selectMerkleLeafQuery = `
+		SELECT ` + merkleLeafColumns + ` FROM merkle_leaves
+		WHERE vote_id = ?
+		ORDER BY published_at DESC
+		LIMIT 1`
+
+	selectMerkleLeafHashesQuery = `SELECT leaf_hash FROM merkle_leaves WHERE commitment_id = ? ORDER BY leaf_index ASC`
+
+	deleteMerkleLeavesQuery = `ALTER TABLE merkle_leaves DELETE WHERE commitment_id = ?`
+)
+
+func (m *MerkleRepository) InsertMerkleCommitment(ctx context.Context, commitment *model.MerkleCommitment, leaves []*model.MerkleLeaf) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "MerkleRepository.InsertMerkleCommitment")
+	defer span.End()
+	defer metrics.ObserveQuery("MerkleRepository", "InsertMerkleCommitment", time.Now())
+
+	// Leaves go first so a published commitment always has its leaves.
+	for start := 0; start < len(leaves); start += merkleLeavesBatchSize {
+		batch := leaves[start:min(start+merkleLeavesBatchSize, len(leaves))]
+
+		values := make([]string, 0, len(batch))
+		args := make([]any, 0, 8*len(batch))
+		for _, leaf := range batch {
+			values = append(values, "(?, ?, ?, ?, ?, ?, ?, ?)")
+			args = append(args, leaf.CommitmentID, leaf.LeafIndex, leaf.VoteID, leaf.ElectionPairID, leaf.Region,
+				leaf.TransactionHash, leaf.LeafHash, leaf.PublishedAt)
+		}
+
+		if err := m.exec(ctx, insertMerkleLeavesQuery+strings.Join(values, ", "), args...); err != nil {
+			log.WithFields(log.Fields{
+				"error":         err,
+				"commitment_id": commitment.ID,
+				"rows":          len(batch),
+			}).ErrorWithCtx(ctx, "[MerkleRepository.InsertMerkleCommitment] failed to insert merkle leaves")
+			return err
+		}
+	}
+
+	err := m.exec(ctx, insertMerkleCommitmentQuery, commitment.ID, commitment.ElectionID, commitment.Region,
+		commitment.Root, commitment.LeafCount, commitment.PublishedAt)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":         err,
+			"commitment_id": commitment.ID,
+		}).ErrorWithCtx(ctx, "[MerkleRepository.InsertMerkleCommitment] failed to insert merkle commitment")
+		return err
+	}
+
+	return nil
+}
+
+func (m *MerkleRepository) GetMerkleCommitment(ctx context.Context, id string) (*model.MerkleCommitment, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "MerkleRepository.GetMerkleCommitment")
+	defer span.End()
+	defer metrics.ObserveQuery("MerkleRepository", "GetMerkleCommitment", time.Now())
+
+	var (
+		result model.MerkleCommitment
+		err    error
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &result, selectMerkleCommitmentQuery, id)
+	} else {
+		err = m.db.GetMaster().GetContext(ctx, &result, selectMerkleCommitmentQuery, id)
+	}
+
+	if errors.Is(err, sql2.ErrNoRows) {
+		return nil, ErrNoResult
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[MerkleRepository.GetMerkleCommitment] failed to get merkle commitment")
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (m *MerkleRepository) GetLatestMerkleCommitments(ctx context.Context, electionID string) ([]*model.MerkleCommitment, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "MerkleRepository.GetLatestMerkleCommitments")
+	defer span.End()
+	defer metrics.ObserveQuery("MerkleRepository", "GetLatestMerkleCommitments", time.Now())
+
+	var (
+		results []*model.MerkleCommitment
+		err     error
+		args    []any
+		where   string
+	)
+
+	if electionID != "" {
+		where = `AND election_id = ?`
+		args = append(args, electionID)
+	}
+	query := fmt.Sprintf(selectLatestMerkleCommitmentsQuery, where)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &results, query, args...)
+	} else {
+		err = m.db.GetMaster().SelectContext(ctx, &results, query, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"election_id": electionID,
+		}).ErrorWithCtx(ctx, "[MerkleRepository.GetLatestMerkleCommitments] failed to get merkle commitments")
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (m *MerkleRepository) GetMerkleLeaf(ctx context.Context, voteID string) (*model.MerkleLeaf, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "MerkleRepository.GetMerkleLeaf")
+	defer span.End()
+	defer metrics.ObserveQuery("MerkleRepository", "GetMerkleLeaf", time.Now())
+
+	var (
+		result model.MerkleLeaf
+		err    error
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &result, selectMerkleLeafQuery, voteID)
+	} else {
+		err = m.db.GetMaster().GetContext(ctx, &result, selectMerkleLeafQuery, voteID)
+	}
+
+	if errors.Is(err, sql2.ErrNoRows) {
+		return nil, ErrNoResult
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":   err,
+			"vote_id": voteID,
+		}).ErrorWithCtx(ctx, "[MerkleRepository.GetMerkleLeaf] failed to get merkle leaf")
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (m *MerkleRepository) GetMerkleLeafHashes(ctx context.Context, commitmentID string) ([]string, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "MerkleRepository.GetMerkleLeafHashes")
+	defer span.End()
+	defer metrics.ObserveQuery("MerkleRepository", "GetMerkleLeafHashes", time.Now())
+
+	var (
+		results []string
+		err     error
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &results, selectMerkleLeafHashesQuery, commitmentID)
+	} else {
+		err = m.db.GetMaster().SelectContext(ctx, &results, selectMerkleLeafHashesQuery, commitmentID)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":         err,
+			"commitment_id": commitmentID,
+		}).ErrorWithCtx(ctx, "[MerkleRepository.GetMerkleLeafHashes] failed to get merkle leaf hashes")
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (m *MerkleRepository) DeleteMerkleLeaves(ctx context.Context, commitmentID string) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "MerkleRepository.DeleteMerkleLeaves")
+	defer span.End()
+	defer metrics.ObserveQuery("MerkleRepository", "DeleteMerkleLeaves", time.Now())
+
+	if err := m.exec(ctx, deleteMerkleLeavesQuery, commitmentID); err != nil {
+		log.WithFields(log.Fields{
+			"error":         err,
+			"commitment_id": commitmentID,
+		}).ErrorWithCtx(ctx, "[MerkleRepository.DeleteMerkleLeaves] failed to delete merkle leaves")
+		return err
+	}
+
+	return nil
+}
+
+func (m *MerkleRepository) exec(ctx context.Context, query string, args ...any) error {
+	var err error
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = m.db.GetMaster().ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
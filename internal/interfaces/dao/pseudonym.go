@@ -0,0 +1,126 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	"github.com/nocturna-ta/golib/database/sql"
+	"github.com/nocturna-ta/golib/log"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/golib/txmanager/utils"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
+	"time"
+)
+
+type PseudonymRepository struct {
+	db *sql.Store
+}
+
+type OptsPseudonymRepository struct {
+	DB *sql.Store
+}
+
+func NewPseudonymRepository(opts *OptsPseudonymRepository) repository.PseudonymRepository {
+	return &PseudonymRepository{
+		db: opts.DB,
+	}
+}
+
+const (
+	insertVoterPseudonymQuery = `
+		INSERT INTO voter_pseudonyms (pseudonym, voter_id, created_at) VALUES (?, ?, ?)`
+
+	selectVoterPseudonymQuery = `
+		SELECT pseudonym, voter_id, created_at FROM voter_pseudonyms WHERE pseudonym = ? ORDER BY created_at ASC LIMIT 1`
+
+	insertPseudonymLookupQuery = `
+		INSERT INTO voter_pseudonym_lookups (
+			id, pseudonym, user_id, user_name, reason, found, looked_up_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?)`
+)
+
+func (p *PseudonymRepository) InsertVoterPseudonym(ctx context.Context, pseudonym *model.VoterPseudonym) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PseudonymRepository.InsertVoterPseudonym")
+	defer span.End()
+	defer metrics.ObserveQuery("PseudonymRepository", "InsertVoterPseudonym", time.Now())
+
+	var err error
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, insertVoterPseudonymQuery, pseudonym.Pseudonym, pseudonym.VoterID, pseudonym.CreatedAt)
+	} else {
+		_, err = p.db.GetMaster().ExecContext(ctx, insertVoterPseudonymQuery, pseudonym.Pseudonym, pseudonym.VoterID, pseudonym.CreatedAt)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":     err,
+			"pseudonym": pseudonym.Pseudonym,
+		}).ErrorWithCtx(ctx, "[PseudonymRepository.InsertVoterPseudonym] failed to insert voter pseudonym")
+		return err
+	}
+
+	return nil
+}
+
+func (p *PseudonymRepository) GetVoterPseudonym(ctx context.Context, pseudonym string) (*model.VoterPseudonym, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PseudonymRepository.GetVoterPseudonym")
+	defer span.End()
+	defer metrics.ObserveQuery("PseudonymRepository", "GetVoterPseudonym", time.Now())
+
+	var (
+		result model.VoterPseudonym
+		err    error
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &result, selectVoterPseudonymQuery, pseudonym)
+	} else {
+		err = p.db.GetMaster().GetContext(ctx, &result, selectVoterPseudonymQuery, pseudonym)
+	}
+
+	if errors.Is(err, sql2.ErrNoRows) {
+		return nil, ErrNoResult
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":     err,
+			"pseudonym": pseudonym,
+		}).ErrorWithCtx(ctx, "[PseudonymRepository.GetVoterPseudonym] failed to get voter pseudonym")
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p *PseudonymRepository) InsertPseudonymLookup(ctx context.Context, lookup *model.PseudonymLookup) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PseudonymRepository.InsertPseudonymLookup")
+	defer span.End()
+	defer metrics.ObserveQuery("PseudonymRepository", "InsertPseudonymLookup", time.Now())
+
+	var err error
+
+	args := []any{lookup.ID, lookup.Pseudonym, lookup.UserID, lookup.UserName, lookup.Reason, lookup.Found, lookup.LookedUpAt}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, insertPseudonymLookupQuery, args...)
+	} else {
+		_, err = p.db.GetMaster().ExecContext(ctx, insertPseudonymLookupQuery, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":     err,
+			"pseudonym": lookup.Pseudonym,
+			"user_id":   lookup.UserID,
+		}).ErrorWithCtx(ctx, "[PseudonymRepository.InsertPseudonymLookup] failed to insert pseudonym lookup")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"github.com/nocturna-ta/golib/database/sql"
+	"github.com/nocturna-ta/golib/log"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/golib/txmanager/utils"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
+	"strings"
+	"time"
+)
+
+type RegisteredVotersRepository struct {
+	db *sql.Store
+}
+
+type OptsRegisteredVotersRepository struct {
+	DB *sql.Store
+}
+
+func NewRegisteredVotersRepository(opts *OptsRegisteredVotersRepository) repository.RegisteredVotersRepository {
+	return &RegisteredVotersRepository{
+		db: opts.DB,
+	}
+}
+
+// registeredVotersBatchSize caps the rows of one insert statement.
+const registeredVotersBatchSize = 1000
+
+const (
+	insertRegisteredVotersQuery = `INSERT INTO registered_voters (election_id, region, registered_voters, updated_at) VALUES `
+
+	// Counts are appended rather than updated, so the latest row of each
+	// election and region is the one in effect.
+	selectRegisteredVotersQuery = `
+		SELECT election_id, region, argMax(registered_voters, updated_at) AS registered_voters,
+		max(updated_at) AS updated_at FROM registered_voters WHERE TRUE %s
+		GROUP BY election_id, region ORDER BY election_id ASC, region ASC`
+
+	deleteRegisteredVotersQuery = `ALTER TABLE registered_voters DELETE WHERE election_id = ? AND region = ?`
+)
+
+func (r *RegisteredVotersRepository) InsertRegisteredVoters(ctx context.Context, counts []*model.RegisteredVoters) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "RegisteredVotersRepository.InsertRegisteredVoters")
+	defer span.End()
+	defer metrics.ObserveQuery("RegisteredVotersRepository", "InsertRegisteredVoters", time.Now())
+
+	for start := 0; start < len(counts); start += registeredVotersBatchSize {
+		batch := counts[start:min(start+registeredVotersBatchSize, len(counts))]
+
+		values := make([]string, 0, len(batch))
+		args := make([]any, 0, 4*len(batch))
+		for _, count := range batch {
+			values = append(values, "(?, ?, ?, ?)")
+			args = append(args, count.ElectionID, count.Region, count.RegisteredVoters, count.UpdatedAt)
+		}
+
+		if err := r.exec(ctx, insertRegisteredVotersQuery+strings.Join(values, ", "), args...); err != nil {
+			log.WithFields(log.Fields{
+				"error": err,
+				"rows":  len(batch),
+			}).ErrorWithCtx(ctx, "[RegisteredVotersRepository.InsertRegisteredVoters] failed to insert registered voters")
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *RegisteredVotersRepository) GetRegisteredVoters(ctx context.Context, electionID string) ([]*model.RegisteredVoters, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "RegisteredVotersRepository.GetRegisteredVoters")
+	defer span.End()
+	defer metrics.ObserveQuery("RegisteredVotersRepository", "GetRegisteredVoters", time.Now())
+
+	var (
+		results []*model.RegisteredVoters
+		err     error
+		args    []any
+		where   string
+	)
+
+	if electionID != "" {
+		where = `AND election_id IN (?, '')`
+		args = append(args, electionID)
+	}
+	query := fmt.Sprintf(selectRegisteredVotersQuery, where)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &results, query, args...)
+	} else {
+		err = r.db.GetMaster().SelectContext(ctx, &results, query, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"election_id": electionID,
+		}).ErrorWithCtx(ctx, "[RegisteredVotersRepository.GetRegisteredVoters] failed to get registered voters")
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *RegisteredVotersRepository) DeleteRegisteredVoters(ctx context.Context, electionID, region string) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "RegisteredVotersRepository.DeleteRegisteredVoters")
+	defer span.End()
+	defer metrics.ObserveQuery("RegisteredVotersRepository", "DeleteRegisteredVoters", time.Now())
+
+	if err := r.exec(ctx, deleteRegisteredVotersQuery, electionID, region); err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"election_id": electionID,
+			"region":      region,
+		}).ErrorWithCtx(ctx, "[RegisteredVotersRepository.DeleteRegisteredVoters] failed to delete registered voters")
+		return err
+	}
+
+	return nil
+}
+
+func (r *RegisteredVotersRepository) exec(ctx context.Context, query string, args ...any) error {
+	var err error
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = r.db.GetMaster().ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
@@ -2,13 +2,17 @@ package dao
 
 import (
 	"context"
+	sql2 "database/sql"
+	"errors"
 	"fmt"
+	"github.com/jmoiron/sqlx"
 	"github.com/nocturna-ta/golib/database/sql"
 	"github.com/nocturna-ta/golib/log"
 	"github.com/nocturna-ta/golib/tracing"
 	"github.com/nocturna-ta/golib/txmanager/utils"
 	"github.com/nocturna-ta/result/internal/domain/model"
 	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
 	"time"
 )
 
@@ -37,11 +41,21 @@ const (
 	selectVoteResultQuery = `SELECT %s FROM vote_results %s WHERE TRUE %s `
 
 	updateVoteResultQuery = `ALTER TABLE vote_results UPDATE %s WHERE TRUE %s `
+
+	voteChainColumns = `chain_status, block_number, block_hash, block_time, chain_checked_at`
 )
 
+var bucketFunctions = map[model.BucketSize]string{
+	model.BucketMinute:        "toStartOfMinute",
+	model.BucketFifteenMinute: "toStartOfFifteenMinutes",
+	model.BucketHour:          "toStartOfHour",
+	model.BucketDay:           "toStartOfDay",
+}
+
 func (v *VoteResultRepository) InsertVoteResult(ctx context.Context, result *model.VoteResult) error {
 	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.InsertVoteResult")
 	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "InsertVoteResult", time.Now())
 
 	sqlTrx := utils.GetSqlTx(ctx)
 	var (
@@ -72,6 +86,7 @@ func (v *VoteResultRepository) InsertVoteResult(ctx context.Context, result *mod
 func (v *VoteResultRepository) UpdateVoteResult(ctx context.Context, result *model.VoteResult) error {
 	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.UpdateVoteResult")
 	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "UpdateVoteResult", time.Now())
 
 	var (
 		args []any
@@ -106,6 +121,7 @@ func (v *VoteResultRepository) UpdateVoteResult(ctx context.Context, result *mod
 func (v *VoteResultRepository) GetVoteResultByID(ctx context.Context, id string) (*model.VoteResult, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.GetVoteResultByID")
 	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "GetVoteResultByID", time.Now())
 
 	var (
 		result model.VoteResult
@@ -115,7 +131,7 @@ func (v *VoteResultRepository) GetVoteResultByID(ctx context.Context, id string)
 
 	sqlTrx := utils.GetSqlTx(ctx)
 
-	selectQuery := `id, voter_id, election_pair_id, region, status, transaction_hash, error_message, voted_at, processed_at, created_at, updated_at`
+	selectQuery := `id, voter_id, election_pair_id, region, status, transaction_hash, error_message, voted_at, processed_at, created_at, updated_at, ` + voteChainColumns
 	whereQuery := `AND id = ?`
 	args = append(args, id)
 	query := fmt.Sprintf(selectVoteResultQuery, selectQuery, "", whereQuery)
@@ -126,6 +142,9 @@ func (v *VoteResultRepository) GetVoteResultByID(ctx context.Context, id string)
 		err = v.db.GetMaster().GetContext(ctx, &result, query, args...)
 	}
 
+	if errors.Is(err, sql2.ErrNoRows) {
+		return nil, ErrNoResult
+	}
 	if err != nil {
 		log.WithFields(log.Fields{
 			"error": err,
@@ -140,6 +159,7 @@ func (v *VoteResultRepository) GetVoteResultByID(ctx context.Context, id string)
 func (v *VoteResultRepository) GetVoteResultsByElectionPair(ctx context.Context, electionPairID string, limit, offset int) ([]*model.VoteResult, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.GetVoteResultsByElectionPair")
 	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "GetVoteResultsByElectionPair", time.Now())
 
 	var (
 		results []*model.VoteResult
@@ -175,6 +195,7 @@ func (v *VoteResultRepository) GetVoteResultsByElectionPair(ctx context.Context,
 func (v *VoteResultRepository) GetVoteResultsByRegion(ctx context.Context, region string, limit, offset int) ([]*model.VoteResult, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.GetVoteResultsByRegion")
 	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "GetVoteResultsByRegion", time.Now())
 
 	var (
 		results []*model.VoteResult
@@ -211,6 +232,7 @@ func (v *VoteResultRepository) GetVoteResultsByRegion(ctx context.Context, regio
 func (v *VoteResultRepository) GetVoteResultsByStatus(ctx context.Context, status string, limit, offset int) ([]*model.VoteResult, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.GetVoteResultsByStatus")
 	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "GetVoteResultsByStatus", time.Now())
 
 	var (
 		results []*model.VoteResult
@@ -246,6 +268,7 @@ func (v *VoteResultRepository) GetVoteResultsByStatus(ctx context.Context, statu
 func (v *VoteResultRepository) GetElectionResults(ctx context.Context, electionPairID string) (*model.ElectionResult, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.GetElectionResults")
 	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "GetElectionResults", time.Now())
 
 	var (
 		result model.ElectionResult
@@ -255,7 +278,7 @@ func (v *VoteResultRepository) GetElectionResults(ctx context.Context, electionP
 
 	sqlTrx := utils.GetSqlTx(ctx)
 
-	selectQuery := `election_pair_id, region, count(*) as total_votes, countIf(status = 'confirmed') as confirmed_votes, countIf(status = 'pending') as pending_votes, countIf(status = 'error') as error_votes, max(updated_at) as last_updated`
+	selectQuery := `election_pair_id, region, count(*) as total_votes, countIf(status = 'confirmed') as confirmed_votes, countIf(status = 'finalizing') as finalizing_votes, countIf(status = 'pending') as pending_votes, countIf(status = 'error') as error_votes, max(updated_at) as last_updated`
 	whereQuery := ` AND election_pair_id = ? GROUP BY election_pair_id, region`
 	args = append(args, electionPairID)
 	query := fmt.Sprintf(selectVoteResultQuery, selectQuery, "", whereQuery)
@@ -276,9 +299,50 @@ func (v *VoteResultRepository) GetElectionResults(ctx context.Context, electionP
 	return &result, nil
 }
 
+// GetElectionRegionResult returns the tally of an election pair in one region,
+// or ErrNoResult when it has no votes there.
+func (v *VoteResultRepository) GetElectionRegionResult(ctx context.Context, electionPairID, region string) (*model.ElectionResult, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.GetElectionRegionResult")
+	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "GetElectionRegionResult", time.Now())
+
+	var (
+		result model.ElectionResult
+		err    error
+		args   []any
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	selectQuery := `election_pair_id, region, count(*) as total_votes, countIf(status = 'confirmed') as confirmed_votes, countIf(status = 'finalizing') as finalizing_votes, countIf(status = 'pending') as pending_votes, countIf(status = 'error') as error_votes, max(updated_at) as last_updated`
+	whereQuery := ` AND election_pair_id = ? AND region = ? GROUP BY election_pair_id, region`
+	args = append(args, electionPairID, region)
+	query := fmt.Sprintf(selectVoteResultQuery, selectQuery, "", whereQuery)
+
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &result, query, args...)
+	} else {
+		err = v.db.GetMaster().GetContext(ctx, &result, query, args...)
+	}
+	if errors.Is(err, sql2.ErrNoRows) {
+		return nil, ErrNoResult
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":         err,
+			"election_pair": electionPairID,
+			"region":        region,
+		}).ErrorWithCtx(ctx, "[VoteResultRepository.GetElectionRegionResult] failed to get election results in region")
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 func (v *VoteResultRepository) GetRegionResults(ctx context.Context, region string) (*model.RegionResult, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.GetRegionResults")
 	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "GetRegionResults", time.Now())
 
 	var (
 		result model.RegionResult
@@ -288,7 +352,7 @@ func (v *VoteResultRepository) GetRegionResults(ctx context.Context, region stri
 
 	sqlTrx := utils.GetSqlTx(ctx)
 
-	selectQuery := `region, count(*) as total_votes, countIf(status = 'confirmed') as confirmed_votes,
+	selectQuery := `region, count(*) as total_votes, countIf(status = 'confirmed') as confirmed_votes, countIf(status = 'finalizing') as finalizing_votes,
 	countIf(status = 'pending') as pending_votes, countIf(status = 'error') as error_votes, max(updated_at) as last_updated`
 	whereQuery := `AND region = ? GROUP BY region`
 	args = append(args, region)
@@ -313,6 +377,7 @@ func (v *VoteResultRepository) GetRegionResults(ctx context.Context, region stri
 func (v *VoteResultRepository) GetOverallStatistics(ctx context.Context) (*model.VoteStatistics, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.GetOverallStatistics")
 	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "GetOverallStatistics", time.Now())
 
 	var (
 		result model.VoteStatistics
@@ -320,7 +385,7 @@ func (v *VoteResultRepository) GetOverallStatistics(ctx context.Context) (*model
 	)
 	sqlTrx := utils.GetSqlTx(ctx)
 
-	selectQuery := `count(*) as total_votes, countIf(status = 'confirmed') as confirmed_votes,
+	selectQuery := `count(*) as total_votes, countIf(status = 'confirmed') as confirmed_votes, countIf(status = 'finalizing') as finalizing_votes,
 	countIf(status = 'pending') as pending_votes, countIf(status = 'error') as error_votes, max(updated_at) as last_updated`
 	query := fmt.Sprintf(selectVoteResultQuery, selectQuery, "", "")
 
@@ -343,6 +408,7 @@ func (v *VoteResultRepository) GetOverallStatistics(ctx context.Context) (*model
 func (v *VoteResultRepository) GetVoteResultsByDateRange(ctx context.Context, startDate, endDate time.Time, limit, offset int) ([]*model.VoteResult, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.GetVoteResultsByDateRange")
 	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "GetVoteResultsByDateRange", time.Now())
 
 	var (
 		results []*model.VoteResult
@@ -380,6 +446,7 @@ func (v *VoteResultRepository) GetVoteResultsByDateRange(ctx context.Context, st
 func (v *VoteResultRepository) GetElectionResultsByRegion(ctx context.Context, region string) ([]*model.ElectionResult, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.GetElectionResultsByRegion")
 	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "GetElectionResultsByRegion", time.Now())
 
 	var (
 		results []*model.ElectionResult
@@ -389,7 +456,7 @@ func (v *VoteResultRepository) GetElectionResultsByRegion(ctx context.Context, r
 
 	sqlTrx := utils.GetSqlTx(ctx)
 
-	selectQuery := `election_pair_id, region, count(*) as total_votes, countIf(status = 'confirmed') as confirmed_votes,
+	selectQuery := `election_pair_id, region, count(*) as total_votes, countIf(status = 'confirmed') as confirmed_votes, countIf(status = 'finalizing') as finalizing_votes,
             countIf(status = 'pending') as pending_votes, countIf(status = 'error') as error_votes, max(updated_at) as last_updated`
 	whereQuery := `AND region = ? GROUP BY election_pair_id, region ORDER BY total_votes DESC`
 	args = append(args, region)
@@ -412,9 +479,52 @@ func (v *VoteResultRepository) GetElectionResultsByRegion(ctx context.Context, r
 	return results, nil
 }
 
+func (v *VoteResultRepository) GetElectionResultsByPairs(ctx context.Context, electionPairIDs []string, byRegion bool) ([]*model.ElectionResult, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.GetElectionResultsByPairs")
+	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "GetElectionResultsByPairs", time.Now())
+
+	var (
+		results []*model.ElectionResult
+		err     error
+	)
+
+	if len(electionPairIDs) == 0 {
+		return results, nil
+	}
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	groupBy := `election_pair_id`
+	if byRegion {
+		groupBy = `election_pair_id, region`
+	}
+	selectQuery := groupBy + `, count(*) as total_votes, countIf(status = 'confirmed') as confirmed_votes, countIf(status = 'finalizing') as finalizing_votes,
+            countIf(status = 'pending') as pending_votes, countIf(status = 'error') as error_votes, max(updated_at) as last_updated`
+	whereQuery := `AND has(?, election_pair_id) GROUP BY ` + groupBy + ` ORDER BY ` + groupBy
+	query := fmt.Sprintf(selectVoteResultQuery, selectQuery, "", whereQuery)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &results, query, electionPairIDs)
+	} else {
+		err = v.db.GetMaster().SelectContext(ctx, &results, query, electionPairIDs)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":          err,
+			"election_pairs": electionPairIDs,
+		}).ErrorWithCtx(ctx, "[VoteResultRepository.GetElectionResultsByPairs] failed to get election results by pairs")
+		return nil, err
+	}
+
+	return results, nil
+}
+
 func (v *VoteResultRepository) GetRegionStatistics(ctx context.Context) ([]*model.RegionResult, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.GetRegionStatistics")
 	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "GetRegionStatistics", time.Now())
 
 	var (
 		results []*model.RegionResult
@@ -423,7 +533,7 @@ func (v *VoteResultRepository) GetRegionStatistics(ctx context.Context) ([]*mode
 
 	sqlTrx := utils.GetSqlTx(ctx)
 
-	selectQuery := `region, count(*) as total_votes, countIf(status = 'confirmed') as confirmed_votes,
+	selectQuery := `region, count(*) as total_votes, countIf(status = 'confirmed') as confirmed_votes, countIf(status = 'finalizing') as finalizing_votes,
             countIf(status = 'pending') as pending_votes, countIf(status = 'error') as error_votes, max(updated_at) as last_updated`
 	whereQuery := `GROUP BY region ORDER BY total_votes DESC`
 	query := fmt.Sprintf(selectVoteResultQuery, selectQuery, "", whereQuery)
@@ -447,6 +557,7 @@ func (v *VoteResultRepository) GetRegionStatistics(ctx context.Context) ([]*mode
 func (v *VoteResultRepository) CountVotesByStatus(ctx context.Context, status string) (uint64, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.CountVotesByStatus")
 	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "CountVotesByStatus", time.Now())
 
 	var (
 		count uint64
@@ -481,6 +592,7 @@ func (v *VoteResultRepository) CountVotesByStatus(ctx context.Context, status st
 func (v *VoteResultRepository) CountVotesByElectionPair(ctx context.Context, electionPairID string) (uint64, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.CountVotesByElectionPair")
 	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "CountVotesByElectionPair", time.Now())
 
 	var (
 		count uint64
@@ -514,6 +626,7 @@ func (v *VoteResultRepository) CountVotesByElectionPair(ctx context.Context, ele
 func (v *VoteResultRepository) CountVotesByRegion(ctx context.Context, region string) (uint64, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.CountVotesByRegion")
 	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "CountVotesByRegion", time.Now())
 
 	var (
 		count uint64
@@ -544,9 +657,10 @@ func (v *VoteResultRepository) CountVotesByRegion(ctx context.Context, region st
 	return count, nil
 }
 
-func (v *VoteResultRepository) GetVoteResultsByHour(ctx context.Context, date time.Time) ([]*model.VoteResult, error) {
+func (v *VoteResultRepository) GetVoteResultsByHour(ctx context.Context, date time.Time, limit, offset int) ([]*model.VoteResult, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.GetVoteResultsByHour")
 	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "GetVoteResultsByHour", time.Now())
 
 	var (
 		results []*model.VoteResult
@@ -560,8 +674,8 @@ func (v *VoteResultRepository) GetVoteResultsByHour(ctx context.Context, date ti
 	endHour := startHour.Add(time.Hour)
 
 	selectQuery := `id, voter_id, election_pair_id, region, status, transaction_hash, error_message, voted_at, processed_at, created_at, updated_at`
-	whereQuery := `AND created_at >= ? AND created_at < ? ORDER BY created_at DESC`
-	args = append(args, startHour, endHour)
+	whereQuery := `AND created_at >= ? AND created_at < ? ORDER BY created_at DESC, id ASC LIMIT ? OFFSET ?`
+	args = append(args, startHour, endHour, limit, offset)
 	query := fmt.Sprintf(selectVoteResultQuery, selectQuery, "", whereQuery)
 
 	if sqlTrx != nil {
@@ -580,9 +694,10 @@ func (v *VoteResultRepository) GetVoteResultsByHour(ctx context.Context, date ti
 	return results, nil
 }
 
-func (v *VoteResultRepository) GetVoteResultsByDay(ctx context.Context, date time.Time) ([]*model.VoteResult, error) {
+func (v *VoteResultRepository) GetVoteResultsByDay(ctx context.Context, date time.Time, limit, offset int) ([]*model.VoteResult, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.GetVoteResultsByDay")
 	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "GetVoteResultsByDay", time.Now())
 
 	var (
 		results []*model.VoteResult
@@ -596,8 +711,8 @@ func (v *VoteResultRepository) GetVoteResultsByDay(ctx context.Context, date tim
 	endDay := startDay.Add(24 * time.Hour)
 
 	selectQuery := `id, voter_id, election_pair_id, region, status, transaction_hash, error_message, voted_at, processed_at, created_at, updated_at`
-	whereQuery := `AND created_at >= ? AND created_at < ? ORDER BY created_at DESC`
-	args = append(args, startDay, endDay)
+	whereQuery := `AND created_at >= ? AND created_at < ? ORDER BY created_at DESC, id ASC LIMIT ? OFFSET ?`
+	args = append(args, startDay, endDay, limit, offset)
 	query := fmt.Sprintf(selectVoteResultQuery, selectQuery, "", whereQuery)
 
 	if sqlTrx != nil {
@@ -619,6 +734,7 @@ func (v *VoteResultRepository) GetVoteResultsByDay(ctx context.Context, date tim
 func (v *VoteResultRepository) GetDailyStatistics(ctx context.Context, startDate, endDate time.Time) ([]*model.VoteStatistics, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.GetDailyStatistics")
 	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "GetDailyStatistics", time.Now())
 
 	var (
 		results []*model.VoteStatistics
@@ -628,7 +744,7 @@ func (v *VoteResultRepository) GetDailyStatistics(ctx context.Context, startDate
 
 	sqlTrx := utils.GetSqlTx(ctx)
 
-	selectQuery := `toDate(created_at) as date, count(*) as total_votes, countIf(status = 'confirmed') as confirmed_votes,
+	selectQuery := `toDate(created_at) as date, count(*) as total_votes, countIf(status = 'confirmed') as confirmed_votes, countIf(status = 'finalizing') as finalizing_votes,
                  countIf(status = 'pending') as pending_votes, countIf(status = 'error') as error_votes, max(updated_at) as last_updated`
 	whereQuery := `AND created_at >= ? AND created_at <= ? GROUP BY toDate(created_at) ORDER BY date DESC`
 	args = append(args, startDate, endDate)
@@ -655,3 +771,406 @@ func (v *VoteResultRepository) GetDailyStatistics(ctx context.Context, startDate
 
 	return results, nil
 }
+
+// GetVoteTimeSeries counts votes per bucket of the filter's time axis. Buckets
+// are computed on the wall clock of the filter's location, and buckets without
+// votes are absent from the result.
+func (v *VoteResultRepository) GetVoteTimeSeries(ctx context.Context, filter model.TimeSeriesFilter) ([]*model.TimeSeriesPoint, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.GetVoteTimeSeries")
+	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "GetVoteTimeSeries", time.Now())
+
+	var (
+		results []*model.TimeSeriesPoint
+		err     error
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	bucketQuery, whereQuery, args := buildTimeSeriesQuery(filter)
+	selectQuery := bucketQuery + ` as bucket, count(*) as total_votes, countIf(status = 'confirmed') as confirmed_votes,
+                 countIf(status = 'finalizing') as finalizing_votes, countIf(status = 'pending') as pending_votes, countIf(status = 'error') as error_votes`
+	whereQuery += ` GROUP BY bucket ORDER BY bucket ASC`
+
+	query := fmt.Sprintf(selectVoteResultQuery, selectQuery, "", whereQuery)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &results, query, args...)
+	} else {
+		err = v.db.GetMaster().SelectContext(ctx, &results, query, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":  err,
+			"filter": filter,
+		}).ErrorWithCtx(ctx, "[VoteResultRepository.GetVoteTimeSeries] failed to get vote time series")
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// GetVoteBucketCounts counts votes per bucket, election pair, region and status.
+// Only combinations with at least one vote are returned.
+func (v *VoteResultRepository) GetVoteBucketCounts(ctx context.Context, filter model.TimeSeriesFilter) ([]*model.VoteBucketCount, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.GetVoteBucketCounts")
+	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "GetVoteBucketCounts", time.Now())
+
+	var (
+		results []*model.VoteBucketCount
+		err     error
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	bucketQuery, whereQuery, args := buildTimeSeriesQuery(filter)
+	selectQuery := bucketQuery + ` as bucket, election_pair_id, region, status, count(*) as votes`
+	whereQuery += ` GROUP BY bucket, election_pair_id, region, status ORDER BY bucket ASC, election_pair_id ASC, region ASC, status ASC`
+
+	query := fmt.Sprintf(selectVoteResultQuery, selectQuery, "", whereQuery)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &results, query, args...)
+	} else {
+		err = v.db.GetMaster().SelectContext(ctx, &results, query, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":  err,
+			"filter": filter,
+		}).ErrorWithCtx(ctx, "[VoteResultRepository.GetVoteBucketCounts] failed to get vote bucket counts")
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// StreamVoteResults walks every vote result matching the filter row by row and
+// hands each one to fn, so callers never hold the full result set in memory.
+// Iteration stops at the first error returned by fn.
+func (v *VoteResultRepository) StreamVoteResults(ctx context.Context, filter model.VoteResultFilter, fn func(result *model.VoteResult) error) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.StreamVoteResults")
+	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "StreamVoteResults", time.Now())
+
+	var (
+		rows *sqlx.Rows
+		err  error
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	selectQuery := `id, voter_id, election_pair_id, region, status, transaction_hash, error_message, voted_at, processed_at, created_at, updated_at`
+	whereQuery, args := buildVoteResultFilter(filter)
+	whereQuery += ` ORDER BY created_at ASC, id ASC`
+	query := fmt.Sprintf(selectVoteResultQuery, selectQuery, "", whereQuery)
+
+	if sqlTrx != nil {
+		rows, err = sqlTrx.QueryxContext(ctx, query, args...)
+	} else {
+		rows, err = v.db.GetMaster().QueryxContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":  err,
+			"filter": filter,
+		}).ErrorWithCtx(ctx, "[VoteResultRepository.StreamVoteResults] failed to query vote results")
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var result model.VoteResult
+		if err = rows.StructScan(&result); err != nil {
+			log.WithFields(log.Fields{
+				"error":  err,
+				"filter": filter,
+			}).ErrorWithCtx(ctx, "[VoteResultRepository.StreamVoteResults] failed to scan vote result")
+			return err
+		}
+
+		if err = fn(&result); err != nil {
+			return err
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		log.WithFields(log.Fields{
+			"error":  err,
+			"filter": filter,
+		}).ErrorWithCtx(ctx, "[VoteResultRepository.StreamVoteResults] failed to iterate vote results")
+		return err
+	}
+
+	return nil
+}
+
+func (v *VoteResultRepository) CountVoteResults(ctx context.Context, filter model.VoteResultFilter) (uint64, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.CountVoteResults")
+	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "CountVoteResults", time.Now())
+
+	var (
+		count uint64
+		err   error
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	selectQuery := `count(*)`
+	whereQuery, args := buildVoteResultFilter(filter)
+	query := fmt.Sprintf(selectVoteResultQuery, selectQuery, "", whereQuery)
+
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &count, query, args...)
+	} else {
+		err = v.db.GetMaster().GetContext(ctx, &count, query, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":  err,
+			"filter": filter,
+		}).ErrorWithCtx(ctx, "[VoteResultRepository.CountVoteResults] failed to count vote results")
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func buildVoteResultFilter(filter model.VoteResultFilter) (string, []any) {
+	var (
+		whereQuery string
+		args       []any
+	)
+
+	if filter.ElectionPairID != "" {
+		whereQuery += ` AND election_pair_id = ?`
+		args = append(args, filter.ElectionPairID)
+	}
+	if filter.Region != "" {
+		whereQuery += ` AND region = ?`
+		args = append(args, filter.Region)
+	}
+	if filter.Status != "" {
+		whereQuery += ` AND status = ?`
+		args = append(args, filter.Status)
+	}
+	if !filter.StartDate.IsZero() {
+		whereQuery += ` AND created_at >= ?`
+		args = append(args, filter.StartDate)
+	}
+	if !filter.EndDate.IsZero() {
+		whereQuery += ` AND created_at <= ?`
+		args = append(args, filter.EndDate)
+	}
+
+	return whereQuery, args
+}
+
+// buildTimeSeriesQuery returns the bucket expression and the conditions of a
+// time series filter. The bucket expression takes the first argument.
+func buildTimeSeriesQuery(filter model.TimeSeriesFilter) (string, string, []any) {
+	// The axis and bucket are validated enums, so they are safe to inline.
+	bucketQuery := fmt.Sprintf("%s(%s, ?)", bucketFunctions[filter.Bucket], filter.Axis)
+	args := []any{filter.Location.String()}
+
+	whereQuery := fmt.Sprintf(` AND %[1]s >= ? AND %[1]s < ?`, filter.Axis)
+	args = append(args, filter.Start, filter.End)
+	if filter.Axis == model.TimeAxisProcessedAt {
+		whereQuery += ` AND processed_at IS NOT NULL`
+	}
+	if filter.ElectionPairID != "" {
+		whereQuery += ` AND election_pair_id = ?`
+		args = append(args, filter.ElectionPairID)
+	}
+	if filter.Region != "" {
+		whereQuery += ` AND region = ?`
+		args = append(args, filter.Region)
+	}
+
+	return bucketQuery, whereQuery, args
+}
+
+// GetVotesToReconcile returns confirmed votes whose transaction is not verified
+// yet, or was verified at or above minBlock, least recently checked first.
+func (v *VoteResultRepository) GetVotesToReconcile(ctx context.Context, minBlock uint64, limit int) ([]*model.VoteResult, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.GetVotesToReconcile")
+	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "GetVotesToReconcile", time.Now())
+
+	var (
+		results []*model.VoteResult
+		err     error
+		args    []any
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	selectQuery := `id, voter_id, election_pair_id, region, status, transaction_hash, error_message, voted_at, processed_at, created_at, updated_at, ` + voteChainColumns
+	whereQuery := `AND status = ? AND (chain_status IN ('', ?, ?) OR (chain_status IN (?, ?) AND block_number >= ?))
+		ORDER BY chain_checked_at ASC NULLS FIRST, id ASC LIMIT ?`
+	args = append(args, string(model.VoteStatusConfirmed), string(model.ChainStatusMissing), string(model.ChainStatusReverted),
+		string(model.ChainStatusVerified), string(model.ChainStatusFailed), minBlock, limit)
+	query := fmt.Sprintf(selectVoteResultQuery, selectQuery, "", whereQuery)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &results, query, args...)
+	} else {
+		err = v.db.GetMaster().SelectContext(ctx, &results, query, args...)
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":     err,
+			"min_block": minBlock,
+			"limit":     limit,
+		}).ErrorWithCtx(ctx, "[VoteResultRepository.GetVotesToReconcile] failed to get votes to reconcile")
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (v *VoteResultRepository) GetVoteResultsByChainStatus(ctx context.Context, chainStatuses []string, limit, offset int) ([]*model.VoteResult, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.GetVoteResultsByChainStatus")
+	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "GetVoteResultsByChainStatus", time.Now())
+
+	var (
+		results []*model.VoteResult
+		err     error
+		args    []any
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	selectQuery := `id, voter_id, election_pair_id, region, status, transaction_hash, error_message, voted_at, processed_at, created_at, updated_at, ` + voteChainColumns
+	whereQuery := `AND has(?, chain_status) ORDER BY chain_checked_at DESC, id ASC LIMIT ? OFFSET ?`
+	args = append(args, chainStatuses, limit, offset)
+	query := fmt.Sprintf(selectVoteResultQuery, selectQuery, "", whereQuery)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &results, query, args...)
+	} else {
+		err = v.db.GetMaster().SelectContext(ctx, &results, query, args...)
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":          err,
+			"chain_statuses": chainStatuses,
+			"limit":          limit,
+			"offset":         offset,
+		}).ErrorWithCtx(ctx, "[VoteResultRepository.GetVoteResultsByChainStatus] failed to get vote results by chain status")
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (v *VoteResultRepository) GetVotesAboveBlock(ctx context.Context, block uint64) ([]*model.VoteResult, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.GetVotesAboveBlock")
+	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "GetVotesAboveBlock", time.Now())
+
+	var (
+		results []*model.VoteResult
+		err     error
+		args    []any
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	selectQuery := `id, voter_id, election_pair_id, region, status, transaction_hash, error_message, voted_at, processed_at, created_at, updated_at, ` + voteChainColumns
+	whereQuery := `AND chain_status IN (?, ?) AND block_number > ? ORDER BY block_number ASC, id ASC`
+	args = append(args, string(model.ChainStatusVerified), string(model.ChainStatusFailed), block)
+	query := fmt.Sprintf(selectVoteResultQuery, selectQuery, "", whereQuery)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &results, query, args...)
+	} else {
+		err = v.db.GetMaster().SelectContext(ctx, &results, query, args...)
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"block": block,
+		}).ErrorWithCtx(ctx, "[VoteResultRepository.GetVotesAboveBlock] failed to get votes above block")
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (v *VoteResultRepository) GetVotesToFinalize(ctx context.Context, limit int) ([]*model.VoteResult, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.GetVotesToFinalize")
+	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "GetVotesToFinalize", time.Now())
+
+	var (
+		results []*model.VoteResult
+		err     error
+		args    []any
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	selectQuery := `id, voter_id, election_pair_id, region, status, transaction_hash, error_message, voted_at, processed_at, created_at, updated_at, ` + voteChainColumns
+	whereQuery := `AND status = ? ORDER BY chain_checked_at ASC NULLS FIRST, id ASC LIMIT ?`
+	args = append(args, string(model.VoteStatusFinalizing), limit)
+	query := fmt.Sprintf(selectVoteResultQuery, selectQuery, "", whereQuery)
+
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &results, query, args...)
+	} else {
+		err = v.db.GetMaster().SelectContext(ctx, &results, query, args...)
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"limit": limit,
+		}).ErrorWithCtx(ctx, "[VoteResultRepository.GetVotesToFinalize] failed to get votes to finalize")
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (v *VoteResultRepository) UpdateVoteChainStatus(ctx context.Context, result *model.VoteResult) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "VoteResultRepository.UpdateVoteChainStatus")
+	defer span.End()
+	defer metrics.ObserveQuery("VoteResultRepository", "UpdateVoteChainStatus", time.Now())
+
+	var (
+		args []any
+		err  error
+	)
+
+	sqlTrx := utils.GetSqlTx(ctx)
+
+	setQuery := `chain_status = ?, block_number = ?, block_hash = ?, block_time = ?, chain_checked_at = ?`
+	whereQuery := ` AND id = ?`
+	args = append(args, result.ChainStatus, result.BlockNumber, result.BlockHash, result.BlockTime, result.ChainCheckedAt, result.ID)
+
+	query := fmt.Sprintf(updateVoteResultQuery, setQuery, whereQuery)
+
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = v.db.GetMaster().ExecContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":        err,
+			"id":           result.ID,
+			"chain_status": result.ChainStatus,
+		}).ErrorWithCtx(ctx, "[VoteResultRepository.UpdateVoteChainStatus] failed to update vote chain status")
+		return err
+	}
+
+	return nil
+}
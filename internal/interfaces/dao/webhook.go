@@ -0,0 +1,296 @@
+package dao
+
+import (
+	"context"
+	sql2 "database/sql"
+	"errors"
+	"github.com/nocturna-ta/golib/database/sql"
+	"github.com/nocturna-ta/golib/log"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/golib/txmanager/utils"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
+	"time"
+)
+
+type WebhookRepository struct {
+	db *sql.Store
+}
+
+type OptsWebhookRepository struct {
+	DB *sql.Store
+}
+
+func NewWebhookRepository(opts *OptsWebhookRepository) repository.WebhookRepository {
+	return &WebhookRepository{
+		db: opts.DB,
+	}
+}
+
+const (
+	webhookSubscriptionColumns = `id, url, secret, event_types, election_pair_id, region, active, consecutive_failures,
+		last_error, last_success_at, last_failure_at, created_at, updated_at`
+
+	insertWebhookSubscriptionQuery = `
+		INSERT INTO webhook_subscriptions (` + webhookSubscriptionColumns + `) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	updateWebhookSubscriptionQuery = `
+		ALTER TABLE webhook_subscriptions UPDATE url = ?, secret = ?, event_types = ?, election_pair_id = ?, region = ?,
+		active = ?, consecutive_failures = ?, updated_at = ? WHERE id = ?`
+
+	updateWebhookHealthQuery = `
+		ALTER TABLE webhook_subscriptions UPDATE active = ?, consecutive_failures = ?, last_error = ?,
+		last_success_at = ?, last_failure_at = ? WHERE id = ?`
+
+	deleteWebhookSubscriptionQuery = `ALTER TABLE webhook_subscriptions DELETE WHERE id = ?`
+
+	selectWebhookSubscriptionsQuery = `SELECT ` + webhookSubscriptionColumns + ` FROM webhook_subscriptions`
+
+	webhookDeliveryColumns = `id, delivery_id, subscription_id, event_type, payload, attempt, status, response_code,
+		error, duration_ms, attempted_at`
+
+	insertWebhookDeliveryQuery = `
+		INSERT INTO webhook_deliveries (` + webhookDeliveryColumns + `) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	selectWebhookDeliveriesQuery = `SELECT ` + webhookDeliveryColumns + ` FROM webhook_deliveries`
+)
+
+func (w *WebhookRepository) InsertWebhookSubscription(ctx context.Context, subscription *model.WebhookSubscription) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookRepository.InsertWebhookSubscription")
+	defer span.End()
+	defer metrics.ObserveQuery("WebhookRepository", "InsertWebhookSubscription", time.Now())
+
+	args := []any{
+		subscription.ID, subscription.URL, subscription.Secret, subscription.EventTypes, subscription.ElectionPairID,
+		subscription.Region, subscription.Active, subscription.ConsecutiveFailures, subscription.LastError,
+		subscription.LastSuccessAt, subscription.LastFailureAt, subscription.CreatedAt, subscription.UpdatedAt,
+	}
+
+	if err := w.exec(ctx, insertWebhookSubscriptionQuery, args...); err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    subscription.ID,
+		}).ErrorWithCtx(ctx, "[WebhookRepository.InsertWebhookSubscription] failed to insert webhook subscription")
+		return err
+	}
+
+	return nil
+}
+
+func (w *WebhookRepository) UpdateWebhookSubscription(ctx context.Context, subscription *model.WebhookSubscription) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookRepository.UpdateWebhookSubscription")
+	defer span.End()
+	defer metrics.ObserveQuery("WebhookRepository", "UpdateWebhookSubscription", time.Now())
+
+	args := []any{
+		subscription.URL, subscription.Secret, subscription.EventTypes, subscription.ElectionPairID, subscription.Region,
+		subscription.Active, subscription.ConsecutiveFailures, subscription.UpdatedAt, subscription.ID,
+	}
+
+	if err := w.exec(ctx, updateWebhookSubscriptionQuery, args...); err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    subscription.ID,
+		}).ErrorWithCtx(ctx, "[WebhookRepository.UpdateWebhookSubscription] failed to update webhook subscription")
+		return err
+	}
+
+	return nil
+}
+
+func (w *WebhookRepository) UpdateWebhookHealth(ctx context.Context, subscription *model.WebhookSubscription) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookRepository.UpdateWebhookHealth")
+	defer span.End()
+	defer metrics.ObserveQuery("WebhookRepository", "UpdateWebhookHealth", time.Now())
+
+	args := []any{
+		subscription.Active, subscription.ConsecutiveFailures, subscription.LastError,
+		subscription.LastSuccessAt, subscription.LastFailureAt, subscription.ID,
+	}
+
+	if err := w.exec(ctx, updateWebhookHealthQuery, args...); err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    subscription.ID,
+		}).ErrorWithCtx(ctx, "[WebhookRepository.UpdateWebhookHealth] failed to update webhook health")
+		return err
+	}
+
+	return nil
+}
+
+func (w *WebhookRepository) DeleteWebhookSubscription(ctx context.Context, id string) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookRepository.DeleteWebhookSubscription")
+	defer span.End()
+	defer metrics.ObserveQuery("WebhookRepository", "DeleteWebhookSubscription", time.Now())
+
+	if err := w.exec(ctx, deleteWebhookSubscriptionQuery, id); err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WebhookRepository.DeleteWebhookSubscription] failed to delete webhook subscription")
+		return err
+	}
+
+	return nil
+}
+
+func (w *WebhookRepository) GetWebhookSubscription(ctx context.Context, id string) (*model.WebhookSubscription, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookRepository.GetWebhookSubscription")
+	defer span.End()
+	defer metrics.ObserveQuery("WebhookRepository", "GetWebhookSubscription", time.Now())
+
+	var (
+		result model.WebhookSubscription
+		err    error
+	)
+
+	query := selectWebhookSubscriptionsQuery + ` WHERE id = ? LIMIT 1`
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &result, query, id)
+	} else {
+		err = w.db.GetMaster().GetContext(ctx, &result, query, id)
+	}
+
+	if errors.Is(err, sql2.ErrNoRows) {
+		return nil, ErrNoResult
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WebhookRepository.GetWebhookSubscription] failed to get webhook subscription")
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (w *WebhookRepository) GetWebhookSubscriptions(ctx context.Context) ([]*model.WebhookSubscription, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookRepository.GetWebhookSubscriptions")
+	defer span.End()
+	defer metrics.ObserveQuery("WebhookRepository", "GetWebhookSubscriptions", time.Now())
+
+	var (
+		results []*model.WebhookSubscription
+		err     error
+	)
+
+	query := selectWebhookSubscriptionsQuery + ` ORDER BY created_at ASC, id ASC`
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &results, query)
+	} else {
+		err = w.db.GetMaster().SelectContext(ctx, &results, query)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[WebhookRepository.GetWebhookSubscriptions] failed to get webhook subscriptions")
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (w *WebhookRepository) InsertWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookRepository.InsertWebhookDelivery")
+	defer span.End()
+	defer metrics.ObserveQuery("WebhookRepository", "InsertWebhookDelivery", time.Now())
+
+	args := []any{
+		delivery.ID, delivery.DeliveryID, delivery.SubscriptionID, delivery.EventType, delivery.Payload, delivery.Attempt,
+		delivery.Status, delivery.ResponseCode, delivery.Error, delivery.DurationMs, delivery.AttemptedAt,
+	}
+
+	if err := w.exec(ctx, insertWebhookDeliveryQuery, args...); err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"delivery_id": delivery.DeliveryID,
+		}).ErrorWithCtx(ctx, "[WebhookRepository.InsertWebhookDelivery] failed to insert webhook delivery")
+		return err
+	}
+
+	return nil
+}
+
+func (w *WebhookRepository) GetWebhookDeliveries(ctx context.Context, subscriptionID string, limit, offset int) ([]*model.WebhookDelivery, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookRepository.GetWebhookDeliveries")
+	defer span.End()
+	defer metrics.ObserveQuery("WebhookRepository", "GetWebhookDeliveries", time.Now())
+
+	var (
+		results []*model.WebhookDelivery
+		err     error
+	)
+
+	query := selectWebhookDeliveriesQuery + ` WHERE subscription_id = ? ORDER BY attempted_at DESC, id ASC LIMIT ? OFFSET ?`
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		err = sqlTrx.SelectContext(ctx, &results, query, subscriptionID, limit, offset)
+	} else {
+		err = w.db.GetMaster().SelectContext(ctx, &results, query, subscriptionID, limit, offset)
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":           err,
+			"subscription_id": subscriptionID,
+		}).ErrorWithCtx(ctx, "[WebhookRepository.GetWebhookDeliveries] failed to get webhook deliveries")
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (w *WebhookRepository) GetLatestWebhookDelivery(ctx context.Context, deliveryID string) (*model.WebhookDelivery, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookRepository.GetLatestWebhookDelivery")
+	defer span.End()
+	defer metrics.ObserveQuery("WebhookRepository", "GetLatestWebhookDelivery", time.Now())
+
+	var (
+		result model.WebhookDelivery
+		err    error
+	)
+
+	query := selectWebhookDeliveriesQuery + ` WHERE delivery_id = ? ORDER BY attempt DESC LIMIT 1`
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		err = sqlTrx.GetContext(ctx, &result, query, deliveryID)
+	} else {
+		err = w.db.GetMaster().GetContext(ctx, &result, query, deliveryID)
+	}
+
+	if errors.Is(err, sql2.ErrNoRows) {
+		return nil, ErrNoResult
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"delivery_id": deliveryID,
+		}).ErrorWithCtx(ctx, "[WebhookRepository.GetLatestWebhookDelivery] failed to get webhook delivery")
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (w *WebhookRepository) exec(ctx context.Context, query string, args ...any) error {
+	var err error
+
+	sqlTrx := utils.GetSqlTx(ctx)
+	if sqlTrx != nil {
+		_, err = sqlTrx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = w.db.GetMaster().ExecContext(ctx, query, args...)
+	}
+
+	return err
+}
@@ -0,0 +1,21 @@
+package usecases
+
+import (
+	"context"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+)
+
+type AnomalyUseCases interface {
+	// Detection, fed by the consumer
+	ObserveVote(ctx context.Context, vote *model.VoteResult, isNew bool)
+	Run(ctx context.Context)
+	Raise(ctx context.Context, found *model.Anomaly)
+
+	// Alerts, pushed by the server
+	StartAlertBroadcast(ctx context.Context)
+
+	// Queries
+	GetAnomalies(ctx context.Context, req *request.AnomalyRequest) ([]*response.AnomalyResponse, error)
+}
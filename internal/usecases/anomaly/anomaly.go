@@ -0,0 +1,237 @@
+package anomaly
+
+import (
+	"context"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/golib/log"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/infrastructures/anomaly"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"time"
+)
+
+// alertBatchSize caps the anomalies pushed per alert interval.
+const alertBatchSize = 1000
+
+// ObserveVote queues a consumed vote for the detector without blocking the
+// consumer. Votes are skipped when detection is disabled or the queue is full.
+func (m *Module) ObserveVote(ctx context.Context, vote *model.VoteResult, isNew bool) {
+	if m.detector == nil || vote == nil {
+		return
+	}
+
+	select {
+	case m.queue <- &anomaly.Vote{
+		ID:              vote.ID,
+		VoterID:         vote.VoterID,
+		ElectionPairID:  vote.ElectionPairID,
+		Region:          vote.Region,
+		Status:          vote.Status,
+		TransactionHash: vote.TransactionHash,
+		VotedAt:         vote.VotedAt,
+		ObservedAt:      time.Now(),
+		New:             isNew,
+	}:
+	default:
+		metrics.AnomalyVotesDropped.Inc()
+		log.WithFields(log.Fields{
+			"vote_id": vote.ID,
+		}).WarnWithCtx(ctx, "[AnomalyUseCases.ObserveVote] Detector queue full, skipping vote")
+	}
+}
+
+// Run feeds queued votes to the detector and closes a detection window every
+// configured interval until ctx is done.
+func (m *Module) Run(ctx context.Context) {
+	if m.detector == nil {
+		return
+	}
+
+	ticker := time.NewTicker(m.cfg.Window)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case vote := <-m.queue:
+			m.report(ctx, m.detector.Observe(vote))
+		case now := <-ticker.C:
+			m.report(ctx, m.detector.Tick(now))
+		}
+	}
+}
+
+// Raise stores an anomaly found outside the detector, for the server to push
+// to admins. It is raised whether detection is enabled or not.
+func (m *Module) Raise(ctx context.Context, found *model.Anomaly) {
+	m.report(ctx, []*model.Anomaly{found})
+}
+
+func (m *Module) report(ctx context.Context, anomalies []*model.Anomaly) {
+	for _, found := range anomalies {
+		metrics.AnomaliesDetected.WithLabelValues(found.Kind).Inc()
+		log.WithFields(log.Fields{
+			"id":               found.ID,
+			"kind":             found.Kind,
+			"election_pair_id": found.ElectionPairID,
+			"region":           found.Region,
+			"vote_id":          found.VoteID,
+			"description":      found.Description,
+		}).WarnWithCtx(ctx, "[AnomalyUseCases.Run] Anomaly detected")
+
+		if err := m.anomalyRepo.InsertAnomaly(ctx, found); err != nil {
+			log.WithFields(log.Fields{
+				"error": err,
+				"id":    found.ID,
+				"kind":  found.Kind,
+			}).ErrorWithCtx(ctx, "[AnomalyUseCases.Run] Failed to store anomaly")
+		}
+	}
+}
+
+// StartAlertBroadcast pushes the anomalies the consumer stores to admins
+// subscribed on the hub, reading new ones every alert interval until ctx is
+// done. Anomalies stored before it starts are not pushed.
+func (m *Module) StartAlertBroadcast(ctx context.Context) {
+	if m.hub == nil {
+		return
+	}
+
+	ticker := time.NewTicker(m.cfg.AlertInterval)
+	defer ticker.Stop()
+
+	m.alertedUntil = time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := m.alert(ctx, now); err != nil {
+				log.WithFields(log.Fields{
+					"error": err,
+				}).ErrorWithCtx(ctx, "[AnomalyUseCases.StartAlertBroadcast] Failed to read anomalies to alert")
+			}
+		}
+	}
+}
+
+// alert pushes the anomalies stored since the last read, oldest first. The
+// read reaches back an interval further, since the consumer stores anomalies
+// after detecting them, and anomalies already pushed are skipped.
+func (m *Module) alert(ctx context.Context, now time.Time) error {
+	since := m.alertedUntil.Add(-m.cfg.AlertInterval)
+	found, err := m.anomalyRepo.GetAnomalies(ctx, model.AnomalyFilter{StartDate: since}, alertBatchSize, 0)
+	if err != nil {
+		return err
+	}
+
+	for i := len(found) - 1; i >= 0; i-- {
+		if _, ok := m.alerted[found[i].ID]; ok {
+			continue
+		}
+		m.alerted[found[i].ID] = found[i].DetectedAt
+		m.hub.BroadcastAnomalyAlert(toAnomalyResponse(found[i]))
+	}
+
+	for id, detectedAt := range m.alerted {
+		if detectedAt.Before(since) {
+			delete(m.alerted, id)
+		}
+	}
+	m.alertedUntil = now
+
+	return nil
+}
+
+func (m *Module) GetAnomalies(ctx context.Context, req *request.AnomalyRequest) ([]*response.AnomalyResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "AnomalyUseCases.GetAnomalies")
+	defer span.End()
+
+	filter := model.AnomalyFilter{
+		Kind:           req.Kind,
+		ElectionPairID: req.ElectionPairID,
+		Region:         req.Region,
+	}
+
+	switch model.AnomalyKind(req.Kind) {
+	case "", model.AnomalyKindRegionSpike, model.AnomalyKindErrorRateSurge, model.AnomalyKindSharedTransactionHash,
+		model.AnomalyKindDuplicateVoter, model.AnomalyKindClockSkew, model.AnomalyKindCertifiedTallyChange:
+	default:
+		return nil, &custerr.ErrChain{
+			Message: "kind must be one of region_spike, error_rate_surge, shared_transaction_hash, duplicate_voter, clock_skew or certified_tally_change",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+
+	var err error
+	if filter.StartDate, err = parseAnomalyTime(req.StartDate); err != nil {
+		return nil, &custerr.ErrChain{
+			Message: "invalid start date format, expected RFC3339",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+	if filter.EndDate, err = parseAnomalyTime(req.EndDate); err != nil {
+		return nil, &custerr.ErrChain{
+			Message: "invalid end date format, expected RFC3339",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+
+	limit, offset := req.Limit, req.Offset
+	if limit <= 0 {
+		limit = 50
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	results, err := m.anomalyRepo.GetAnomalies(ctx, filter, limit, offset)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":  err,
+			"filter": filter,
+		}).ErrorWithCtx(ctx, "[AnomalyUseCases.GetAnomalies] Failed to get anomalies")
+		return nil, err
+	}
+
+	responses := make([]*response.AnomalyResponse, 0, len(results))
+	for _, result := range results {
+		responses = append(responses, toAnomalyResponse(result))
+	}
+
+	return responses, nil
+}
+
+func parseAnomalyTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+func toAnomalyResponse(found *model.Anomaly) *response.AnomalyResponse {
+	return &response.AnomalyResponse{
+		ID:              found.ID,
+		Kind:            found.Kind,
+		ElectionPairID:  found.ElectionPairID,
+		Region:          found.Region,
+		VoteID:          found.VoteID,
+		VoterID:         found.VoterID,
+		TransactionHash: found.TransactionHash,
+		Value:           found.Value,
+		Baseline:        found.Baseline,
+		Description:     found.Description,
+		DetectedAt:      found.DetectedAt,
+	}
+}
@@ -0,0 +1,139 @@
+package anomaly
+
+import (
+	"context"
+	"errors"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/websocket"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"github.com/nocturna-ta/result/pkg/constants"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type anomalyRepo struct {
+	repository.AnomalyRepository
+
+	mu        sync.Mutex
+	anomalies []*model.Anomaly
+}
+
+func (r *anomalyRepo) InsertAnomaly(_ context.Context, anomaly *model.Anomaly) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.anomalies = append(r.anomalies, anomaly)
+	return nil
+}
+
+func (r *anomalyRepo) GetAnomalies(_ context.Context, filter model.AnomalyFilter, limit, offset int) ([]*model.Anomaly, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var anomalies []*model.Anomaly
+	for _, anomaly := range r.anomalies {
+		if !anomaly.DetectedAt.Before(filter.StartDate) {
+			anomalies = append(anomalies, anomaly)
+		}
+	}
+	sort.SliceStable(anomalies, func(i, j int) bool { return anomalies[i].DetectedAt.After(anomalies[j].DetectedAt) })
+	if offset > len(anomalies) {
+		return nil, nil
+	}
+	anomalies = anomalies[offset:]
+	if len(anomalies) > limit {
+		anomalies = anomalies[:limit]
+	}
+	return anomalies, nil
+}
+
+func TestAnomalyStoredByConsumerReachesServerAdmins(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	repo := &anomalyRepo{}
+	consumer := New(&Options{AnomalyRepo: repo})
+
+	hub := websocket.NewHub(ctx, config.LiveResultsConfig{}, nil)
+	defer hub.Stop()
+	go hub.Run()
+
+	subscriptions := map[websocket.SubscriptionType]*websocket.MessageFilter{websocket.SubscriptionAnomalies: nil}
+	admin := hub.Listen(subscriptions, 8, constants.RoleAdmin)
+	observer := hub.Listen(subscriptions, 8, constants.RoleObserver)
+
+	server := New(&Options{
+		AnomalyRepo: repo,
+		Hub:         hub,
+		Cfg:         config.AnomalyConfig{AlertInterval: 10 * time.Millisecond},
+	})
+	go server.StartAlertBroadcast(ctx)
+
+	// Stored before the server started alerting, so never pushed.
+	repo.InsertAnomaly(ctx, &model.Anomaly{ID: "old", DetectedAt: time.Now().Add(-time.Hour)})
+	time.Sleep(20 * time.Millisecond)
+
+	consumer.Raise(ctx, &model.Anomaly{
+		ID:             "anomaly-1",
+		Kind:           string(model.AnomalyKindDuplicateVoter),
+		ElectionPairID: "pair-1",
+		Region:         "region-1",
+		DetectedAt:     time.Now(),
+	})
+
+	select {
+	case message := <-admin.Messages:
+		alert, ok := message.Data.(*response.AnomalyResponse)
+		if message.Type != websocket.MessageTypeAnomalyAlert || !ok || alert.ID != "anomaly-1" {
+			t.Fatalf("admin received %s %+v, want the anomaly-1 alert", message.Type, message.Data)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("the anomaly stored by the consumer did not reach the admin")
+	}
+
+	// Later reads overlap the first but push nothing again.
+	time.Sleep(50 * time.Millisecond)
+	select {
+	case message := <-admin.Messages:
+		t.Errorf("admin received %s %+v again", message.Type, message.Data)
+	default:
+	}
+	select {
+	case message := <-observer.Messages:
+		t.Errorf("observer received %s %+v", message.Type, message.Data)
+	default:
+	}
+}
+
+func TestGetAnomaliesKind(t *testing.T) {
+	m := New(&Options{AnomalyRepo: &anomalyRepo{}})
+
+	tests := []struct {
+		kind    string
+		wantErr bool
+	}{
+		{kind: ""},
+		{kind: string(model.AnomalyKindClockSkew)},
+		{kind: string(model.AnomalyKindCertifiedTallyChange)},
+		{kind: "unknown", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			_, err := m.GetAnomalies(context.Background(), &request.AnomalyRequest{Kind: tt.kind})
+			var chain *custerr.ErrChain
+			switch {
+			case !tt.wantErr && err != nil:
+				t.Fatalf("GetAnomalies(%q) = %v", tt.kind, err)
+			case tt.wantErr && (!errors.As(err, &chain) || chain.Code != 400):
+				t.Fatalf("GetAnomalies(%q) = %v, want a bad request", tt.kind, err)
+			case tt.wantErr && !strings.Contains(chain.Message, string(model.AnomalyKindCertifiedTallyChange)):
+				t.Errorf("message %q does not list %s", chain.Message, model.AnomalyKindCertifiedTallyChange)
+			}
+		})
+	}
+}
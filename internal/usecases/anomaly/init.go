@@ -0,0 +1,56 @@
+package anomaly
+
+import (
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/anomaly"
+	"github.com/nocturna-ta/result/internal/infrastructures/websocket"
+	"github.com/nocturna-ta/result/internal/usecases"
+	"time"
+)
+
+type Module struct {
+	anomalyRepo repository.AnomalyRepository
+	hub         *websocket.Hub
+	cfg         config.AnomalyConfig
+	detector    *anomaly.Detector
+	queue       chan *anomaly.Vote
+	// alertedUntil is when the stored anomalies were last read for alerts, and
+	// alerted holds the detection time of those pushed since, by ID.
+	alertedUntil time.Time
+	alerted      map[string]time.Time
+}
+
+type Options struct {
+	AnomalyRepo repository.AnomalyRepository
+	// Hub receives the anomalies stored by the consumer, pushed to admins by
+	// StartAlertBroadcast on the server.
+	Hub *websocket.Hub
+	Cfg config.AnomalyConfig
+}
+
+func New(opts *Options) usecases.AnomalyUseCases {
+	module := &Module{
+		anomalyRepo: opts.AnomalyRepo,
+		hub:         opts.Hub,
+		cfg:         opts.Cfg,
+		alerted:     make(map[string]time.Time),
+	}
+
+	if module.cfg.AlertInterval <= 0 {
+		module.cfg.AlertInterval = 5 * time.Second
+	}
+
+	if opts.Cfg.Enabled {
+		if module.cfg.Window <= 0 {
+			module.cfg.Window = time.Minute
+		}
+		if module.cfg.QueueSize <= 0 {
+			module.cfg.QueueSize = 1024
+		}
+		module.detector = anomaly.NewDetector(module.cfg)
+		module.queue = make(chan *anomaly.Vote, module.cfg.QueueSize)
+	}
+
+	return module
+}
@@ -0,0 +1,19 @@
+package usecases
+
+import (
+	"context"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+)
+
+type CertificationUseCases interface {
+	Certify(ctx context.Context, req *request.CertificationRequest) (*response.CertificationResponse, error)
+	GetCertifications(ctx context.Context) ([]*response.CertificationResponse, error)
+	GetCertification(ctx context.Context, id string) (*response.CertificationResponse, error)
+
+	// Guard, used by the consumer
+	FrozenBy(ctx context.Context, electionPairID, region string) (*model.Certification, error)
+	Quarantine(ctx context.Context, quarantined *model.QuarantinedEvent) error
+	GetQuarantinedEvents(ctx context.Context, limit, offset int) ([]*response.QuarantinedEventResponse, error)
+}
@@ -0,0 +1,341 @@
+package certification
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/golib/log"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/infrastructures/auth"
+	"github.com/nocturna-ta/result/internal/infrastructures/embargo"
+	"github.com/nocturna-ta/result/internal/interfaces/dao"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Certify freezes the current counts of a region or an election into a hashed
+// snapshot. A scope is certified once; its certification is never replaced.
+func (m *Module) Certify(ctx context.Context, req *request.CertificationRequest) (*response.CertificationResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "CertificationUseCases.Certify")
+	defer span.End()
+
+	var scopeID string
+	switch req.Scope {
+	case model.CertificationScopeRegion:
+		scopeID = strings.TrimSpace(req.Region)
+	case model.CertificationScopeElection:
+		scopeID = strings.TrimSpace(req.ElectionID)
+	default:
+		return nil, &custerr.ErrChain{
+			Message: "scope must be region or election",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+	if scopeID == "" {
+		return nil, &custerr.ErrChain{
+			Message: "region or election_id is required for the scope",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+
+	m.certifyMu.Lock()
+	defer m.certifyMu.Unlock()
+
+	_, err := m.certificationRepo.GetCertificationByScope(ctx, req.Scope, scopeID)
+	if err == nil {
+		return nil, &custerr.ErrChain{
+			Message: req.Scope + " " + scopeID + " is already certified",
+			Code:    409,
+			Type:    response2.ErrConflict,
+		}
+	}
+	if !errors.Is(err, dao.ErrNoResult) {
+		return nil, err
+	}
+
+	snapshot := &model.CertificationSnapshot{
+		CertificationID: uuid.NewString(),
+		Scope:           req.Scope,
+		CertifiedAt:     time.Now().UTC(),
+	}
+	if principal := auth.PrincipalFromContext(ctx); principal != nil {
+		snapshot.CertifiedBy = principal.UserId
+		snapshot.CertifierName = principal.Name
+	}
+
+	var results []*model.ElectionResult
+	if req.Scope == model.CertificationScopeRegion {
+		snapshot.Region = scopeID
+		results, err = m.regionResults(ctx, scopeID)
+	} else {
+		snapshot.ElectionID = scopeID
+		results, err = m.electionResults(ctx, scopeID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, result := range results {
+		counts := model.CertifiedVoteCounts{
+			TotalVotes:      result.TotalVotes,
+			ConfirmedVotes:  result.ConfirmedVotes,
+			FinalizingVotes: result.FinalizingVotes,
+			PendingVotes:    result.PendingVotes,
+			ErrorVotes:      result.ErrorVotes,
+		}
+		snapshot.Results = append(snapshot.Results, &model.CertifiedResult{
+			ElectionPairID:      result.ElectionPairID,
+			CertifiedVoteCounts: counts,
+		})
+		snapshot.Totals.TotalVotes += counts.TotalVotes
+		snapshot.Totals.ConfirmedVotes += counts.ConfirmedVotes
+		snapshot.Totals.FinalizingVotes += counts.FinalizingVotes
+		snapshot.Totals.PendingVotes += counts.PendingVotes
+		snapshot.Totals.ErrorVotes += counts.ErrorVotes
+		if result.LastUpdated.After(snapshot.LastUpdated) {
+			snapshot.LastUpdated = result.LastUpdated.UTC()
+		}
+	}
+	sort.Slice(snapshot.Results, func(i, j int) bool {
+		return snapshot.Results[i].ElectionPairID < snapshot.Results[j].ElectionPairID
+	})
+
+	content, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256(content)
+
+	certification := &model.Certification{
+		ID:            snapshot.CertificationID,
+		Scope:         snapshot.Scope,
+		ScopeID:       scopeID,
+		Snapshot:      string(content),
+		ContentHash:   hex.EncodeToString(digest[:]),
+		CertifiedBy:   snapshot.CertifiedBy,
+		CertifierName: snapshot.CertifierName,
+		CertifiedAt:   snapshot.CertifiedAt,
+	}
+	if err = m.certificationRepo.InsertCertification(ctx, certification); err != nil {
+		log.WithFields(log.Fields{
+			"error":    err,
+			"scope":    req.Scope,
+			"scope_id": scopeID,
+		}).ErrorWithCtx(ctx, "[CertificationUseCases.Certify] Failed to store certification")
+		return nil, err
+	}
+	m.invalidate()
+
+	log.WithFields(log.Fields{
+		"id":           certification.ID,
+		"scope":        certification.Scope,
+		"scope_id":     certification.ScopeID,
+		"content_hash": certification.ContentHash,
+		"certified_by": certification.CertifiedBy,
+	}).InfoWithCtx(ctx, "[CertificationUseCases.Certify] Result certified")
+
+	return m.toCertificationResponse(ctx, certification), nil
+}
+
+func (m *Module) GetCertifications(ctx context.Context) ([]*response.CertificationResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "CertificationUseCases.GetCertifications")
+	defer span.End()
+
+	certifications, err := m.certificationRepo.GetCertifications(ctx)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[CertificationUseCases.GetCertifications] Failed to get certifications")
+		return nil, err
+	}
+
+	responses := make([]*response.CertificationResponse, 0, len(certifications))
+	for _, certification := range certifications {
+		responses = append(responses, m.toCertificationResponse(ctx, certification))
+	}
+
+	return responses, nil
+}
+
+func (m *Module) GetCertification(ctx context.Context, id string) (*response.CertificationResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "CertificationUseCases.GetCertification")
+	defer span.End()
+
+	certification, err := m.certificationRepo.GetCertification(ctx, id)
+	if err != nil {
+		if errors.Is(err, dao.ErrNoResult) {
+			return nil, &custerr.ErrChain{
+				Message: "certification not found",
+				Code:    404,
+				Type:    response2.ErrNotFound,
+			}
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[CertificationUseCases.GetCertification] Failed to get certification")
+		return nil, err
+	}
+
+	return m.toCertificationResponse(ctx, certification), nil
+}
+
+// regionResults returns the counts of every election pair voted for in the
+// region. A region of the hierarchy counts the votes of every polling station
+// under it, whichever of its codes or aliases the votes carry.
+func (m *Module) regionResults(ctx context.Context, region string) ([]*model.ElectionResult, error) {
+	regions := []string{region}
+	if node, ok := m.hierarchy.Node(region); ok {
+		var err error
+		if regions, err = m.regionsUnder(ctx, node.Code); err != nil {
+			return nil, err
+		}
+	}
+
+	byPair := make(map[string]*model.ElectionResult)
+	for _, voted := range regions {
+		counted, err := m.voteResultRepo.GetElectionResultsByRegion(ctx, voted)
+		if err != nil && !errors.Is(err, dao.ErrNoResult) {
+			log.WithFields(log.Fields{
+				"error":  err,
+				"region": voted,
+			}).ErrorWithCtx(ctx, "[CertificationUseCases.Certify] Failed to get election results by region")
+			return nil, err
+		}
+		for _, result := range counted {
+			sum, ok := byPair[result.ElectionPairID]
+			if !ok {
+				sum = &model.ElectionResult{ElectionPairID: result.ElectionPairID, Region: region}
+				byPair[result.ElectionPairID] = sum
+			}
+			sum.TotalVotes += result.TotalVotes
+			sum.ConfirmedVotes += result.ConfirmedVotes
+			sum.FinalizingVotes += result.FinalizingVotes
+			sum.PendingVotes += result.PendingVotes
+			sum.ErrorVotes += result.ErrorVotes
+			if result.LastUpdated.After(sum.LastUpdated) {
+				sum.LastUpdated = result.LastUpdated
+			}
+		}
+	}
+
+	results := make([]*model.ElectionResult, 0, len(byPair))
+	for _, result := range byPair {
+		results = append(results, result)
+	}
+	if len(results) == 0 {
+		return nil, &custerr.ErrChain{
+			Message: "no votes found for the region",
+			Code:    404,
+			Type:    response2.ErrNotFound,
+		}
+	}
+	return results, nil
+}
+
+// regionsUnder returns the regions votes carry that map to a polling station
+// under the region of the hierarchy with the code, the station itself included.
+func (m *Module) regionsUnder(ctx context.Context, code string) ([]string, error) {
+	voted, err := m.voteResultRepo.GetRegionStatistics(ctx)
+	if err != nil && !errors.Is(err, dao.ErrNoResult) {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[CertificationUseCases.Certify] Failed to get region statistics")
+		return nil, err
+	}
+
+	var regions []string
+	for _, result := range voted {
+		leaf, ok := m.hierarchy.Leaf(result.Region)
+		if !ok {
+			continue
+		}
+		for node, ok := leaf, true; ok; node, ok = m.hierarchy.Node(node.ParentCode) {
+			if node.Code == code {
+				regions = append(regions, result.Region)
+				break
+			}
+		}
+	}
+	return regions, nil
+}
+
+// electionResults returns the national counts of every registered pair of the
+// election, pairs without votes included.
+func (m *Module) electionResults(ctx context.Context, electionID string) ([]*model.ElectionResult, error) {
+	pairs, err := m.electionPairRepo.GetElectionPairs(ctx, electionID)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"election_id": electionID,
+		}).ErrorWithCtx(ctx, "[CertificationUseCases.Certify] Failed to get election pairs")
+		return nil, err
+	}
+	if len(pairs) == 0 {
+		return nil, &custerr.ErrChain{
+			Message: "no election pairs registered for the given election",
+			Code:    404,
+			Type:    response2.ErrNotFound,
+		}
+	}
+
+	ids := make([]string, 0, len(pairs))
+	for _, pair := range pairs {
+		ids = append(ids, pair.ID)
+	}
+	counted, err := m.voteResultRepo.GetElectionResultsByPairs(ctx, ids, false)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"election_id": electionID,
+		}).ErrorWithCtx(ctx, "[CertificationUseCases.Certify] Failed to get election results")
+		return nil, err
+	}
+
+	byPair := make(map[string]*model.ElectionResult, len(counted))
+	for _, result := range counted {
+		byPair[result.ElectionPairID] = result
+	}
+	results := make([]*model.ElectionResult, 0, len(pairs))
+	for _, pair := range pairs {
+		result, ok := byPair[pair.ID]
+		if !ok {
+			result = &model.ElectionResult{ElectionPairID: pair.ID}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// toCertificationResponse leaves the snapshot out for public callers while
+// disclosure control is enabled, since it holds the counts unprotected.
+func (m *Module) toCertificationResponse(ctx context.Context, certification *model.Certification) *response.CertificationResponse {
+	res := &response.CertificationResponse{
+		ID:            certification.ID,
+		Scope:         certification.Scope,
+		ContentHash:   certification.ContentHash,
+		CertifiedBy:   certification.CertifiedBy,
+		CertifierName: certification.CertifierName,
+		CertifiedAt:   certification.CertifiedAt,
+	}
+	if certification.Scope == model.CertificationScopeRegion {
+		res.Region = certification.ScopeID
+	} else {
+		res.ElectionID = certification.ScopeID
+	}
+	if m.disclosure == nil || embargo.Privileged(ctx) {
+		res.Snapshot = json.RawMessage(certification.Snapshot)
+	}
+	return res
+}
@@ -0,0 +1,178 @@
+package certification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/nocturna-ta/golib/log"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
+	"github.com/nocturna-ta/result/internal/interfaces/dao"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"time"
+)
+
+// FrozenBy returns the certification freezing the tally of the election pair in
+// the region, either because the region, a region above it in the hierarchy or
+// the pair's election is certified, or nil when the tally may still change.
+func (m *Module) FrozenBy(ctx context.Context, electionPairID, region string) (*model.Certification, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "CertificationUseCases.FrozenBy")
+	defer span.End()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f, err := m.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if region != "" {
+		if certification, ok := f.regions[region]; ok {
+			return certification, nil
+		}
+		if leaf, ok := m.hierarchy.Leaf(region); ok {
+			for node, ok := leaf, true; ok; node, ok = m.hierarchy.Node(node.ParentCode) {
+				if certification, ok := f.regions[node.Code]; ok {
+					return certification, nil
+				}
+			}
+		}
+	}
+	if len(f.elections) == 0 || electionPairID == "" {
+		return nil, nil
+	}
+
+	electionID, ok := f.pairs[electionPairID]
+	if !ok {
+		pair, err := m.electionPairRepo.GetElectionPair(ctx, electionPairID)
+		if errors.Is(err, dao.ErrNoResult) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		electionID = pair.ElectionID
+		f.pairs[electionPairID] = electionID
+	}
+	return f.elections[electionID], nil
+}
+
+// load returns the certifications read last, reading them again once they are
+// older than the refresh interval. Callers hold mu.
+func (m *Module) load(ctx context.Context) (*frozen, error) {
+	if m.frozen != nil && time.Since(m.loadedAt) < m.refresh {
+		return m.frozen, nil
+	}
+
+	certifications, err := m.certificationRepo.GetCertifications(ctx)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[CertificationUseCases.FrozenBy] Failed to get certifications")
+		return nil, err
+	}
+
+	f := &frozen{
+		regions:   make(map[string]*model.Certification),
+		elections: make(map[string]*model.Certification),
+		pairs:     make(map[string]string),
+	}
+	for _, certification := range certifications {
+		switch certification.Scope {
+		case model.CertificationScopeRegion:
+			f.regions[certification.ScopeID] = certification
+		case model.CertificationScopeElection:
+			f.elections[certification.ScopeID] = certification
+		}
+	}
+
+	m.frozen = f
+	m.loadedAt = time.Now()
+	return f, nil
+}
+
+// invalidate makes the next check read the certifications again.
+func (m *Module) invalidate() {
+	m.mu.Lock()
+	m.loadedAt = time.Time{}
+	m.mu.Unlock()
+}
+
+// Quarantine stores a vote event held back from a certified tally and raises it
+// as an anomaly alert to admins.
+func (m *Module) Quarantine(ctx context.Context, quarantined *model.QuarantinedEvent) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "CertificationUseCases.Quarantine")
+	defer span.End()
+
+	quarantined.ID = uuid.NewString()
+	quarantined.QuarantinedAt = time.Now()
+
+	if err := m.certificationRepo.InsertQuarantinedEvent(ctx, quarantined); err != nil {
+		log.WithFields(log.Fields{
+			"error":            err,
+			"vote_id":          quarantined.VoteID,
+			"certification_id": quarantined.CertificationID,
+		}).ErrorWithCtx(ctx, "[CertificationUseCases.Quarantine] Failed to quarantine event")
+		return err
+	}
+	metrics.EventsQuarantined.WithLabelValues(quarantined.Topic).Inc()
+
+	if m.anomaly != nil {
+		m.anomaly.Raise(ctx, &model.Anomaly{
+			ID:             uuid.NewString(),
+			Kind:           string(model.AnomalyKindCertifiedTallyChange),
+			ElectionPairID: quarantined.ElectionPairID,
+			Region:         quarantined.Region,
+			VoteID:         quarantined.VoteID,
+			Description: fmt.Sprintf("vote event quarantined as it would change the tally certified by %s: %s",
+				quarantined.CertificationID, quarantined.Reason),
+			DetectedAt: quarantined.QuarantinedAt,
+		})
+	}
+
+	return nil
+}
+
+func (m *Module) GetQuarantinedEvents(ctx context.Context, limit, offset int) ([]*response.QuarantinedEventResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "CertificationUseCases.GetQuarantinedEvents")
+	defer span.End()
+
+	switch {
+	case limit <= 0:
+		limit = 50
+	case limit > 1000:
+		limit = 1000
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	events, err := m.certificationRepo.GetQuarantinedEvents(ctx, limit, offset)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[CertificationUseCases.GetQuarantinedEvents] Failed to get quarantined events")
+		return nil, err
+	}
+
+	responses := make([]*response.QuarantinedEventResponse, 0, len(events))
+	for _, quarantined := range events {
+		responses = append(responses, &response.QuarantinedEventResponse{
+			ID:              quarantined.ID,
+			CertificationID: quarantined.CertificationID,
+			Topic:           quarantined.Topic,
+			MessageKey:      quarantined.MessageKey,
+			VoteID:          quarantined.VoteID,
+			ElectionPairID:  quarantined.ElectionPairID,
+			Region:          quarantined.Region,
+			Reason:          quarantined.Reason,
+			Payload:         quarantined.Payload,
+			QuarantinedAt:   quarantined.QuarantinedAt,
+		})
+	}
+
+	return responses, nil
+}
@@ -0,0 +1,249 @@
+package certification
+
+import (
+	"context"
+	"errors"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/registry"
+	"github.com/nocturna-ta/result/internal/interfaces/dao"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"sync"
+	"testing"
+	"time"
+)
+
+type certificationRepo struct {
+	repository.CertificationRepository
+
+	mu             sync.Mutex
+	certifications []*model.Certification
+	reads          int
+}
+
+func (r *certificationRepo) InsertCertification(_ context.Context, certification *model.Certification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.certifications = append(r.certifications, certification)
+	return nil
+}
+
+func (r *certificationRepo) GetCertificationByScope(_ context.Context, scope, scopeID string) (*model.Certification, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, certification := range r.certifications {
+		if certification.Scope == scope && certification.ScopeID == scopeID {
+			return certification, nil
+		}
+	}
+	return nil, dao.ErrNoResult
+}
+
+func (r *certificationRepo) GetCertifications(context.Context) ([]*model.Certification, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reads++
+	return append([]*model.Certification(nil), r.certifications...), nil
+}
+
+func (r *certificationRepo) count() (certifications, reads int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.certifications), r.reads
+}
+
+type electionPairRepo struct {
+	repository.ElectionPairRepository
+	pairs map[string]*model.ElectionPair
+}
+
+func (r *electionPairRepo) GetElectionPair(_ context.Context, id string) (*model.ElectionPair, error) {
+	pair, ok := r.pairs[id]
+	if !ok {
+		return nil, dao.ErrNoResult
+	}
+	return pair, nil
+}
+
+type voteResultRepo struct {
+	repository.VoteResultRepository
+	byRegion map[string][]*model.ElectionResult
+}
+
+func (r *voteResultRepo) GetElectionResultsByRegion(_ context.Context, region string) ([]*model.ElectionResult, error) {
+	return r.byRegion[region], nil
+}
+
+func (r *voteResultRepo) GetRegionStatistics(context.Context) ([]*model.RegionResult, error) {
+	var results []*model.RegionResult
+	for region := range r.byRegion {
+		results = append(results, &model.RegionResult{Region: region})
+	}
+	return results, nil
+}
+
+func testHierarchy(t *testing.T) *registry.RegionHierarchy {
+	t.Helper()
+
+	hierarchy, err := registry.NewRegionHierarchy([]*model.RegionNode{{
+		Code: "31",
+		Children: []*model.RegionNode{{
+			Code: "31.71",
+			Children: []*model.RegionNode{{
+				Code: "31.71.01",
+				Children: []*model.RegionNode{
+					{Code: "31.71.01.001", Aliases: []string{"TPS 001 Gambir"}},
+					{Code: "31.71.01.002"},
+				},
+			}},
+		}},
+	}, {
+		Code: "32",
+		Children: []*model.RegionNode{{
+			Code: "32.73",
+			Children: []*model.RegionNode{{
+				Code:     "32.73.01",
+				Children: []*model.RegionNode{{Code: "32.73.01.001"}},
+			}},
+		}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hierarchy
+}
+
+func TestFrozenBy(t *testing.T) {
+	certifications := &certificationRepo{certifications: []*model.Certification{
+		{ID: "province", Scope: model.CertificationScopeRegion, ScopeID: "31"},
+		{ID: "station", Scope: model.CertificationScopeRegion, ScopeID: "Unmapped Station"},
+		{ID: "election", Scope: model.CertificationScopeElection, ScopeID: "presidential"},
+	}}
+	m := New(&Options{
+		CertificationRepo: certifications,
+		ElectionPairRepo: &electionPairRepo{pairs: map[string]*model.ElectionPair{
+			"pair-1": {ID: "pair-1", ElectionID: "presidential"},
+			"pair-2": {ID: "pair-2", ElectionID: "legislative"},
+		}},
+		Hierarchy: testHierarchy(t),
+		Refresh:   time.Minute,
+	})
+
+	tests := []struct {
+		name           string
+		electionPairID string
+		region         string
+		want           string
+	}{
+		{name: "certified region", electionPairID: "pair-2", region: "Unmapped Station", want: "station"},
+		{name: "certified province above the station", electionPairID: "pair-2", region: "31.71.01.002", want: "province"},
+		{name: "station alias under the province", electionPairID: "pair-2", region: " tps 001 gambir ", want: "province"},
+		{name: "certified election", electionPairID: "pair-1", region: "32.73.01.001", want: "election"},
+		{name: "uncertified", electionPairID: "pair-2", region: "32.73.01.001"},
+		{name: "unknown pair", electionPairID: "pair-3", region: "32.73.01.001"},
+		{name: "no region", electionPairID: "pair-2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			certification, err := m.FrozenBy(context.Background(), tt.electionPairID, tt.region)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got string
+			if certification != nil {
+				got = certification.ID
+			}
+			if got != tt.want {
+				t.Errorf("FrozenBy(%q, %q) = %q, want %q", tt.electionPairID, tt.region, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFrozenByCachesCertificationsUntilCertify(t *testing.T) {
+	certifications := &certificationRepo{}
+	m := New(&Options{
+		CertificationRepo: certifications,
+		ElectionPairRepo:  &electionPairRepo{},
+		VoteResultRepo: &voteResultRepo{byRegion: map[string][]*model.ElectionResult{
+			"TPS 001 Gambir": {{ElectionPairID: "pair-1", TotalVotes: 3, ConfirmedVotes: 1, FinalizingVotes: 1, PendingVotes: 1}},
+			"31.71.01.002":   {{ElectionPairID: "pair-1", TotalVotes: 4, ConfirmedVotes: 4}},
+			"32.73.01.001":   {{ElectionPairID: "pair-1", TotalVotes: 5, ConfirmedVotes: 5}},
+		}},
+		Hierarchy: testHierarchy(t),
+		Refresh:   time.Hour,
+	})
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if certification, err := m.FrozenBy(ctx, "pair-1", "31.71.01.002"); err != nil || certification != nil {
+			t.Fatalf("FrozenBy = %v, %v before certification", certification, err)
+		}
+	}
+	if _, reads := certifications.count(); reads != 1 {
+		t.Fatalf("certifications read %d times, want once", reads)
+	}
+
+	res, err := m.Certify(ctx, &request.CertificationRequest{Scope: model.CertificationScopeRegion, Region: "31.71"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certification, err := m.FrozenBy(ctx, "pair-1", "TPS 001 Gambir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if certification == nil || certification.ID != res.ID {
+		t.Fatalf("FrozenBy = %v after certifying the regency, want %s", certification, res.ID)
+	}
+
+	snapshot, err := certification.Content()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := model.CertifiedVoteCounts{TotalVotes: 7, ConfirmedVotes: 5, FinalizingVotes: 1, PendingVotes: 1}
+	if len(snapshot.Results) != 1 || snapshot.Totals != want {
+		t.Errorf("snapshot totals = %+v over %d results, want %+v over the two stations of the regency",
+			snapshot.Totals, len(snapshot.Results), want)
+	}
+}
+
+func TestCertifyConcurrentlyCertifiesOnce(t *testing.T) {
+	certifications := &certificationRepo{}
+	m := New(&Options{
+		CertificationRepo: certifications,
+		VoteResultRepo: &voteResultRepo{byRegion: map[string][]*model.ElectionResult{
+			"region-1": {{ElectionPairID: "pair-1", TotalVotes: 1, ConfirmedVotes: 1}},
+		}},
+	})
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		conflicts int
+	)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := m.Certify(context.Background(), &request.CertificationRequest{
+				Scope:  model.CertificationScopeRegion,
+				Region: "region-1",
+			})
+			var chain *custerr.ErrChain
+			if errors.As(err, &chain) && chain.Code == 409 {
+				mu.Lock()
+				conflicts++
+				mu.Unlock()
+			} else if err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if stored, _ := certifications.count(); stored != 1 || conflicts != 7 {
+		t.Errorf("%d certifications stored and %d conflicts, want 1 and 7", stored, conflicts)
+	}
+}
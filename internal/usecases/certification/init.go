@@ -0,0 +1,63 @@
+package certification
+
+import (
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/disclosure"
+	"github.com/nocturna-ta/result/internal/infrastructures/registry"
+	"github.com/nocturna-ta/result/internal/usecases"
+	"sync"
+	"time"
+)
+
+type Module struct {
+	certificationRepo repository.CertificationRepository
+	electionPairRepo  repository.ElectionPairRepository
+	voteResultRepo    repository.VoteResultRepository
+	anomaly           usecases.AnomalyUseCases
+	disclosure        *disclosure.Control
+	hierarchy         *registry.RegionHierarchy
+	refresh           time.Duration
+
+	// certifyMu serializes Certify, so a scope cannot be certified twice by
+	// concurrent requests passing the existing certification check together.
+	certifyMu sync.Mutex
+
+	mu       sync.Mutex
+	loadedAt time.Time
+	frozen   *frozen
+}
+
+// frozen is the certifications the guard checks vote events against, by the
+// region or election they certify, and the election of every pair seen.
+type frozen struct {
+	regions   map[string]*model.Certification
+	elections map[string]*model.Certification
+	pairs     map[string]string
+}
+
+type Options struct {
+	CertificationRepo repository.CertificationRepository
+	ElectionPairRepo  repository.ElectionPairRepository
+	VoteResultRepo    repository.VoteResultRepository
+	// Anomaly raises the alerts of quarantined events. It is only needed by the consumer.
+	Anomaly    usecases.AnomalyUseCases
+	Disclosure *disclosure.Control
+	// Hierarchy freezes the polling stations under a certified region and
+	// certifies a region with the votes of every polling station under it.
+	Hierarchy *registry.RegionHierarchy
+	// Refresh is how long the guard keeps certifications before reading them again.
+	Refresh time.Duration
+}
+
+func New(opts *Options) usecases.CertificationUseCases {
+	return &Module{
+		certificationRepo: opts.CertificationRepo,
+		electionPairRepo:  opts.ElectionPairRepo,
+		voteResultRepo:    opts.VoteResultRepo,
+		anomaly:           opts.Anomaly,
+		disclosure:        opts.Disclosure,
+		hierarchy:         opts.Hierarchy,
+		refresh:           opts.Refresh,
+	}
+}
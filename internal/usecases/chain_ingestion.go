@@ -0,0 +1,12 @@
+package usecases
+
+import "context"
+
+type ChainIngestionUseCases interface {
+	// Run ingests the events of the voting contract periodically until ctx is
+	// done.
+	Run(ctx context.Context)
+	// Ingest rolls back the votes of blocks replaced by a reorganization, then
+	// ingests the events of every block up to the head of the chain.
+	Ingest(ctx context.Context) error
+}
@@ -0,0 +1,255 @@
+package chain_ingestion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	event2 "github.com/nocturna-ta/common-model/models/event"
+	"github.com/nocturna-ta/golib/event"
+	"github.com/nocturna-ta/golib/log"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/infrastructures/ethereum"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
+	"github.com/nocturna-ta/result/internal/interfaces/dao"
+	"github.com/nocturna-ta/result/pkg/constants"
+	"time"
+)
+
+func (m *Module) Run(ctx context.Context) {
+	if !m.cfg.Enabled || m.source == nil {
+		return
+	}
+
+	ticker := time.NewTicker(m.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.Ingest(ctx); err != nil {
+			log.WithFields(log.Fields{
+				"error":    err,
+				"contract": m.source.Address(),
+			}).ErrorWithCtx(ctx, "[ChainIngestionUseCases.Run] Failed to ingest contract events")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *Module) Ingest(ctx context.Context) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ChainIngestionUseCases.Ingest")
+	defer span.End()
+
+	head, err := m.source.Head(ctx)
+	if err != nil {
+		return err
+	}
+
+	next, err := m.resume(ctx)
+	if err != nil {
+		return err
+	}
+
+	for next <= head {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		to := min(head, next+m.cfg.BlockRange-1)
+		if err = m.ingestRange(ctx, next, to); err != nil {
+			return err
+		}
+		next = to + 1
+	}
+
+	return nil
+}
+
+// resume returns the first block not ingested yet, after rolling back the
+// blocks a reorganization replaced.
+func (m *Module) resume(ctx context.Context) (uint64, error) {
+	checkpoint, err := m.checkpointRepo.GetLatestChainCheckpoint(ctx, m.source.Address())
+	if errors.Is(err, dao.ErrNoResult) {
+		return m.cfg.StartBlock, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	hash, err := m.canonicalHash(ctx, checkpoint.BlockNumber)
+	if err != nil {
+		return 0, err
+	}
+	if hash == checkpoint.BlockHash {
+		return checkpoint.BlockNumber + 1, nil
+	}
+
+	fork, err := m.rollback(ctx, checkpoint)
+	if err != nil {
+		return 0, err
+	}
+	return fork + 1, nil
+}
+
+// ingestRange applies the votes cast in blocks from through to and records the
+// range as ingested. Votes are applied idempotently, so a range that fails
+// halfway is read again in full.
+func (m *Module) ingestRange(ctx context.Context, from, to uint64) error {
+	last, err := m.source.Block(ctx, to)
+	if err != nil {
+		return err
+	}
+
+	casts, err := m.source.VoteCasts(ctx, from, to)
+	if err != nil {
+		return err
+	}
+
+	for _, cast := range casts {
+		if err = m.apply(ctx, cast); err != nil {
+			log.WithFields(log.Fields{
+				"error":            err,
+				"vote_id":          cast.VoteID,
+				"transaction_hash": cast.TransactionHash,
+				"block_number":     cast.Block.Number,
+			}).ErrorWithCtx(ctx, "[ChainIngestionUseCases.Ingest] Failed to apply vote")
+			return err
+		}
+	}
+
+	err = m.checkpointRepo.InsertChainCheckpoint(ctx, &model.ChainCheckpoint{
+		Contract:    m.source.Address(),
+		BlockNumber: last.Number,
+		BlockHash:   last.Hash,
+		CreatedAt:   time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	metrics.ChainIngestedBlock.Set(float64(last.Number))
+
+	log.WithFields(log.Fields{
+		"contract":   m.source.Address(),
+		"from_block": from,
+		"to_block":   to,
+		"votes":      len(casts),
+	}).DebugWithCtx(ctx, "[ChainIngestionUseCases.Ingest] Ingested blocks")
+
+	return nil
+}
+
+// apply hands a vote cast on chain to the consumer as the submit and processed
+// events Kafka would deliver for it, then records the block it was cast in.
+func (m *Module) apply(ctx context.Context, cast *ethereum.VoteCast) error {
+	existing, err := m.voteResultRepo.GetVoteResultByID(ctx, cast.VoteID)
+	if err != nil && !errors.Is(err, dao.ErrNoResult) {
+		return err
+	}
+	if existing != nil && confirmed(existing) && existing.BlockHash == cast.Block.Hash {
+		return nil
+	}
+
+	if existing == nil {
+		err = m.consume(ctx, cast.VoteID, m.consumer.ConsumeVoteSubmit, constants.Create, &event2.VoteSubmitMessage{
+			VoteID:         cast.VoteID,
+			VoterID:        cast.VoterID,
+			ElectionPairID: cast.ElectionPairID,
+			Region:         cast.Region,
+			SubmittedAt:    cast.Block.Time,
+		})
+		if err != nil {
+			return err
+		}
+
+		existing, err = m.voteResultRepo.GetVoteResultByID(ctx, cast.VoteID)
+		if errors.Is(err, dao.ErrNoResult) {
+			return m.held(ctx, cast)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	err = m.consume(ctx, cast.VoteID, m.consumer.ConsumeVoteProcessed, "", &event2.VoteProcessedMessage{
+		VoteID:          cast.VoteID,
+		VoterID:         cast.VoterID,
+		Status:          string(model.VoteStatusConfirmed),
+		TransactionHash: cast.TransactionHash,
+		ProcessedAt:     cast.Block.Time,
+	})
+	if err != nil {
+		return err
+	}
+
+	blockNumber, blockTime, checkedAt := cast.Block.Number, cast.Block.Time, time.Now()
+	existing.ChainStatus = string(model.ChainStatusVerified)
+	existing.BlockNumber = &blockNumber
+	existing.BlockHash = cast.Block.Hash
+	existing.BlockTime = &blockTime
+	existing.ChainCheckedAt = &checkedAt
+	if err = m.voteResultRepo.UpdateVoteChainStatus(ctx, existing); err != nil {
+		return err
+	}
+	metrics.ChainVotes.WithLabelValues("ingested").Inc()
+
+	return nil
+}
+
+// confirmed tells whether a vote is confirmed, final or not.
+func confirmed(vote *model.VoteResult) bool {
+	return vote.Status == string(model.VoteStatusConfirmed) || vote.Status == string(model.VoteStatusFinalizing)
+}
+
+// held tells whether a new vote the consumer did not store was quarantined,
+// in which case it is left to the quarantine, or failed to be stored.
+func (m *Module) held(ctx context.Context, cast *ethereum.VoteCast) error {
+	if m.certification != nil {
+		certification, err := m.certification.FrozenBy(ctx, cast.ElectionPairID, cast.Region)
+		if err != nil {
+			return err
+		}
+		if certification != nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("vote %s was not stored", cast.VoteID)
+}
+
+// consume passes a message to a consumer handler as if it came from Kafka. The
+// topic names the contract so quarantined events show where they came from.
+func (m *Module) consume(ctx context.Context, voteID string, handler func(context.Context, *event.EventConsumeMessage) error, operation string, message any) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	metadata := map[string]any{}
+	if operation != "" {
+		metadata[constants.MetaDataOperation] = operation
+	}
+
+	return handler(ctx, &event.EventConsumeMessage{
+		Topic:    "chain:" + m.source.Address(),
+		Key:      voteID,
+		Metadata: metadata,
+		Data:     data,
+	})
+}
+
+// canonicalHash returns the hash of the canonical block at number, or an empty
+// hash when the chain is not that long.
+func (m *Module) canonicalHash(ctx context.Context, number uint64) (string, error) {
+	block, err := m.source.Block(ctx, number)
+	if errors.Is(err, ethereum.ErrBlockNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return block.Hash, nil
+}
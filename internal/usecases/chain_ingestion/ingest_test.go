@@ -0,0 +1,472 @@
+package chain_ingestion
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/ethereum"
+	"github.com/nocturna-ta/result/internal/infrastructures/privacy"
+	"github.com/nocturna-ta/result/internal/interfaces/dao"
+	"github.com/nocturna-ta/result/internal/usecases/consumer"
+	"math/big"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+type voteResultRepo struct {
+	repository.VoteResultRepository
+
+	mu    sync.Mutex
+	votes map[string]*model.VoteResult
+}
+
+func (r *voteResultRepo) GetVoteResultByID(_ context.Context, id string) (*model.VoteResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	vote, ok := r.votes[id]
+	if !ok {
+		return nil, dao.ErrNoResult
+	}
+	copied := *vote
+	return &copied, nil
+}
+
+func (r *voteResultRepo) InsertVoteResult(_ context.Context, result *model.VoteResult) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *result
+	r.votes[result.ID] = &copied
+	return nil
+}
+
+func (r *voteResultRepo) UpdateVoteResult(_ context.Context, result *model.VoteResult) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	vote := r.votes[result.ID]
+	vote.Status = result.Status
+	vote.TransactionHash = result.TransactionHash
+	vote.ErrorMessage = result.ErrorMessage
+	vote.ProcessedAt = result.ProcessedAt
+	vote.UpdatedAt = result.UpdatedAt
+	return nil
+}
+
+func (r *voteResultRepo) UpdateVoteChainStatus(_ context.Context, result *model.VoteResult) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	vote := r.votes[result.ID]
+	vote.ChainStatus = result.ChainStatus
+	vote.BlockNumber = result.BlockNumber
+	vote.BlockHash = result.BlockHash
+	vote.BlockTime = result.BlockTime
+	vote.ChainCheckedAt = result.ChainCheckedAt
+	return nil
+}
+
+func (r *voteResultRepo) GetVotesAboveBlock(_ context.Context, block uint64) ([]*model.VoteResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var votes []*model.VoteResult
+	for _, vote := range r.votes {
+		if vote.ChainStatus != string(model.ChainStatusVerified) && vote.ChainStatus != string(model.ChainStatusFailed) {
+			continue
+		}
+		if vote.BlockNumber == nil || *vote.BlockNumber <= block {
+			continue
+		}
+		copied := *vote
+		votes = append(votes, &copied)
+	}
+	sort.Slice(votes, func(i, j int) bool { return *votes[i].BlockNumber < *votes[j].BlockNumber })
+	return votes, nil
+}
+
+func (r *voteResultRepo) vote(t *testing.T, id string) *model.VoteResult {
+	t.Helper()
+	vote, err := r.GetVoteResultByID(context.Background(), id)
+	if err != nil {
+		t.Fatalf("vote %s: %v", id, err)
+	}
+	return vote
+}
+
+type checkpointRepo struct {
+	repository.ChainCheckpointRepository
+
+	mu          sync.Mutex
+	checkpoints []*model.ChainCheckpoint
+	deletions   []uint64
+}
+
+func (r *checkpointRepo) InsertChainCheckpoint(_ context.Context, checkpoint *model.ChainCheckpoint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checkpoints = append(r.checkpoints, checkpoint)
+	return nil
+}
+
+func (r *checkpointRepo) GetLatestChainCheckpoint(context.Context, string) (*model.ChainCheckpoint, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.checkpoints) == 0 {
+		return nil, dao.ErrNoResult
+	}
+	return r.checkpoints[len(r.checkpoints)-1], nil
+}
+
+func (r *checkpointRepo) GetChainCheckpoints(_ context.Context, _ string, fromBlock, toBlock uint64) ([]*model.ChainCheckpoint, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var checkpoints []*model.ChainCheckpoint
+	for i := len(r.checkpoints) - 1; i >= 0; i-- {
+		if checkpoint := r.checkpoints[i]; checkpoint.BlockNumber >= fromBlock && checkpoint.BlockNumber <= toBlock {
+			checkpoints = append(checkpoints, checkpoint)
+		}
+	}
+	sort.SliceStable(checkpoints, func(i, j int) bool { return checkpoints[i].BlockNumber > checkpoints[j].BlockNumber })
+	return checkpoints, nil
+}
+
+func (r *checkpointRepo) DeleteChainCheckpoints(_ context.Context, _ string, block uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	kept := r.checkpoints[:0]
+	for _, checkpoint := range r.checkpoints {
+		if checkpoint.BlockNumber <= block {
+			kept = append(kept, checkpoint)
+		}
+	}
+	r.checkpoints = kept
+	r.deletions = append(r.deletions, block)
+	return nil
+}
+
+// hashes returns the block hash of every checkpoint by block number.
+func (r *checkpointRepo) hashes() map[uint64]common.Hash {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	hashes := make(map[uint64]common.Hash)
+	for _, checkpoint := range r.checkpoints {
+		hashes[checkpoint.BlockNumber] = common.HexToHash(checkpoint.BlockHash)
+	}
+	return hashes
+}
+
+// emitterCode deploys a contract that emits its calldata as the data of a
+// VoteCast event, standing in for the voting contract.
+func emitterCode() []byte {
+	topic := crypto.Keccak256Hash([]byte("VoteCast(string,string,string,string)"))
+
+	// CALLDATACOPY the calldata to memory, then LOG1 it with the topic.
+	runtime := append([]byte{0x36, 0x60, 0x00, 0x60, 0x00, 0x37, 0x7f}, topic.Bytes()...)
+	runtime = append(runtime, 0x36, 0x60, 0x00, 0xa1, 0x00)
+
+	// CODECOPY the runtime that follows the init code and RETURN it.
+	size := byte(len(runtime))
+	init := []byte{0x60, size, 0x60, 0x0c, 0x60, 0x00, 0x39, 0x60, size, 0x60, 0x00, 0xf3}
+	return append(init, runtime...)
+}
+
+// chain is a simulated chain with the emitter deployed and funded accounts
+// casting votes.
+type chain struct {
+	t        *testing.T
+	backend  *backends.SimulatedBackend
+	keys     map[string]*ecdsa.PrivateKey
+	contract common.Address
+	args     abi.Arguments
+}
+
+func newChain(t *testing.T, accounts ...string) *chain {
+	t.Helper()
+
+	stringType, err := abi.NewType("string", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &chain{
+		t:    t,
+		keys: make(map[string]*ecdsa.PrivateKey),
+		args: abi.Arguments{{Type: stringType}, {Type: stringType}, {Type: stringType}, {Type: stringType}},
+	}
+	alloc := types.GenesisAlloc{}
+	for _, account := range append(accounts, "deployer") {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.keys[account] = key
+		alloc[crypto.PubkeyToAddress(key.PublicKey)] = types.Account{Balance: big.NewInt(1e18)}
+	}
+	c.backend = backends.NewSimulatedBackend(alloc, 30_000_000)
+	t.Cleanup(func() { _ = c.backend.Close() })
+
+	c.receipt(c.send("deployer", nil, emitterCode()))
+	c.contract = crypto.CreateAddress(crypto.PubkeyToAddress(c.keys["deployer"].PublicKey), 0)
+	return c
+}
+
+// send signs and submits the first transaction of the account, and mines it.
+func (c *chain) send(account string, to *common.Address, data []byte) *types.Transaction {
+	c.t.Helper()
+	ctx := context.Background()
+
+	tip, err := c.backend.SuggestGasTipCap(ctx)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	head, err := c.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+
+	tx, err := types.SignTx(types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(1337),
+		GasTipCap: tip,
+		GasFeeCap: new(big.Int).Add(tip, new(big.Int).Mul(head.BaseFee, big.NewInt(2))),
+		Gas:       200_000,
+		To:        to,
+		Data:      data,
+	}), types.LatestSignerForChainID(big.NewInt(1337)), c.keys[account])
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	if err = c.backend.SendTransaction(ctx, tx); err != nil {
+		c.t.Fatal(err)
+	}
+	c.backend.Commit()
+	return tx
+}
+
+// cast casts a vote from the account of the same ID in a new block.
+func (c *chain) cast(voteID string) common.Hash {
+	c.t.Helper()
+	data, err := c.args.Pack(voteID, "voter-"+voteID, "pair-1", "31.71.01.001")
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	return c.receipt(c.send(voteID, &c.contract, data)).BlockHash
+}
+
+// receipt waits for the transaction to be mined on the canonical chain.
+func (c *chain) receipt(tx *types.Transaction) *types.Receipt {
+	c.t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		receipt, err := c.backend.TransactionReceipt(context.Background(), tx.Hash())
+		if err == nil {
+			return receipt
+		}
+		if time.Now().After(deadline) {
+			c.t.Fatalf("transaction %s was not mined: %v", tx.Hash().Hex(), err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// fork makes the child of parent the head and drops the transactions of the
+// replaced blocks, which the pool takes back in the background.
+func (c *chain) fork(parent common.Hash, replaced uint) {
+	c.t.Helper()
+	ctx := context.Background()
+
+	if err := c.backend.Fork(ctx, parent); err != nil {
+		c.t.Fatal(err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		pending, err := c.backend.PendingTransactionCount(ctx)
+		if err != nil {
+			c.t.Fatal(err)
+		}
+		if pending == replaced {
+			break
+		}
+		if time.Now().After(deadline) {
+			c.t.Fatalf("%d transactions back in the pool, want %d", pending, replaced)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	c.backend.Rollback()
+}
+
+func (c *chain) block(number uint64) common.Hash {
+	c.t.Helper()
+	header, err := c.backend.HeaderByNumber(context.Background(), new(big.Int).SetUint64(number))
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	return header.Hash()
+}
+
+func newIngestion(t *testing.T, c *chain, maxReorgDepth uint64) (*Module, *voteResultRepo, *checkpointRepo) {
+	t.Helper()
+
+	policy, err := privacy.New(config.PrivacyConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := ethereum.NewEventSource(c.backend, c.contract.Hex())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	votes := &voteResultRepo{votes: make(map[string]*model.VoteResult)}
+	checkpoints := &checkpointRepo{}
+	m := New(&Options{
+		Consumer:       consumer.New(&consumer.Options{ResultRepo: votes, Privacy: policy}),
+		VoteResultRepo: votes,
+		CheckpointRepo: checkpoints,
+		Source:         source,
+		// Every block is checkpointed, so a reorganization finds its fork.
+		Cfg: config.ChainIngestionConfig{Enabled: true, BlockRange: 1, MaxReorgDepth: maxReorgDepth},
+	}).(*Module)
+	return m, votes, checkpoints
+}
+
+func expectVote(t *testing.T, votes *voteResultRepo, id string, status model.VoteStatus, chainStatus model.ChainStatus, block common.Hash, number uint64) {
+	t.Helper()
+	vote := votes.vote(t, id)
+	if vote.Status != string(status) || vote.ChainStatus != string(chainStatus) {
+		t.Errorf("vote %s is %s on chain %s, want %s on chain %s", id, vote.Status, vote.ChainStatus, status, chainStatus)
+	}
+	if vote.BlockHash != block.Hex() || vote.BlockNumber == nil || *vote.BlockNumber != number {
+		t.Errorf("vote %s is in block %s, want %s at %d", id, vote.BlockHash, block.Hex(), number)
+	}
+}
+
+func expectCheckpoints(t *testing.T, c *chain, checkpoints *checkpointRepo, head uint64) {
+	t.Helper()
+	hashes := checkpoints.hashes()
+	for number := uint64(0); number <= head; number++ {
+		if want := c.block(number); hashes[number] != want {
+			t.Errorf("checkpoint of block %d is %s, want %s", number, hashes[number].Hex(), want.Hex())
+		}
+	}
+	if len(hashes) != int(head)+1 {
+		t.Errorf("%d blocks checkpointed, want %d", len(hashes), head+1)
+	}
+	latest, err := checkpoints.GetLatestChainCheckpoint(context.Background(), c.contract.Hex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if latest.BlockNumber != head || latest.BlockHash != c.block(head).Hex() {
+		t.Errorf("latest checkpoint is block %d %s, want %d %s", latest.BlockNumber, latest.BlockHash, head, c.block(head).Hex())
+	}
+}
+
+func TestIngest(t *testing.T) {
+	c := newChain(t, "vote-1", "vote-2")
+	block2 := c.cast("vote-1")
+	block3 := c.cast("vote-2")
+
+	m, votes, checkpoints := newIngestion(t, c, 64)
+	ctx := context.Background()
+	if err := m.Ingest(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	expectVote(t, votes, "vote-1", model.VoteStatusConfirmed, model.ChainStatusVerified, block2, 2)
+	expectVote(t, votes, "vote-2", model.VoteStatusConfirmed, model.ChainStatusVerified, block3, 3)
+	if vote := votes.vote(t, "vote-1"); vote.ElectionPairID != "pair-1" || vote.Region != "31.71.01.001" || vote.VoterID != "voter-vote-1" {
+		t.Errorf("vote-1 was stored as %+v", vote)
+	}
+	expectCheckpoints(t, c, checkpoints, 3)
+
+	// Ingesting again reads nothing new.
+	if err := m.Ingest(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(votes.votes) != 2 || len(checkpoints.checkpoints) != 4 {
+		t.Errorf("%d votes and %d checkpoints after ingesting again, want 2 and 4", len(votes.votes), len(checkpoints.checkpoints))
+	}
+}
+
+func TestIngestShallowReorg(t *testing.T) {
+	c := newChain(t, "vote-1", "vote-2", "vote-3")
+	block2 := c.cast("vote-1")
+	block3 := c.cast("vote-2")
+
+	m, votes, checkpoints := newIngestion(t, c, 64)
+	ctx := context.Background()
+	if err := m.Ingest(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// Block 3 is replaced by one casting another vote, and vote-2 is dropped.
+	c.fork(block2, 1)
+	newBlock3 := c.cast("vote-3")
+	c.backend.Commit()
+	if newBlock3 == block3 {
+		t.Fatal("the fork did not replace block 3")
+	}
+
+	if err := m.Ingest(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	expectVote(t, votes, "vote-1", model.VoteStatusConfirmed, model.ChainStatusVerified, block2, 2)
+	expectVote(t, votes, "vote-2", model.VoteStatusPending, model.ChainStatusReverted, block3, 3)
+	expectVote(t, votes, "vote-3", model.VoteStatusConfirmed, model.ChainStatusVerified, newBlock3, 3)
+	if vote := votes.vote(t, "vote-2"); vote.ErrorMessage != reorgErrorMessage {
+		t.Errorf("vote-2 error message = %q, want %q", vote.ErrorMessage, reorgErrorMessage)
+	}
+	if len(checkpoints.deletions) != 1 || checkpoints.deletions[0] != 2 {
+		t.Errorf("checkpoints deleted above %v, want above the fork at 2", checkpoints.deletions)
+	}
+	expectCheckpoints(t, c, checkpoints, 4)
+}
+
+func TestIngestReorgDeeperThanMaxReorgDepth(t *testing.T) {
+	c := newChain(t, "vote-2", "vote-3", "vote-4", "vote-5", "vote-6")
+	blocks := map[string]common.Hash{}
+	for _, id := range []string{"vote-2", "vote-3", "vote-4", "vote-5", "vote-6"} {
+		blocks[id] = c.cast(id)
+	}
+
+	m, votes, checkpoints := newIngestion(t, c, 2)
+	ctx := context.Background()
+	if err := m.Ingest(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// Every block above the deployment is replaced by an empty one.
+	c.fork(c.block(1), 5)
+	for i := 0; i < 6; i++ {
+		c.backend.Commit()
+	}
+
+	if err := m.Ingest(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the votes above the maximum depth below the checkpoint are rolled
+	// back; the deeper ones are left to reconciliation.
+	expectVote(t, votes, "vote-2", model.VoteStatusConfirmed, model.ChainStatusVerified, blocks["vote-2"], 2)
+	expectVote(t, votes, "vote-3", model.VoteStatusConfirmed, model.ChainStatusVerified, blocks["vote-3"], 3)
+	expectVote(t, votes, "vote-4", model.VoteStatusConfirmed, model.ChainStatusVerified, blocks["vote-4"], 4)
+	expectVote(t, votes, "vote-5", model.VoteStatusPending, model.ChainStatusReverted, blocks["vote-5"], 5)
+	expectVote(t, votes, "vote-6", model.VoteStatusPending, model.ChainStatusReverted, blocks["vote-6"], 6)
+	if len(checkpoints.deletions) != 1 || checkpoints.deletions[0] != 4 {
+		t.Errorf("checkpoints deleted above %v, want above the floor at 4", checkpoints.deletions)
+	}
+	if hash := checkpoints.hashes()[4]; hash != c.block(4) {
+		t.Errorf("checkpoint of the floor is %s, want the canonical %s", hash.Hex(), c.block(4).Hex())
+	}
+	latest, err := checkpoints.GetLatestChainCheckpoint(ctx, c.contract.Hex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if latest.BlockNumber != 7 || latest.BlockHash != c.block(7).Hex() {
+		t.Errorf("latest checkpoint is block %d, want the head at 7", latest.BlockNumber)
+	}
+}
@@ -0,0 +1,51 @@
+package chain_ingestion
+
+import (
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/ethereum"
+	"github.com/nocturna-ta/result/internal/usecases"
+	"time"
+)
+
+type Module struct {
+	consumer       usecases.Consumer
+	certification  usecases.CertificationUseCases
+	voteResultRepo repository.VoteResultRepository
+	checkpointRepo repository.ChainCheckpointRepository
+	source         *ethereum.EventSource
+	cfg            config.ChainIngestionConfig
+}
+
+type Options struct {
+	// Consumer applies the votes read from the chain, as it does those read
+	// from Kafka.
+	Consumer usecases.Consumer
+	// Certification tells votes the consumer quarantined from votes it failed
+	// to store. Every vote not stored is retried when nil.
+	Certification  usecases.CertificationUseCases
+	VoteResultRepo repository.VoteResultRepository
+	CheckpointRepo repository.ChainCheckpointRepository
+	// Source reads the contract. Ingestion does not run without it.
+	Source *ethereum.EventSource
+	Cfg    config.ChainIngestionConfig
+}
+
+func New(opts *Options) usecases.ChainIngestionUseCases {
+	cfg := opts.Cfg
+	if cfg.Interval <= 0 {
+		cfg.Interval = 15 * time.Second
+	}
+	if cfg.BlockRange == 0 {
+		cfg.BlockRange = 1000
+	}
+
+	return &Module{
+		consumer:       opts.Consumer,
+		certification:  opts.Certification,
+		voteResultRepo: opts.VoteResultRepo,
+		checkpointRepo: opts.CheckpointRepo,
+		source:         opts.Source,
+		cfg:            cfg,
+	}
+}
@@ -0,0 +1,148 @@
+package chain_ingestion
+
+import (
+	"context"
+	event2 "github.com/nocturna-ta/common-model/models/event"
+	"github.com/nocturna-ta/golib/log"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
+	"time"
+)
+
+// reorgErrorMessage is recorded on votes rolled back to pending.
+const reorgErrorMessage = "transaction dropped by a chain reorganization"
+
+// rollback handles a reorganization that replaced the block of the latest
+// checkpoint. It finds the latest checkpoint still on the canonical chain,
+// rolls back the votes of the blocks replaced above it and returns it as the
+// fork point, from which ingestion resumes.
+func (m *Module) rollback(ctx context.Context, latest *model.ChainCheckpoint) (uint64, error) {
+	metrics.ChainReorgs.Inc()
+	contract := m.source.Address()
+
+	floor := m.cfg.StartBlock
+	if latest.BlockNumber > m.cfg.MaxReorgDepth {
+		floor = max(floor, latest.BlockNumber-m.cfg.MaxReorgDepth)
+	}
+
+	checkpoints, err := m.checkpointRepo.GetChainCheckpoints(ctx, contract, floor, latest.BlockNumber)
+	if err != nil {
+		return 0, err
+	}
+
+	hashes := make(map[uint64]string)
+	canonical := func(number uint64) (string, error) {
+		if hash, ok := hashes[number]; ok {
+			return hash, nil
+		}
+		hash, err := m.canonicalHash(ctx, number)
+		if err != nil {
+			return "", err
+		}
+		hashes[number] = hash
+		return hash, nil
+	}
+
+	var fork *model.ChainCheckpoint
+	for _, checkpoint := range checkpoints {
+		hash, err := canonical(checkpoint.BlockNumber)
+		if err != nil {
+			return 0, err
+		}
+		if hash == checkpoint.BlockHash {
+			fork = checkpoint
+			break
+		}
+	}
+	if fork == nil {
+		// Deeper reorganizations are left to reconciliation.
+		hash, err := canonical(floor)
+		if err != nil {
+			return 0, err
+		}
+		fork = &model.ChainCheckpoint{Contract: contract, BlockNumber: floor, BlockHash: hash}
+		log.WithFields(log.Fields{
+			"contract":        contract,
+			"block_number":    latest.BlockNumber,
+			"max_reorg_depth": m.cfg.MaxReorgDepth,
+		}).ErrorWithCtx(ctx, "[ChainIngestionUseCases.Ingest] Reorganization deeper than the maximum depth, rolling back to it")
+	}
+
+	votes, err := m.voteResultRepo.GetVotesAboveBlock(ctx, fork.BlockNumber)
+	if err != nil {
+		return 0, err
+	}
+
+	rolledBack := 0
+	for _, vote := range votes {
+		hash, err := canonical(*vote.BlockNumber)
+		if err != nil {
+			return 0, err
+		}
+		if hash == vote.BlockHash {
+			continue
+		}
+		if err = m.revert(ctx, vote); err != nil {
+			return 0, err
+		}
+		rolledBack++
+	}
+
+	if err = m.checkpointRepo.DeleteChainCheckpoints(ctx, contract, fork.BlockNumber); err != nil {
+		return 0, err
+	}
+	err = m.checkpointRepo.InsertChainCheckpoint(ctx, &model.ChainCheckpoint{
+		Contract:    contract,
+		BlockNumber: fork.BlockNumber,
+		BlockHash:   fork.BlockHash,
+		CreatedAt:   time.Now(),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	log.WithFields(log.Fields{
+		"contract":     contract,
+		"block_number": latest.BlockNumber,
+		"fork_block":   fork.BlockNumber,
+		"rolled_back":  rolledBack,
+	}).WarnWithCtx(ctx, "[ChainIngestionUseCases.Ingest] Chain reorganized, rolled back votes of replaced blocks")
+
+	return fork.BlockNumber, nil
+}
+
+// revert rolls a vote whose block was replaced back to pending through the
+// consumer, so tallies, feeds and certification guards see it as any other
+// status change. It is confirmed again if its transaction is mined in the new
+// chain. The replaced block is kept on the vote, as reconciliation does.
+func (m *Module) revert(ctx context.Context, vote *model.VoteResult) error {
+	if confirmed(vote) {
+		err := m.consume(ctx, vote.ID, m.consumer.ConsumeVoteProcessed, "", &event2.VoteProcessedMessage{
+			VoteID:          vote.ID,
+			Status:          string(model.VoteStatusPending),
+			TransactionHash: vote.TransactionHash,
+			ErrorMessage:    reorgErrorMessage,
+			ProcessedAt:     time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	checkedAt := time.Now()
+	vote.ChainStatus = string(model.ChainStatusReverted)
+	vote.ChainCheckedAt = &checkedAt
+	if err := m.voteResultRepo.UpdateVoteChainStatus(ctx, vote); err != nil {
+		return err
+	}
+	metrics.ChainVotes.WithLabelValues("rolled_back").Inc()
+
+	log.WithFields(log.Fields{
+		"vote_id":          vote.ID,
+		"transaction_hash": vote.TransactionHash,
+		"block_number":     *vote.BlockNumber,
+		"block_hash":       vote.BlockHash,
+	}).WarnWithCtx(ctx, "[ChainIngestionUseCases.Ingest] Rolled back vote of a replaced block")
+
+	return nil
+}
@@ -9,9 +9,15 @@ import (
 	"github.com/nocturna-ta/golib/log"
 	"github.com/nocturna-ta/golib/tracing"
 	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
+	"github.com/nocturna-ta/result/internal/infrastructures/privacy"
 	"github.com/nocturna-ta/result/pkg/constants"
+	"time"
 )
 
+// statusNone is the transition source recorded for votes seen for the first time.
+const statusNone = "none"
+
 func (m *Module) ConsumeVoteProcessed(ctx context.Context, message *event.EventConsumeMessage) error {
 	span, ctx := tracing.StartSpanFromContext(ctx, "ConsumerUseCases.ConsumeVoteProcessed")
 	defer span.End()
@@ -35,6 +41,8 @@ func (m *Module) ConsumeVoteProcessed(ctx context.Context, message *event.EventC
 		return nil
 	}
 
+	defer m.votes.lock(voteMessage.VoteID)()
+
 	existingResult, err := m.resultRepo.GetVoteResultByID(ctx, voteMessage.VoteID)
 	if err != nil {
 		log.WithFields(log.Fields{
@@ -43,14 +51,25 @@ func (m *Module) ConsumeVoteProcessed(ctx context.Context, message *event.EventC
 			"vote_id":    voteMessage.VoteID,
 		}).DebugWithCtx(ctx, "[ConsumerUseCases.ConsumeVoteProcessed] Failed to get existing vote result")
 	}
+	voteMessage.Status = m.processedStatus(message, voteMessage.Status, existingResult)
 
 	var electionPairID, region string
 
 	if existingResult != nil {
+		if existingResult.Status != voteMessage.Status {
+			held, err := m.quarantine(ctx, message, voteMessage.VoteID, "vote status changed from "+existingResult.Status+" to "+voteMessage.Status,
+				existingResult.ElectionPairID, existingResult.Region)
+			if err != nil || held {
+				return err
+			}
+		}
+
+		previousStatus := existingResult.Status
 		existingResult.Status = voteMessage.Status
 		existingResult.TransactionHash = voteMessage.TransactionHash
 		existingResult.ErrorMessage = voteMessage.ErrorMessage
 		existingResult.ProcessedAt = &voteMessage.ProcessedAt
+		existingResult.UpdatedAt = time.Now()
 
 		electionPairID = existingResult.ElectionPairID
 		region = existingResult.Region
@@ -64,6 +83,11 @@ func (m *Module) ConsumeVoteProcessed(ctx context.Context, message *event.EventC
 			}).ErrorWithCtx(ctx, "[ConsumerUseCases.ConsumeVoteProcessed] Failed to update existing vote result")
 			return err
 		}
+		metrics.VoteStatusTransitions.WithLabelValues(previousStatus, voteMessage.Status).Inc()
+		if previousStatus != string(model.VoteStatusFinalizing) || voteMessage.Status != string(model.VoteStatusConfirmed) {
+			// A vote made final was already observed as processed.
+			m.observeVote(ctx, existingResult, false)
+		}
 		log.WithFields(log.Fields{
 			"request_id": requestId,
 			"vote_id":    voteMessage.VoteID,
@@ -74,6 +98,20 @@ func (m *Module) ConsumeVoteProcessed(ctx context.Context, message *event.EventC
 		electionPairID = result.ElectionPairID
 		region = result.Region
 
+		held, err := m.quarantine(ctx, message, voteMessage.VoteID, "new vote", electionPairID, region)
+		if err != nil || held {
+			return err
+		}
+
+		if err = m.pseudonymize(ctx, result); err != nil {
+			log.WithFields(log.Fields{
+				"request_id": requestId,
+				"error":      err,
+				"vote_id":    result.ID,
+			}).ErrorWithCtx(ctx, "[ConsumerUseCases.ConsumeVoteProcessed] Failed to pseudonymize voter")
+			return err
+		}
+
 		err = m.resultRepo.InsertVoteResult(ctx, result)
 		if err != nil {
 			log.WithFields(log.Fields{
@@ -83,6 +121,8 @@ func (m *Module) ConsumeVoteProcessed(ctx context.Context, message *event.EventC
 			}).ErrorWithCtx(ctx, "[ConsumerUseCases.ConsumeVoteProcessed] Failed to insert new vote result")
 			return err
 		}
+		metrics.VoteStatusTransitions.WithLabelValues(statusNone, result.Status).Inc()
+		m.observeVote(ctx, result, true)
 
 		log.WithFields(log.Fields{
 			"request_id": requestId,
@@ -91,6 +131,7 @@ func (m *Module) ConsumeVoteProcessed(ctx context.Context, message *event.EventC
 		}).InfoWithCtx(ctx, "[ConsumerUseCases.ConsumeVoteProcessed] Inserted new vote result")
 	}
 
+	m.markResultChanged(electionPairID, region)
 	m.broadcastLiveUpdates(ctx, voteMessage.VoteID, electionPairID, region)
 
 	return nil
@@ -119,6 +160,8 @@ func (m *Module) ConsumeVoteSubmit(ctx context.Context, message *event.EventCons
 		return nil
 	}
 
+	defer m.votes.lock(voteMessage.VoteID)()
+
 	operation, ok := message.Metadata[constants.MetaDataOperation].(string)
 	if !ok {
 		log.WithFields(log.Fields{
@@ -134,9 +177,9 @@ func (m *Module) ConsumeVoteSubmit(ctx context.Context, message *event.EventCons
 
 	switch operation {
 	case constants.Create:
-		electionPairID, region = m.handleVoteCreate(ctx, &voteMessage, requestId)
+		electionPairID, region = m.handleVoteCreate(ctx, message, &voteMessage, requestId)
 	case constants.Update:
-		electionPairID, region = m.handleVoteUpdate(ctx, &voteMessage, requestId)
+		electionPairID, region = m.handleVoteUpdate(ctx, message, &voteMessage, requestId)
 	default:
 		log.WithFields(log.Fields{
 			"request_id": requestId,
@@ -147,12 +190,17 @@ func (m *Module) ConsumeVoteSubmit(ctx context.Context, message *event.EventCons
 		return nil
 	}
 
+	if electionPairID == "" && region == "" {
+		return nil
+	}
+
+	m.markResultChanged(electionPairID, region)
 	m.broadcastLiveUpdates(ctx, voteMessage.VoteID, electionPairID, region)
 
 	return nil
 }
 
-func (m *Module) handleVoteCreate(ctx context.Context, voteMessage *event2.VoteSubmitMessage, requestId string) (string, string) {
+func (m *Module) handleVoteCreate(ctx context.Context, message *event.EventConsumeMessage, voteMessage *event2.VoteSubmitMessage, requestId string) (string, string) {
 	existingResult, err := m.resultRepo.GetVoteResultByID(ctx, voteMessage.VoteID)
 	if err == nil && existingResult != nil {
 		log.WithFields(log.Fields{
@@ -162,7 +210,21 @@ func (m *Module) handleVoteCreate(ctx context.Context, voteMessage *event2.VoteS
 		return existingResult.ElectionPairID, existingResult.Region
 	}
 
+	held, err := m.quarantine(ctx, message, voteMessage.VoteID, "new vote", voteMessage.ElectionPairID, voteMessage.Region)
+	if err != nil || held {
+		return "", ""
+	}
+
 	result := model.FromVoteSubmitMessage(voteMessage)
+	if err = m.pseudonymize(ctx, result); err != nil {
+		log.WithFields(log.Fields{
+			"request_id": requestId,
+			"error":      err,
+			"vote_id":    result.ID,
+		}).ErrorWithCtx(ctx, "[ConsumerUseCases.ConsumerVoteSubmit] Failed to pseudonymize voter")
+		return "", ""
+	}
+
 	err = m.resultRepo.InsertVoteResult(ctx, result)
 	if err != nil {
 		log.WithFields(log.Fields{
@@ -172,6 +234,8 @@ func (m *Module) handleVoteCreate(ctx context.Context, voteMessage *event2.VoteS
 		}).ErrorWithCtx(ctx, "[ConsumerUseCases.ConsumerVoteSubmit] Failed to insert new vote result")
 		return "", ""
 	}
+	metrics.VoteStatusTransitions.WithLabelValues(statusNone, result.Status).Inc()
+	m.observeVote(ctx, result, true)
 
 	log.WithFields(log.Fields{
 		"request_id":       requestId,
@@ -183,7 +247,7 @@ func (m *Module) handleVoteCreate(ctx context.Context, voteMessage *event2.VoteS
 	return voteMessage.ElectionPairID, voteMessage.Region
 }
 
-func (m *Module) handleVoteUpdate(ctx context.Context, voteMessage *event2.VoteSubmitMessage, requestId string) (string, string) {
+func (m *Module) handleVoteUpdate(ctx context.Context, message *event.EventConsumeMessage, voteMessage *event2.VoteSubmitMessage, requestId string) (string, string) {
 	existingResult, err := m.resultRepo.GetVoteResultByID(ctx, voteMessage.VoteID)
 	if err != nil {
 		log.WithFields(log.Fields{
@@ -201,9 +265,23 @@ func (m *Module) handleVoteUpdate(ctx context.Context, voteMessage *event2.VoteS
 		return "", ""
 	}
 
+	if existingResult.ElectionPairID != voteMessage.ElectionPairID || existingResult.Region != voteMessage.Region {
+		reason := "vote moved from " + existingResult.ElectionPairID + " in " + existingResult.Region +
+			" to " + voteMessage.ElectionPairID + " in " + voteMessage.Region
+		held, err := m.quarantine(ctx, message, voteMessage.VoteID, reason, existingResult.ElectionPairID, existingResult.Region)
+		if err != nil || held {
+			return "", ""
+		}
+		held, err = m.quarantine(ctx, message, voteMessage.VoteID, reason, voteMessage.ElectionPairID, voteMessage.Region)
+		if err != nil || held {
+			return "", ""
+		}
+	}
+
 	existingResult.ElectionPairID = voteMessage.ElectionPairID
 	existingResult.Region = voteMessage.Region
 	existingResult.VotedAt = voteMessage.SubmittedAt
+	existingResult.UpdatedAt = time.Now()
 
 	err = m.resultRepo.UpdateVoteResult(ctx, existingResult)
 	if err != nil {
@@ -222,8 +300,115 @@ func (m *Module) handleVoteUpdate(ctx context.Context, voteMessage *event2.VoteS
 	return voteMessage.ElectionPairID, voteMessage.Region
 }
 
+// quarantine holds back a vote event that would change the tally of the
+// election pair in the region when that tally is certified, instead of it
+// being applied.
+func (m *Module) quarantine(ctx context.Context, message *event.EventConsumeMessage, voteID, reason, electionPairID, region string) (bool, error) {
+	if m.certification == nil {
+		return false, nil
+	}
+
+	certification, err := m.certification.FrozenBy(ctx, electionPairID, region)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":            err,
+			"vote_id":          voteID,
+			"election_pair_id": electionPairID,
+			"region":           region,
+		}).ErrorWithCtx(ctx, "[ConsumerUseCases] Failed to check certification")
+		return false, err
+	}
+	if certification == nil {
+		return false, nil
+	}
+
+	err = m.certification.Quarantine(ctx, &model.QuarantinedEvent{
+		CertificationID: certification.ID,
+		Topic:           message.Topic,
+		MessageKey:      message.Key,
+		VoteID:          voteID,
+		ElectionPairID:  electionPairID,
+		Region:          region,
+		Reason:          reason,
+		Payload:         string(message.Data),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	log.WithFields(log.Fields{
+		"vote_id":          voteID,
+		"election_pair_id": electionPairID,
+		"region":           region,
+		"certification_id": certification.ID,
+	}).WarnWithCtx(ctx, "[ConsumerUseCases] Event would change a certified tally, quarantined")
+	return true, nil
+}
+
+// pseudonymize records the pseudonym of a new vote's voter so an admin can
+// resolve it later, and keeps only the pseudonym when voter IDs are
+// pseudonymized at ingestion.
+func (m *Module) pseudonymize(ctx context.Context, result *model.VoteResult) error {
+	if !m.privacy.Enabled() || result.VoterID == "" || privacy.IsPseudonym(result.VoterID) {
+		return nil
+	}
+
+	pseudonym := m.privacy.Pseudonym(result.VoterID)
+	err := m.pseudonymRepo.InsertVoterPseudonym(ctx, &model.VoterPseudonym{
+		Pseudonym: pseudonym,
+		VoterID:   result.VoterID,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if m.privacy.AtIngestion() {
+		result.VoterID = pseudonym
+	}
+	return nil
+}
+
+// processedStatus returns the status a processed event stores. With a
+// confirmation depth, a confirmation is held as finalizing unless the event is
+// marked final, and a vote already final stays confirmed.
+func (m *Module) processedStatus(message *event.EventConsumeMessage, status string, existing *model.VoteResult) string {
+	if status != string(model.VoteStatusConfirmed) || m.confirmationDepth == 0 {
+		return status
+	}
+	if final, _ := message.Metadata[constants.MetaDataFinal].(bool); final {
+		return status
+	}
+	if existing != nil && existing.Status == string(model.VoteStatusConfirmed) {
+		return status
+	}
+	return string(model.VoteStatusFinalizing)
+}
+
+// observeVote hands a stored vote to the anomaly detector, under its pseudonym
+// so anomalies never hold raw voter IDs when pseudonyms are enabled.
+func (m *Module) observeVote(ctx context.Context, result *model.VoteResult, isNew bool) {
+	if m.anomaly == nil {
+		return
+	}
+
+	vote := *result
+	if m.privacy.Enabled() {
+		vote.VoterID = m.privacy.Pseudonym(vote.VoterID)
+	}
+	m.anomaly.ObserveVote(ctx, &vote, isNew)
+}
+
+// markResultChanged queues the tally of the vote for the ResultUpdated feed.
+func (m *Module) markResultChanged(electionPairID, region string) {
+	if m.resultFeed == nil {
+		return
+	}
+	m.resultFeed.MarkChanged(electionPairID, region)
+}
+
 func (m *Module) broadcastLiveUpdates(ctx context.Context, voteID, electionPairID, region string) {
-	if m.liveResult == nil || m.liveResult.GetConnectedClients(ctx) == 0 {
+	if m.liveResult == nil || !m.liveResult.HasSubscribers(ctx) {
 		return
 	}
 
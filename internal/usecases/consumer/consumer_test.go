@@ -0,0 +1,47 @@
+package consumer
+
+import (
+	"github.com/nocturna-ta/golib/event"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/pkg/constants"
+	"testing"
+)
+
+func TestProcessedStatus(t *testing.T) {
+	var (
+		confirmed  = string(model.VoteStatusConfirmed)
+		finalizing = string(model.VoteStatusFinalizing)
+		final      = &event.EventConsumeMessage{Metadata: map[string]any{constants.MetaDataFinal: true}}
+		plain      = &event.EventConsumeMessage{}
+	)
+
+	tests := []struct {
+		name     string
+		depth    uint64
+		message  *event.EventConsumeMessage
+		status   string
+		existing *model.VoteResult
+		want     string
+	}{
+		{name: "no confirmation depth", message: plain, status: confirmed, want: confirmed},
+		{name: "confirmation held as finalizing", depth: 12, message: plain, status: confirmed, want: finalizing},
+		{name: "finalizing vote confirmed again", depth: 12, message: plain, status: confirmed,
+			existing: &model.VoteResult{Status: finalizing}, want: finalizing},
+		{name: "final confirmation", depth: 12, message: final, status: confirmed,
+			existing: &model.VoteResult{Status: finalizing}, want: confirmed},
+		{name: "final vote stays confirmed", depth: 12, message: plain, status: confirmed,
+			existing: &model.VoteResult{Status: confirmed}, want: confirmed},
+		{name: "other statuses pass through", depth: 12, message: plain, status: string(model.VoteStatusError),
+			existing: &model.VoteResult{Status: finalizing}, want: string(model.VoteStatusError)},
+		{name: "final mark of another type", depth: 12, status: confirmed,
+			message: &event.EventConsumeMessage{Metadata: map[string]any{constants.MetaDataFinal: "true"}}, want: finalizing},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Module{confirmationDepth: tt.depth}
+			if got := m.processedStatus(tt.message, tt.status, tt.existing); got != tt.want {
+				t.Errorf("processedStatus() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
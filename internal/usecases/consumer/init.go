@@ -3,25 +3,54 @@ package consumer
 import (
 	"github.com/nocturna-ta/result/config"
 	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/privacy"
 	"github.com/nocturna-ta/result/internal/usecases"
 )
 
 type Module struct {
-	resultRepo repository.VoteResultRepository
-	liveResult usecases.LiveResultUsecases
-	topics     config.KafkaTopics
+	resultRepo    repository.VoteResultRepository
+	pseudonymRepo repository.PseudonymRepository
+	liveResult    usecases.LiveResultUsecases
+	anomaly       usecases.AnomalyUseCases
+	resultFeed    usecases.ResultFeedUseCases
+	topics        config.KafkaTopics
+	privacy       *privacy.Policy
+	certification usecases.CertificationUseCases
+	// confirmationDepth is the number of blocks needed on top of a vote's
+	// block before its confirmation is final.
+	confirmationDepth uint64
+	votes             *voteLocks
 }
 
 type Options struct {
-	ResultRepo repository.VoteResultRepository
-	LiveResult usecases.LiveResultUsecases
-	Topics     config.KafkaTopics
+	ResultRepo    repository.VoteResultRepository
+	PseudonymRepo repository.PseudonymRepository
+	LiveResult    usecases.LiveResultUsecases
+	Anomaly       usecases.AnomalyUseCases
+	ResultFeed    usecases.ResultFeedUseCases
+	Topics        config.KafkaTopics
+	Privacy       *privacy.Policy
+	// Certification quarantines events that would change a certified tally.
+	// Every event is applied when nil.
+	Certification usecases.CertificationUseCases
+	// ConfirmationDepth holds confirmed votes as finalizing until the
+	// finality tracker marks them final. Confirmations are final on arrival
+	// when 0.
+	ConfirmationDepth uint64
 }
 
 func New(opts *Options) usecases.Consumer {
 	return &Module{
-		resultRepo: opts.ResultRepo,
-		liveResult: opts.LiveResult,
-		topics:     opts.Topics,
+		resultRepo:    opts.ResultRepo,
+		pseudonymRepo: opts.PseudonymRepo,
+		liveResult:    opts.LiveResult,
+		anomaly:       opts.Anomaly,
+		resultFeed:    opts.ResultFeed,
+		topics:        opts.Topics,
+		privacy:       opts.Privacy,
+		certification: opts.Certification,
+
+		confirmationDepth: opts.ConfirmationDepth,
+		votes:             newVoteLocks(),
 	}
 }
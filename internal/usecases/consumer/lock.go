@@ -0,0 +1,47 @@
+package consumer
+
+import "sync"
+
+// voteLocks serializes the events of each vote. Vote submit and processed
+// events arrive on separate topics, and chain ingestion and the finality
+// tracker hand over events of their own, so without it two events of one vote
+// could both read the vote before either writes it and the later write would
+// undo the earlier transition.
+type voteLocks struct {
+	mu    sync.Mutex
+	votes map[string]*voteLock
+}
+
+type voteLock struct {
+	sync.Mutex
+	waiters int
+}
+
+func newVoteLocks() *voteLocks {
+	return &voteLocks{votes: make(map[string]*voteLock)}
+}
+
+// lock holds the vote until the returned function is called. Locks are dropped
+// once no event of the vote holds or waits for them.
+func (l *voteLocks) lock(voteID string) func() {
+	l.mu.Lock()
+	vote, ok := l.votes[voteID]
+	if !ok {
+		vote = &voteLock{}
+		l.votes[voteID] = vote
+	}
+	vote.waiters++
+	l.mu.Unlock()
+
+	vote.Lock()
+	return func() {
+		vote.Unlock()
+
+		l.mu.Lock()
+		vote.waiters--
+		if vote.waiters == 0 {
+			delete(l.votes, voteID)
+		}
+		l.mu.Unlock()
+	}
+}
@@ -0,0 +1,63 @@
+package consumer
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestVoteLocksSerializeEventsOfAVote(t *testing.T) {
+	locks := newVoteLocks()
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		holding int
+		overlap bool
+	)
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer locks.lock("vote-1")()
+
+			mu.Lock()
+			holding++
+			overlap = overlap || holding > 1
+			mu.Unlock()
+
+			time.Sleep(time.Millisecond)
+
+			mu.Lock()
+			holding--
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if overlap {
+		t.Error("two events of one vote were handled at once")
+	}
+	if len(locks.votes) != 0 {
+		t.Errorf("%d vote locks left after every event was handled", len(locks.votes))
+	}
+}
+
+func TestVoteLocksLeaveOtherVotesFree(t *testing.T) {
+	locks := newVoteLocks()
+
+	unlock := locks.lock("vote-1")
+	defer unlock()
+
+	done := make(chan struct{})
+	go func() {
+		locks.lock("vote-2")()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("an event of another vote waited for vote-1")
+	}
+}
@@ -0,0 +1,23 @@
+package usecases
+
+import (
+	"context"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+)
+
+type ElectionPairUseCases interface {
+	// Registry
+	CreateElectionPair(ctx context.Context, req *request.ElectionPairRequest) (*response.ElectionPairResponse, error)
+	GetElectionPairs(ctx context.Context, electionID string) ([]*response.ElectionPairResponse, error)
+	GetElectionPair(ctx context.Context, id string) (*response.ElectionPairResponse, error)
+	UpdateElectionPair(ctx context.Context, id string, req *request.ElectionPairRequest) (*response.ElectionPairResponse, error)
+	DeleteElectionPair(ctx context.Context, id string) error
+	ImportElectionPairs(ctx context.Context, pairs []*model.ElectionPair) error
+
+	// Ranked results
+	GetNationalStandings(ctx context.Context, electionID string) (*response.StandingsResponse, error)
+	GetRegionalStandings(ctx context.Context, electionID string) ([]*response.StandingsResponse, error)
+	GetRegionStandings(ctx context.Context, electionID, region string) (*response.StandingsResponse, error)
+}
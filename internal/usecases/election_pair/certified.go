@@ -0,0 +1,56 @@
+package election_pair
+
+import (
+	"context"
+	"errors"
+	"github.com/nocturna-ta/golib/log"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/interfaces/dao"
+)
+
+// certified returns the certified snapshot of a region or an election, or nil
+// when it is not certified and live counts are ranked.
+func (m *Module) certified(ctx context.Context, scope, scopeID string) *model.CertificationSnapshot {
+	if m.certificationRepo == nil {
+		return nil
+	}
+
+	certification, err := m.certificationRepo.GetCertificationByScope(ctx, scope, scopeID)
+	if err != nil {
+		if !errors.Is(err, dao.ErrNoResult) {
+			log.WithFields(log.Fields{
+				"error":    err,
+				"scope":    scope,
+				"scope_id": scopeID,
+			}).ErrorWithCtx(ctx, "[ElectionPairUseCases.certified] Failed to get certification")
+		}
+		return nil
+	}
+
+	snapshot, err := certification.Content()
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    certification.ID,
+		}).ErrorWithCtx(ctx, "[ElectionPairUseCases.certified] Failed to decode certification snapshot")
+		return nil
+	}
+	return snapshot
+}
+
+func certifiedResults(snapshot *model.CertificationSnapshot) []*model.ElectionResult {
+	results := make([]*model.ElectionResult, 0, len(snapshot.Results))
+	for _, result := range snapshot.Results {
+		results = append(results, &model.ElectionResult{
+			ElectionPairID:  result.ElectionPairID,
+			Region:          snapshot.Region,
+			TotalVotes:      result.TotalVotes,
+			ConfirmedVotes:  result.ConfirmedVotes,
+			FinalizingVotes: result.FinalizingVotes,
+			PendingVotes:    result.PendingVotes,
+			ErrorVotes:      result.ErrorVotes,
+			LastUpdated:     snapshot.LastUpdated,
+		})
+	}
+	return results
+}
@@ -0,0 +1,40 @@
+package election_pair
+
+import (
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/disclosure"
+	"github.com/nocturna-ta/result/internal/infrastructures/electorate"
+	"github.com/nocturna-ta/result/internal/infrastructures/embargo"
+	"github.com/nocturna-ta/result/internal/usecases"
+)
+
+type Module struct {
+	electionPairRepo  repository.ElectionPairRepository
+	voteResultRepo    repository.VoteResultRepository
+	embargo           *embargo.Schedule
+	disclosure        *disclosure.Control
+	electorate        *electorate.Register
+	certificationRepo repository.CertificationRepository
+}
+
+type Options struct {
+	ElectionPairRepo repository.ElectionPairRepository
+	VoteResultRepo   repository.VoteResultRepository
+	Embargo          *embargo.Schedule
+	Disclosure       *disclosure.Control
+	Electorate       *electorate.Register
+	// CertificationRepo ranks certified elections and regions on their
+	// snapshot. Live counts are ranked when nil.
+	CertificationRepo repository.CertificationRepository
+}
+
+func New(opts *Options) usecases.ElectionPairUseCases {
+	return &Module{
+		electionPairRepo:  opts.ElectionPairRepo,
+		voteResultRepo:    opts.VoteResultRepo,
+		embargo:           opts.Embargo,
+		disclosure:        opts.Disclosure,
+		electorate:        opts.Electorate,
+		certificationRepo: opts.CertificationRepo,
+	}
+}
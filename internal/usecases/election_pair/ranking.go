@@ -0,0 +1,81 @@
+package election_pair
+
+import (
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"sort"
+)
+
+// shareScale is the number of parts the vote shares split: hundredths of a percent.
+const shareScale = 10000
+
+// rank orders the standings by votes, suppressed pairs last and ties by pair
+// number, and gives tied pairs the same rank. Shares are split between the
+// pairs whose votes are disclosed.
+func rank(standings []*response.CandidateStandingResponse) {
+	sort.SliceStable(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if a.Suppressed != b.Suppressed {
+			return !a.Suppressed
+		}
+		if a.Votes != b.Votes {
+			return a.Votes > b.Votes
+		}
+		return a.PairNumber < b.PairNumber
+	})
+
+	var votes []uint64
+	for i, standing := range standings {
+		standing.Rank = i + 1
+		if i > 0 && standing.Suppressed == standings[i-1].Suppressed && standing.Votes == standings[i-1].Votes {
+			standing.Rank = standings[i-1].Rank
+		}
+		if !standing.Suppressed {
+			votes = append(votes, standing.Votes)
+		}
+	}
+
+	for i, share := range largestRemainder(votes, shareScale) {
+		standings[i].SharePercent = float64(share) / 100
+	}
+}
+
+// largestRemainder splits seats in proportion to votes with the largest
+// remainder method, so the parts always sum to seats. Equal remainders go to the
+// larger vote count, then to the earlier entry. No votes at all yields no seats.
+func largestRemainder(votes []uint64, seats uint64) []uint64 {
+	parts := make([]uint64, len(votes))
+
+	var total uint64
+	for _, v := range votes {
+		total += v
+	}
+	if total == 0 {
+		return parts
+	}
+
+	remainders := make([]uint64, len(votes))
+	var given uint64
+	for i, v := range votes {
+		parts[i] = v * seats / total
+		remainders[i] = v * seats % total
+		given += parts[i]
+	}
+
+	order := make([]int, len(votes))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		i, j := order[a], order[b]
+		if remainders[i] != remainders[j] {
+			return remainders[i] > remainders[j]
+		}
+		return votes[i] > votes[j]
+	})
+
+	for _, i := range order[:seats-given] {
+		parts[i]++
+	}
+
+	return parts
+}
@@ -0,0 +1,108 @@
+package election_pair
+
+import (
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestLargestRemainder(t *testing.T) {
+	tests := []struct {
+		name  string
+		votes []uint64
+		want  []uint64
+	}{
+		{name: "thirds", votes: []uint64{1, 1, 1}, want: []uint64{3334, 3333, 3333}},
+		{name: "halves and quarters", votes: []uint64{2, 1, 1}, want: []uint64{5000, 2500, 2500}},
+		{name: "equal remainders to the larger count", votes: []uint64{1, 2}, want: []uint64{3333, 6667}},
+		{name: "uneven split", votes: []uint64{5, 5, 2}, want: []uint64{4167, 4167, 1666}},
+		{name: "single entry", votes: []uint64{7}, want: []uint64{10000}},
+		{name: "no votes", votes: []uint64{0, 0}, want: []uint64{0, 0}},
+		{name: "no entries", want: []uint64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := largestRemainder(tt.votes, shareScale)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("largestRemainder(%v) = %v, want %v", tt.votes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRank(t *testing.T) {
+	type standing struct {
+		pair       int
+		votes      uint64
+		suppressed bool
+	}
+	type ranked struct {
+		pair  int
+		rank  int
+		share float64
+	}
+
+	tests := []struct {
+		name      string
+		standings []standing
+		want      []ranked
+	}{
+		{
+			name:      "thirds",
+			standings: []standing{{pair: 1, votes: 1}, {pair: 2, votes: 1}, {pair: 3, votes: 1}},
+			want:      []ranked{{1, 1, 33.34}, {2, 1, 33.33}, {3, 1, 33.33}},
+		},
+		{
+			name:      "leader and tied runners-up",
+			standings: []standing{{pair: 3, votes: 1}, {pair: 1, votes: 2}, {pair: 2, votes: 1}},
+			want:      []ranked{{1, 1, 50}, {2, 2, 25}, {3, 2, 25}},
+		},
+		{
+			name:      "exact tie for the lead",
+			standings: []standing{{pair: 3, votes: 2}, {pair: 2, votes: 5}, {pair: 1, votes: 5}},
+			want:      []ranked{{1, 1, 41.67}, {2, 1, 41.67}, {3, 3, 16.66}},
+		},
+		{
+			name:      "single pair",
+			standings: []standing{{pair: 1, votes: 4}},
+			want:      []ranked{{1, 1, 100}},
+		},
+		{
+			name:      "no votes",
+			standings: []standing{{pair: 2}, {pair: 1}},
+			want:      []ranked{{1, 1, 0}, {2, 1, 0}},
+		},
+		{
+			name: "suppressed pairs last and out of the shares",
+			standings: []standing{
+				{pair: 1, suppressed: true}, {pair: 2, votes: 1}, {pair: 3, suppressed: true}, {pair: 4, votes: 3},
+			},
+			want: []ranked{{4, 1, 75}, {2, 2, 25}, {1, 3, 0}, {3, 3, 0}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			standings := make([]*response.CandidateStandingResponse, 0, len(tt.standings))
+			var votes uint64
+			for _, s := range tt.standings {
+				standings = append(standings, &response.CandidateStandingResponse{PairNumber: s.pair, Votes: s.votes, Suppressed: s.suppressed})
+				votes += s.votes
+			}
+
+			rank(standings)
+
+			var hundredths int64
+			for i, s := range standings {
+				got := ranked{s.PairNumber, s.Rank, s.SharePercent}
+				if got != tt.want[i] {
+					t.Errorf("standing %d = %+v, want %+v", i, got, tt.want[i])
+				}
+				hundredths += int64(math.Round(s.SharePercent * 100))
+			}
+			if votes > 0 && hundredths != shareScale {
+				t.Errorf("shares sum to %d hundredths of a percent, want %d", hundredths, shareScale)
+			}
+		})
+	}
+}
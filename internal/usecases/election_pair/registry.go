@@ -0,0 +1,291 @@
+package election_pair
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/golib/log"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/interfaces/dao"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"strings"
+	"time"
+)
+
+func (m *Module) CreateElectionPair(ctx context.Context, req *request.ElectionPairRequest) (*response.ElectionPairResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ElectionPairUseCases.CreateElectionPair")
+	defer span.End()
+
+	now := time.Now()
+	pair := &model.ElectionPair{
+		ID:              strings.TrimSpace(req.ID),
+		ElectionID:      strings.TrimSpace(req.ElectionID),
+		PairNumber:      req.PairNumber,
+		CandidateName:   strings.TrimSpace(req.CandidateName),
+		RunningMateName: strings.TrimSpace(req.RunningMateName),
+		Party:           strings.TrimSpace(req.Party),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	if pair.ID == "" {
+		return nil, &custerr.ErrChain{
+			Message: "id is required",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+	if err := validatePair(pair); err != nil {
+		return nil, err
+	}
+
+	_, err := m.electionPairRepo.GetElectionPair(ctx, pair.ID)
+	if err == nil {
+		return nil, &custerr.ErrChain{
+			Message: "election pair already exists",
+			Code:    409,
+			Type:    response2.ErrConflict,
+		}
+	}
+	if !errors.Is(err, dao.ErrNoResult) {
+		return nil, err
+	}
+
+	if err = m.checkPairNumber(ctx, pair); err != nil {
+		return nil, err
+	}
+
+	if err = m.electionPairRepo.InsertElectionPair(ctx, pair); err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    pair.ID,
+		}).ErrorWithCtx(ctx, "[ElectionPairUseCases.CreateElectionPair] Failed to create election pair")
+		return nil, err
+	}
+
+	return toPairResponse(pair), nil
+}
+
+func (m *Module) GetElectionPairs(ctx context.Context, electionID string) ([]*response.ElectionPairResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ElectionPairUseCases.GetElectionPairs")
+	defer span.End()
+
+	pairs, err := m.electionPairRepo.GetElectionPairs(ctx, electionID)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"election_id": electionID,
+		}).ErrorWithCtx(ctx, "[ElectionPairUseCases.GetElectionPairs] Failed to get election pairs")
+		return nil, err
+	}
+
+	responses := make([]*response.ElectionPairResponse, 0, len(pairs))
+	for _, pair := range pairs {
+		responses = append(responses, toPairResponse(pair))
+	}
+
+	return responses, nil
+}
+
+func (m *Module) GetElectionPair(ctx context.Context, id string) (*response.ElectionPairResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ElectionPairUseCases.GetElectionPair")
+	defer span.End()
+
+	pair, err := m.getPair(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPairResponse(pair), nil
+}
+
+func (m *Module) UpdateElectionPair(ctx context.Context, id string, req *request.ElectionPairRequest) (*response.ElectionPairResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ElectionPairUseCases.UpdateElectionPair")
+	defer span.End()
+
+	pair, err := m.getPair(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	pair.ElectionID = strings.TrimSpace(req.ElectionID)
+	pair.PairNumber = req.PairNumber
+	pair.CandidateName = strings.TrimSpace(req.CandidateName)
+	pair.RunningMateName = strings.TrimSpace(req.RunningMateName)
+	pair.Party = strings.TrimSpace(req.Party)
+	pair.UpdatedAt = time.Now()
+	if err = validatePair(pair); err != nil {
+		return nil, err
+	}
+	if err = m.checkPairNumber(ctx, pair); err != nil {
+		return nil, err
+	}
+
+	if err = m.electionPairRepo.UpdateElectionPair(ctx, pair); err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[ElectionPairUseCases.UpdateElectionPair] Failed to update election pair")
+		return nil, err
+	}
+
+	return toPairResponse(pair), nil
+}
+
+func (m *Module) DeleteElectionPair(ctx context.Context, id string) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ElectionPairUseCases.DeleteElectionPair")
+	defer span.End()
+
+	if _, err := m.getPair(ctx, id); err != nil {
+		return err
+	}
+
+	if err := m.electionPairRepo.DeleteElectionPair(ctx, id); err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[ElectionPairUseCases.DeleteElectionPair] Failed to delete election pair")
+		return err
+	}
+
+	return nil
+}
+
+// ImportElectionPairs inserts the pairs, replacing registered pairs with the
+// same ID. Every pair is validated before anything is written.
+func (m *Module) ImportElectionPairs(ctx context.Context, pairs []*model.ElectionPair) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ElectionPairUseCases.ImportElectionPairs")
+	defer span.End()
+
+	numbers := make(map[string]string, len(pairs))
+	for i, pair := range pairs {
+		if pair.ID == "" {
+			return fmt.Errorf("election pair %d: id is required", i)
+		}
+		if err := validatePair(pair); err != nil {
+			return fmt.Errorf("election pair %s: %w", pair.ID, err)
+		}
+		number := fmt.Sprintf("%s/%d", pair.ElectionID, pair.PairNumber)
+		if other, ok := numbers[number]; ok {
+			return fmt.Errorf("election pairs %s and %s share pair number %d", other, pair.ID, pair.PairNumber)
+		}
+		numbers[number] = pair.ID
+	}
+
+	now := time.Now()
+	for _, pair := range pairs {
+		existing, err := m.electionPairRepo.GetElectionPair(ctx, pair.ID)
+		switch {
+		case errors.Is(err, dao.ErrNoResult):
+			pair.CreatedAt = now
+			pair.UpdatedAt = now
+			err = m.electionPairRepo.InsertElectionPair(ctx, pair)
+		case err == nil:
+			pair.CreatedAt = existing.CreatedAt
+			pair.UpdatedAt = now
+			err = m.electionPairRepo.UpdateElectionPair(ctx, pair)
+		}
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error": err,
+				"id":    pair.ID,
+			}).ErrorWithCtx(ctx, "[ElectionPairUseCases.ImportElectionPairs] Failed to import election pair")
+			return err
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"count": len(pairs),
+	}).InfoWithCtx(ctx, "[ElectionPairUseCases.ImportElectionPairs] Election pairs imported")
+
+	return nil
+}
+
+func (m *Module) getPair(ctx context.Context, id string) (*model.ElectionPair, error) {
+	if id == "" {
+		return nil, &custerr.ErrChain{
+			Message: "election pair ID is required",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+
+	pair, err := m.electionPairRepo.GetElectionPair(ctx, id)
+	if err != nil {
+		if errors.Is(err, dao.ErrNoResult) {
+			return nil, &custerr.ErrChain{
+				Message: "election pair not found",
+				Code:    404,
+				Type:    response2.ErrNotFound,
+			}
+		}
+
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[ElectionPairUseCases] Failed to get election pair")
+		return nil, err
+	}
+
+	return pair, nil
+}
+
+// checkPairNumber rejects a pair number another pair of the election already has.
+func (m *Module) checkPairNumber(ctx context.Context, pair *model.ElectionPair) error {
+	pairs, err := m.electionPairRepo.GetElectionPairs(ctx, pair.ElectionID)
+	if err != nil {
+		return err
+	}
+
+	for _, other := range pairs {
+		if other.ID != pair.ID && other.PairNumber == pair.PairNumber {
+			return &custerr.ErrChain{
+				Message: fmt.Sprintf("pair number %d is already taken by election pair %s", pair.PairNumber, other.ID),
+				Code:    409,
+				Type:    response2.ErrConflict,
+			}
+		}
+	}
+
+	return nil
+}
+
+func validatePair(pair *model.ElectionPair) error {
+	switch {
+	case pair.ElectionID == "":
+		return &custerr.ErrChain{
+			Message: "election_id is required",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	case pair.PairNumber <= 0:
+		return &custerr.ErrChain{
+			Message: "pair_number must be positive",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	case pair.CandidateName == "":
+		return &custerr.ErrChain{
+			Message: "candidate_name is required",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+	return nil
+}
+
+func toPairResponse(pair *model.ElectionPair) *response.ElectionPairResponse {
+	return &response.ElectionPairResponse{
+		ID:              pair.ID,
+		ElectionID:      pair.ElectionID,
+		PairNumber:      pair.PairNumber,
+		CandidateName:   pair.CandidateName,
+		RunningMateName: pair.RunningMateName,
+		Party:           pair.Party,
+		CreatedAt:       pair.CreatedAt,
+		UpdatedAt:       pair.UpdatedAt,
+	}
+}
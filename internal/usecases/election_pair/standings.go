@@ -0,0 +1,314 @@
+package election_pair
+
+import (
+	"context"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/golib/log"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/infrastructures/electorate"
+	"github.com/nocturna-ta/result/internal/infrastructures/embargo"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"math"
+	"sort"
+	"time"
+)
+
+func (m *Module) GetNationalStandings(ctx context.Context, electionID string) (*response.StandingsResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ElectionPairUseCases.GetNationalStandings")
+	defer span.End()
+
+	pairs, err := m.electionPairs(ctx, electionID)
+	if err != nil {
+		return nil, err
+	}
+	if until, embargoed := m.embargoed(ctx, pairs, ""); embargoed {
+		return nil, embargo.Error(until)
+	}
+
+	if snapshot := m.certified(ctx, model.CertificationScopeElection, electionID); snapshot != nil {
+		res := m.standings(ctx, electionID, "", pairs, certifiedResults(snapshot))
+		res.CertificationID = snapshot.CertificationID
+		return res, nil
+	}
+
+	results, err := m.voteResultRepo.GetElectionResultsByPairs(ctx, pairIDs(pairs), false)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"election_id": electionID,
+		}).ErrorWithCtx(ctx, "[ElectionPairUseCases.GetNationalStandings] Failed to get election results")
+		return nil, err
+	}
+
+	return m.standings(ctx, electionID, "", pairs, results), nil
+}
+
+func (m *Module) GetRegionStandings(ctx context.Context, electionID, region string) (*response.StandingsResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ElectionPairUseCases.GetRegionStandings")
+	defer span.End()
+
+	if region == "" {
+		return nil, &custerr.ErrChain{
+			Message: "region is required",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+
+	pairs, err := m.electionPairs(ctx, electionID)
+	if err != nil {
+		return nil, err
+	}
+	if until, embargoed := m.embargoed(ctx, pairs, region); embargoed {
+		return nil, embargo.Error(until)
+	}
+
+	var (
+		results   []*model.ElectionResult
+		snapshot  = m.certified(ctx, model.CertificationScopeRegion, region)
+		certified string
+	)
+	if snapshot != nil {
+		results = certifiedResults(snapshot)
+		certified = snapshot.CertificationID
+	} else {
+		results, err = m.voteResultRepo.GetElectionResultsByRegion(ctx, region)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error":       err,
+				"election_id": electionID,
+				"region":      region,
+			}).ErrorWithCtx(ctx, "[ElectionPairUseCases.GetRegionStandings] Failed to get election results by region")
+			return nil, err
+		}
+	}
+
+	registered := make(map[string]bool, len(pairs))
+	for _, pair := range pairs {
+		registered[pair.ID] = true
+	}
+	var electionResults []*model.ElectionResult
+	for _, result := range results {
+		if registered[result.ElectionPairID] {
+			electionResults = append(electionResults, result)
+		}
+	}
+	if len(electionResults) == 0 {
+		return nil, &custerr.ErrChain{
+			Message: "no votes found for the election in the given region",
+			Code:    404,
+			Type:    response2.ErrNotFound,
+		}
+	}
+
+	res := m.standings(ctx, electionID, region, pairs, electionResults)
+	res.CertificationID = certified
+
+	return res, nil
+}
+
+// GetRegionalStandings returns the standings of every region with votes for the
+// election, by region. Regions still under embargo for the caller are left out.
+func (m *Module) GetRegionalStandings(ctx context.Context, electionID string) ([]*response.StandingsResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ElectionPairUseCases.GetRegionalStandings")
+	defer span.End()
+
+	pairs, err := m.electionPairs(ctx, electionID)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := m.voteResultRepo.GetElectionResultsByPairs(ctx, pairIDs(pairs), true)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"election_id": electionID,
+		}).ErrorWithCtx(ctx, "[ElectionPairUseCases.GetRegionalStandings] Failed to get election results by region")
+		return nil, err
+	}
+
+	byRegion := make(map[string][]*model.ElectionResult)
+	for _, result := range results {
+		byRegion[result.Region] = append(byRegion[result.Region], result)
+	}
+
+	var (
+		responses      []*response.StandingsResponse
+		embargoedUntil time.Time
+	)
+	for region, regionResults := range byRegion {
+		if until, embargoed := m.embargoed(ctx, pairs, region); embargoed {
+			embargoedUntil = until
+			continue
+		}
+		responses = append(responses, m.standings(ctx, electionID, region, pairs, regionResults))
+	}
+
+	if len(responses) == 0 && !embargoedUntil.IsZero() {
+		return nil, embargo.Error(embargoedUntil)
+	}
+
+	sort.Slice(responses, func(i, j int) bool {
+		return responses[i].Region < responses[j].Region
+	})
+
+	return responses, nil
+}
+
+// electionPairs returns the registered pairs of the election, or 404 when it has none.
+func (m *Module) electionPairs(ctx context.Context, electionID string) ([]*model.ElectionPair, error) {
+	if electionID == "" {
+		return nil, &custerr.ErrChain{
+			Message: "election ID is required",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+
+	pairs, err := m.electionPairRepo.GetElectionPairs(ctx, electionID)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"election_id": electionID,
+		}).ErrorWithCtx(ctx, "[ElectionPairUseCases] Failed to get election pairs")
+		return nil, err
+	}
+	if len(pairs) == 0 {
+		return nil, &custerr.ErrChain{
+			Message: "no election pairs registered for the given election",
+			Code:    404,
+			Type:    response2.ErrNotFound,
+		}
+	}
+
+	return pairs, nil
+}
+
+// embargoed reports whether the results of any pair in the region are withheld
+// from the caller. Standings are all or nothing, since ranking the released
+// pairs alone would misstate the race.
+func (m *Module) embargoed(ctx context.Context, pairs []*model.ElectionPair, region string) (time.Time, bool) {
+	if embargo.Privileged(ctx) {
+		return time.Time{}, false
+	}
+
+	var (
+		latest    time.Time
+		withheld  bool
+		checkedAt = time.Now()
+	)
+	for _, pair := range pairs {
+		if until, embargoed := m.embargo.Embargoed(pair.ID, region, checkedAt); embargoed {
+			withheld = true
+			if until.After(latest) {
+				latest = until
+			}
+		}
+	}
+	return latest, withheld
+}
+
+// standings ranks the pairs on the results of one region, or nationally when
+// region is empty. Pairs without results stand with no votes.
+func (m *Module) standings(ctx context.Context, electionID, region string, pairs []*model.ElectionPair, results []*model.ElectionResult) *response.StandingsResponse {
+	byPair := make(map[string]*model.ElectionResult, len(results))
+	for _, result := range results {
+		byPair[result.ElectionPairID] = result
+	}
+
+	res := &response.StandingsResponse{
+		ElectionID: electionID,
+		Region:     region,
+		Standings:  make([]*response.CandidateStandingResponse, 0, len(pairs)),
+	}
+
+	// Counts go through disclosure control as the election results of the
+	// region would, and withheld confirmed counts are not ranked on.
+	rows := make([]*response.ElectionVoteResultResponse, len(pairs))
+	for i, pair := range pairs {
+		row := &response.ElectionVoteResultResponse{ElectionPairID: pair.ID, Region: region}
+		if result, ok := byPair[pair.ID]; ok {
+			row.TotalVotes = result.TotalVotes
+			row.ConfirmedVotes = result.ConfirmedVotes
+			row.FinalizingVotes = result.FinalizingVotes
+			row.PendingVotes = result.PendingVotes
+			row.ErrorVotes = result.ErrorVotes
+			if result.LastUpdated.After(res.LastUpdated) {
+				res.LastUpdated = result.LastUpdated
+			}
+		}
+		rows[i] = row
+	}
+	m.disclosure.Elections(rows...)
+
+	var (
+		cast         uint64
+		castWithheld bool
+	)
+	for i, pair := range pairs {
+		cast += rows[i].TotalVotes
+		standing := &response.CandidateStandingResponse{
+			ElectionPairID:  pair.ID,
+			PairNumber:      pair.PairNumber,
+			CandidateName:   pair.CandidateName,
+			RunningMateName: pair.RunningMateName,
+			Party:           pair.Party,
+			Votes:           rows[i].ConfirmedVotes + rows[i].FinalizingVotes,
+			FinalizingVotes: rows[i].FinalizingVotes,
+		}
+		for _, field := range rows[i].Suppressed {
+			switch field {
+			case "confirmed_votes", "finalizing_votes":
+				standing.Suppressed = true
+				standing.Votes = 0
+				standing.FinalizingVotes = 0
+				res.Suppressed = true
+			case "total_votes":
+				castWithheld = true
+			}
+		}
+		res.TotalVotes += standing.Votes
+		res.Standings = append(res.Standings, standing)
+	}
+
+	rank(res.Standings)
+
+	if registered, ok := m.electorate.Registered(ctx, electionID, region); ok {
+		res.RegisteredVoters = registered
+		if !castWithheld {
+			res.TurnoutPercent = electorate.Percent(cast, registered)
+		}
+	}
+
+	if res.TotalVotes == 0 {
+		return res
+	}
+
+	leader := res.Standings[0]
+	if len(res.Standings) == 1 || res.Standings[1].Suppressed {
+		res.Winner = leader
+		res.Margin = leader.Votes
+		res.MarginPercent = leader.SharePercent
+		return res
+	}
+
+	runnerUp := res.Standings[1]
+	res.Tie = leader.Votes == runnerUp.Votes
+	res.Margin = leader.Votes - runnerUp.Votes
+	res.MarginPercent = math.Round((leader.SharePercent-runnerUp.SharePercent)*100) / 100
+	if !res.Tie {
+		res.Winner = leader
+	}
+
+	return res
+}
+
+func pairIDs(pairs []*model.ElectionPair) []string {
+	ids := make([]string, 0, len(pairs))
+	for _, pair := range pairs {
+		ids = append(ids, pair.ID)
+	}
+	return ids
+}
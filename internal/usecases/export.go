@@ -0,0 +1,22 @@
+package usecases
+
+import (
+	"context"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"io"
+)
+
+type ExportUseCases interface {
+	// Synchronous streaming export
+	PrepareExport(ctx context.Context, req *request.ExportRequest) (*response.ExportFileResponse, error)
+	StreamVoteResults(ctx context.Context, req *request.ExportRequest, w io.Writer) (uint64, error)
+
+	// Asynchronous export jobs
+	CreateExportJob(ctx context.Context, req *request.ExportRequest) (*response.ExportJobResponse, error)
+	GetExportJob(ctx context.Context, id string) (*response.ExportJobResponse, error)
+	GetExportJobFile(ctx context.Context, id string) (*response.ExportFileResponse, error)
+
+	// Management functions
+	RunJobs(ctx context.Context)
+}
@@ -0,0 +1,167 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/golib/log"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/infrastructures/export"
+	"github.com/nocturna-ta/result/internal/infrastructures/privacy"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"io"
+	"time"
+)
+
+var dateLayouts = []string{time.RFC3339, "2006-01-02"}
+
+func (m *Module) PrepareExport(ctx context.Context, req *request.ExportRequest) (*response.ExportFileResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ExportUseCases.PrepareExport")
+	defer span.End()
+
+	format, _, err := parseExportRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.ExportFileResponse{
+		FileName:    fmt.Sprintf("vote_results_%s.%s", time.Now().UTC().Format("20060102T150405Z"), format.FileExtension()),
+		ContentType: format.ContentType(),
+	}, nil
+}
+
+// StreamVoteResults encodes every vote result matching the request into w as
+// rows arrive from the database and returns the number of rows written.
+func (m *Module) StreamVoteResults(ctx context.Context, req *request.ExportRequest, w io.Writer) (uint64, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ExportUseCases.StreamVoteResults")
+	defer span.End()
+
+	format, filter, err := parseExportRequest(req)
+	if err != nil {
+		return 0, err
+	}
+
+	return m.streamVoteResults(ctx, format, filter, privacy.Audience(ctx), w, nil)
+}
+
+// streamVoteResults writes the rows redacted for the audience of the caller
+// that asked for the export.
+func (m *Module) streamVoteResults(ctx context.Context, format export.Format, filter model.VoteResultFilter, audience string, w io.Writer, progress func(rows uint64)) (uint64, error) {
+	encoder, err := export.NewEncoder(format, w)
+	if err != nil {
+		return 0, err
+	}
+
+	var rows uint64
+	err = m.voteResultRepo.StreamVoteResults(ctx, filter, func(result *model.VoteResult) error {
+		vote := toVoteResultResponse(result)
+		m.privacy.Redact(audience, vote)
+		if err := encoder.Encode(vote); err != nil {
+			return err
+		}
+
+		rows++
+		if progress != nil {
+			progress(rows)
+		}
+		return nil
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":  err,
+			"format": format,
+			"filter": filter,
+			"rows":   rows,
+		}).ErrorWithCtx(ctx, "[ExportUseCases.streamVoteResults] Failed to stream vote results")
+		return rows, err
+	}
+
+	if err = encoder.Close(); err != nil {
+		log.WithFields(log.Fields{
+			"error":  err,
+			"format": format,
+			"rows":   rows,
+		}).ErrorWithCtx(ctx, "[ExportUseCases.streamVoteResults] Failed to finalize export")
+		return rows, err
+	}
+
+	return rows, nil
+}
+
+func parseExportRequest(req *request.ExportRequest) (export.Format, model.VoteResultFilter, error) {
+	var filter model.VoteResultFilter
+
+	format, err := export.ParseFormat(req.Format)
+	if err != nil {
+		return "", filter, &custerr.ErrChain{
+			Message: "format must be one of csv, ndjson or parquet",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+
+	filter.ElectionPairID = req.ElectionPairID
+	filter.Region = req.Region
+	filter.Status = req.Status
+
+	if filter.StartDate, err = parseExportDate(req.StartDate); err != nil {
+		return "", filter, &custerr.ErrChain{
+			Message: "invalid start date format, expected YYYY-MM-DD or RFC3339",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+
+	if filter.EndDate, err = parseExportDate(req.EndDate); err != nil {
+		return "", filter, &custerr.ErrChain{
+			Message: "invalid end date format, expected YYYY-MM-DD or RFC3339",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+
+	if !filter.StartDate.IsZero() && !filter.EndDate.IsZero() && filter.StartDate.After(filter.EndDate) {
+		return "", filter, &custerr.ErrChain{
+			Message: "start date cannot be after end date",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+
+	return format, filter, nil
+}
+
+func parseExportDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	var err error
+	for _, layout := range dateLayouts {
+		var t time.Time
+		if t, err = time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, err
+}
+
+func toVoteResultResponse(result *model.VoteResult) *response.VoteResultResponse {
+	return &response.VoteResultResponse{
+		ID:              result.ID,
+		VoterID:         result.VoterID,
+		ElectionPairID:  result.ElectionPairID,
+		Region:          result.Region,
+		Status:          result.Status,
+		TransactionHash: result.TransactionHash,
+		ErrorMessage:    result.ErrorMessage,
+		VotedAt:         result.VotedAt,
+		ProcessedAt:     result.ProcessedAt,
+		CreatedAt:       result.CreatedAt,
+		UpdatedAt:       result.UpdatedAt,
+	}
+}
@@ -0,0 +1,42 @@
+package export
+
+import (
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/privacy"
+	"github.com/nocturna-ta/result/internal/usecases"
+	"sync"
+)
+
+type Module struct {
+	voteResultRepo repository.VoteResultRepository
+	cfg            config.ExportConfig
+	privacy        *privacy.Policy
+	queue          chan *job
+	mu             sync.RWMutex
+	jobs           map[string]*job
+}
+
+type Options struct {
+	VoteResultRepo repository.VoteResultRepository
+	Cfg            config.ExportConfig
+	Privacy        *privacy.Policy
+}
+
+func New(opts *Options) usecases.ExportUseCases {
+	cfg := opts.Cfg
+	if cfg.MaxConcurrentJobs <= 0 {
+		cfg.MaxConcurrentJobs = 1
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = 1
+	}
+
+	return &Module{
+		voteResultRepo: opts.VoteResultRepo,
+		cfg:            cfg,
+		privacy:        opts.Privacy,
+		queue:          make(chan *job, cfg.QueueSize),
+		jobs:           make(map[string]*job),
+	}
+}
@@ -0,0 +1,351 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/golib/log"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/infrastructures/auth"
+	"github.com/nocturna-ta/result/internal/infrastructures/custresp"
+	"github.com/nocturna-ta/result/internal/infrastructures/export"
+	"github.com/nocturna-ta/result/internal/infrastructures/privacy"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+type jobStatus string
+
+const (
+	jobStatusQueued    jobStatus = "queued"
+	jobStatusRunning   jobStatus = "running"
+	jobStatusCompleted jobStatus = "completed"
+	jobStatusFailed    jobStatus = "failed"
+
+	jobCleanupInterval = time.Minute
+)
+
+// job is an export run in the background. Its file is redacted for the
+// audience of the owner who created it, and only that owner, with that
+// audience, sees the job.
+type job struct {
+	mu          sync.RWMutex
+	id          string
+	format      export.Format
+	filter      model.VoteResultFilter
+	owner       string
+	audience    string
+	req         request.ExportRequest
+	status      jobStatus
+	totalRows   uint64
+	rowsWritten uint64
+	err         string
+	path        string
+	createdAt   time.Time
+	startedAt   *time.Time
+	completedAt *time.Time
+	expiresAt   *time.Time
+}
+
+func (m *Module) CreateExportJob(ctx context.Context, req *request.ExportRequest) (*response.ExportJobResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ExportUseCases.CreateExportJob")
+	defer span.End()
+
+	format, filter, err := parseExportRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	j := &job{
+		id:        uuid.NewString(),
+		format:    format,
+		filter:    filter,
+		owner:     auth.UserID(ctx),
+		audience:  privacy.Audience(ctx),
+		req:       *req,
+		status:    jobStatusQueued,
+		createdAt: time.Now(),
+	}
+	j.req.Format = string(format)
+
+	m.mu.Lock()
+	m.jobs[j.id] = j
+	m.mu.Unlock()
+
+	select {
+	case m.queue <- j:
+	default:
+		m.mu.Lock()
+		delete(m.jobs, j.id)
+		m.mu.Unlock()
+
+		log.WithFields(log.Fields{
+			"queue_size": m.cfg.QueueSize,
+		}).WarnWithCtx(ctx, "[ExportUseCases.CreateExportJob] Export queue is full")
+
+		return nil, &custerr.ErrChain{
+			Message: "too many export jobs in progress, try again later",
+			Code:    429,
+			Type:    custresp.ErrTooManyRequest,
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"job_id": j.id,
+		"format": format,
+		"filter": filter,
+	}).InfoWithCtx(ctx, "[ExportUseCases.CreateExportJob] Export job queued")
+
+	return j.toResponse(), nil
+}
+
+func (m *Module) GetExportJob(ctx context.Context, id string) (*response.ExportJobResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ExportUseCases.GetExportJob")
+	defer span.End()
+
+	j, err := m.getJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return j.toResponse(), nil
+}
+
+func (m *Module) GetExportJobFile(ctx context.Context, id string) (*response.ExportFileResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ExportUseCases.GetExportJobFile")
+	defer span.End()
+
+	j, err := m.getJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	if j.status != jobStatusCompleted {
+		return nil, &custerr.ErrChain{
+			Message: fmt.Sprintf("export job is %s", j.status),
+			Code:    409,
+			Type:    response2.ErrConflict,
+		}
+	}
+
+	return &response.ExportFileResponse{
+		FileName:    fmt.Sprintf("vote_results_%s.%s", j.id, j.format.FileExtension()),
+		ContentType: j.format.ContentType(),
+		Path:        j.path,
+	}, nil
+}
+
+// RunJobs starts the export workers and the expired job cleanup, and blocks
+// until ctx is cancelled.
+func (m *Module) RunJobs(ctx context.Context) {
+	if err := os.MkdirAll(m.cfg.Directory, 0o750); err != nil {
+		log.WithFields(log.Fields{
+			"error":     err,
+			"directory": m.cfg.Directory,
+		}).Error("[ExportUseCases.RunJobs] Failed to create export directory")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < m.cfg.MaxConcurrentJobs; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case j := <-m.queue:
+					m.runJob(ctx, j)
+				}
+			}
+		}()
+	}
+
+	ticker := time.NewTicker(jobCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		case <-ticker.C:
+			m.removeExpiredJobs()
+		}
+	}
+}
+
+func (m *Module) runJob(ctx context.Context, j *job) {
+	if m.cfg.JobTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, m.cfg.JobTimeout)
+		defer cancel()
+	}
+
+	span, ctx := tracing.StartSpanFromContext(ctx, "ExportUseCases.runJob")
+	defer span.End()
+
+	startedAt := time.Now()
+	j.mu.Lock()
+	j.status = jobStatusRunning
+	j.startedAt = &startedAt
+	j.mu.Unlock()
+
+	total, err := m.voteResultRepo.CountVoteResults(ctx, j.filter)
+	if err != nil {
+		m.failJob(ctx, j, err)
+		return
+	}
+
+	j.mu.Lock()
+	j.totalRows = total
+	j.mu.Unlock()
+
+	path := filepath.Join(m.cfg.Directory, fmt.Sprintf("%s.%s", j.id, j.format.FileExtension()))
+	partialPath := path + ".part"
+
+	file, err := os.Create(partialPath)
+	if err != nil {
+		m.failJob(ctx, j, err)
+		return
+	}
+
+	_, err = m.streamVoteResults(ctx, j.format, j.filter, j.audience, file, func(rows uint64) {
+		j.mu.Lock()
+		j.rowsWritten = rows
+		j.mu.Unlock()
+	})
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(partialPath, path)
+	}
+	if err != nil {
+		_ = os.Remove(partialPath)
+		m.failJob(ctx, j, err)
+		return
+	}
+
+	completedAt := time.Now()
+	expiresAt := completedAt.Add(m.cfg.JobRetention)
+	j.mu.Lock()
+	j.status = jobStatusCompleted
+	j.path = path
+	j.completedAt = &completedAt
+	j.expiresAt = &expiresAt
+	j.mu.Unlock()
+
+	log.WithFields(log.Fields{
+		"job_id":   j.id,
+		"rows":     j.rowsWritten,
+		"duration": completedAt.Sub(startedAt).String(),
+	}).InfoWithCtx(ctx, "[ExportUseCases.runJob] Export job completed")
+}
+
+func (m *Module) failJob(ctx context.Context, j *job, err error) {
+	log.WithFields(log.Fields{
+		"error":  err,
+		"job_id": j.id,
+	}).ErrorWithCtx(ctx, "[ExportUseCases.runJob] Export job failed")
+
+	completedAt := time.Now()
+	expiresAt := completedAt.Add(m.cfg.JobRetention)
+	j.mu.Lock()
+	j.status = jobStatusFailed
+	j.err = err.Error()
+	j.completedAt = &completedAt
+	j.expiresAt = &expiresAt
+	j.mu.Unlock()
+}
+
+func (m *Module) removeExpiredJobs() {
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, j := range m.jobs {
+		j.mu.RLock()
+		expired := j.expiresAt != nil && now.After(*j.expiresAt)
+		path := j.path
+		j.mu.RUnlock()
+
+		if !expired {
+			continue
+		}
+
+		if path != "" {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				log.WithFields(log.Fields{
+					"error":  err,
+					"job_id": id,
+					"path":   path,
+				}).Error("[ExportUseCases.removeExpiredJobs] Failed to remove export file")
+			}
+		}
+		delete(m.jobs, id)
+	}
+}
+
+// getJob returns the job of the caller. Jobs of other users, or created with
+// another audience, are reported as not found.
+func (m *Module) getJob(ctx context.Context, id string) (*job, error) {
+	if id == "" {
+		return nil, &custerr.ErrChain{
+			Message: "export job ID is required",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+
+	m.mu.RLock()
+	j, ok := m.jobs[id]
+	m.mu.RUnlock()
+
+	if ok && (j.owner != auth.UserID(ctx) || j.audience != privacy.Audience(ctx)) {
+		log.WithFields(log.Fields{
+			"job_id": id,
+		}).WarnWithCtx(ctx, "[ExportUseCases.getJob] Export job requested by another user")
+		ok = false
+	}
+
+	if !ok {
+		return nil, &custerr.ErrChain{
+			Message: "export job not found",
+			Code:    404,
+			Type:    response2.ErrNotFound,
+		}
+	}
+
+	return j, nil
+}
+
+func (j *job) toResponse() *response.ExportJobResponse {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	return &response.ExportJobResponse{
+		ID:          j.id,
+		Status:      string(j.status),
+		Format:      string(j.format),
+		Filter:      j.req,
+		TotalRows:   j.totalRows,
+		RowsWritten: j.rowsWritten,
+		Error:       j.err,
+		CreatedAt:   j.createdAt,
+		StartedAt:   j.startedAt,
+		CompletedAt: j.completedAt,
+		ExpiresAt:   j.expiresAt,
+	}
+}
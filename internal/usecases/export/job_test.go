@@ -0,0 +1,54 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	libCtx "github.com/nocturna-ta/golib/context"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"github.com/nocturna-ta/result/pkg/constants"
+	"testing"
+)
+
+func userContext(userID, role string) context.Context {
+	return context.WithValue(context.Background(), libCtx.RequestContextKey, libCtx.RequestContext{UserId: userID, Role: role})
+}
+
+func TestExportJobIsSeenByItsOwnerOnly(t *testing.T) {
+	m := New(&Options{Cfg: config.ExportConfig{QueueSize: 1}})
+
+	admin := uuid.NewString()
+	job, err := m.CreateExportJob(userContext(admin, constants.RoleAdmin), &request.ExportRequest{Format: "csv"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		found bool
+	}{
+		{name: "owner", ctx: userContext(admin, constants.RoleAdmin), found: true},
+		{name: "owner with a lower role", ctx: userContext(admin, constants.RoleObserver)},
+		{name: "another admin", ctx: userContext(uuid.NewString(), constants.RoleAdmin)},
+		{name: "observer", ctx: userContext(uuid.NewString(), constants.RoleObserver)},
+		{name: "anonymous", ctx: context.Background()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := m.GetExportJob(tt.ctx, job.ID)
+			// The job is still queued, so its owner gets a conflict on download.
+			_, fileErr := m.GetExportJobFile(tt.ctx, job.ID)
+
+			for _, err := range []error{err, fileErr} {
+				var chain *custerr.ErrChain
+				notFound := errors.As(err, &chain) && chain.Code == 404
+				if notFound == tt.found {
+					t.Errorf("err = %v, want found %t", err, tt.found)
+				}
+			}
+		})
+	}
+}
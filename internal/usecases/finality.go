@@ -0,0 +1,11 @@
+package usecases
+
+import "context"
+
+type FinalityUseCases interface {
+	// Run finalizes votes periodically until ctx is done.
+	Run(ctx context.Context)
+	// Finalize checks one batch of finalizing votes on chain and makes final
+	// those whose block is at least the confirmation depth below the head.
+	Finalize(ctx context.Context) error
+}
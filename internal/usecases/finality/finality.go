@@ -0,0 +1,189 @@
+package finality
+
+import (
+	"context"
+	"encoding/json"
+	event2 "github.com/nocturna-ta/common-model/models/event"
+	"github.com/nocturna-ta/golib/event"
+	"github.com/nocturna-ta/golib/log"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
+	"github.com/nocturna-ta/result/pkg/constants"
+	"time"
+)
+
+// finalityTopic is the topic recorded on final confirmations, so quarantined
+// ones show where they came from.
+const finalityTopic = "finality"
+
+func (m *Module) Run(ctx context.Context) {
+	if m.cfg.ConfirmationDepth == 0 || m.verifier == nil {
+		return
+	}
+
+	ticker := time.NewTicker(m.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.Finalize(ctx); err != nil {
+			log.WithFields(log.Fields{
+				"error": err,
+			}).ErrorWithCtx(ctx, "[FinalityUseCases.Run] Failed to finalize votes")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *Module) Finalize(ctx context.Context) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "FinalityUseCases.Finalize")
+	defer span.End()
+
+	head, err := m.verifier.Head(ctx)
+	if err != nil {
+		return err
+	}
+
+	votes, err := m.voteResultRepo.GetVotesToFinalize(ctx, m.cfg.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, vote := range votes {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		m.finalize(ctx, vote, head)
+	}
+
+	return nil
+}
+
+// finalize checks the transaction of one finalizing vote, records its chain
+// status and makes the vote final once its block is deep enough. A vote whose
+// transaction is not verified stays finalizing and is checked again, as it may
+// still be mined in another block, and so does a vote of a certified tally.
+// Votes whose check fails are retried on the next batch.
+func (m *Module) finalize(ctx context.Context, vote *model.VoteResult, head uint64) {
+	verification, err := m.verifier.Verify(ctx, vote.TransactionHash, vote.BlockHash)
+	if err != nil {
+		metrics.VotesFinalized.WithLabelValues("error").Inc()
+		log.WithFields(log.Fields{
+			"error":            err,
+			"vote_id":          vote.ID,
+			"transaction_hash": vote.TransactionHash,
+		}).ErrorWithCtx(ctx, "[FinalityUseCases.Finalize] Failed to verify transaction")
+		return
+	}
+
+	previous := vote.ChainStatus
+	verification.Record(vote, time.Now())
+	if err = m.voteResultRepo.UpdateVoteChainStatus(ctx, vote); err != nil {
+		metrics.VotesFinalized.WithLabelValues("error").Inc()
+		log.WithFields(log.Fields{
+			"error":   err,
+			"vote_id": vote.ID,
+		}).ErrorWithCtx(ctx, "[FinalityUseCases.Finalize] Failed to update vote chain status")
+		return
+	}
+
+	if verification.Status != model.ChainStatusVerified {
+		metrics.VotesFinalized.WithLabelValues("held").Inc()
+		if previous != vote.ChainStatus {
+			log.WithFields(log.Fields{
+				"vote_id":          vote.ID,
+				"transaction_hash": vote.TransactionHash,
+				"chain_status":     vote.ChainStatus,
+				"previous_status":  previous,
+			}).WarnWithCtx(ctx, "[FinalityUseCases.Finalize] Finalizing vote is not backed on chain")
+		}
+		return
+	}
+
+	if head < verification.BlockNumber+m.cfg.ConfirmationDepth {
+		metrics.VotesFinalized.WithLabelValues("pending").Inc()
+		return
+	}
+
+	frozen, err := m.frozen(ctx, vote)
+	if err != nil {
+		metrics.VotesFinalized.WithLabelValues("error").Inc()
+		log.WithFields(log.Fields{
+			"error":   err,
+			"vote_id": vote.ID,
+		}).ErrorWithCtx(ctx, "[FinalityUseCases.Finalize] Failed to check certification")
+		return
+	}
+	if frozen {
+		metrics.VotesFinalized.WithLabelValues("frozen").Inc()
+		return
+	}
+
+	if err = m.confirm(ctx, vote); err != nil {
+		metrics.VotesFinalized.WithLabelValues("error").Inc()
+		log.WithFields(log.Fields{
+			"error":   err,
+			"vote_id": vote.ID,
+		}).ErrorWithCtx(ctx, "[FinalityUseCases.Finalize] Failed to make vote final")
+		return
+	}
+	metrics.VotesFinalized.WithLabelValues("final").Inc()
+
+	log.WithFields(log.Fields{
+		"vote_id":      vote.ID,
+		"block_number": verification.BlockNumber,
+		"head":         head,
+	}).DebugWithCtx(ctx, "[FinalityUseCases.Finalize] Vote is final")
+}
+
+// frozen reports whether the tally of the vote is certified. Such a vote stays
+// finalizing as certified; its chain check is still recorded, which moves it
+// behind the other finalizing votes.
+func (m *Module) frozen(ctx context.Context, vote *model.VoteResult) (bool, error) {
+	if m.certification == nil {
+		return false, nil
+	}
+
+	certification, err := m.certification.FrozenBy(ctx, vote.ElectionPairID, vote.Region)
+	if err != nil {
+		return false, err
+	}
+	if certification != nil {
+		log.WithFields(log.Fields{
+			"vote_id":          vote.ID,
+			"certification_id": certification.ID,
+		}).DebugWithCtx(ctx, "[FinalityUseCases.Finalize] Vote of a certified tally stays finalizing")
+	}
+	return certification != nil, nil
+}
+
+// confirm hands the consumer a processed event marked final for the vote.
+func (m *Module) confirm(ctx context.Context, vote *model.VoteResult) error {
+	processedAt := time.Now()
+	if vote.ProcessedAt != nil {
+		processedAt = *vote.ProcessedAt
+	}
+
+	data, err := json.Marshal(&event2.VoteProcessedMessage{
+		VoteID:          vote.ID,
+		Status:          string(model.VoteStatusConfirmed),
+		TransactionHash: vote.TransactionHash,
+		ErrorMessage:    vote.ErrorMessage,
+		ProcessedAt:     processedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.consumer.ConsumeVoteProcessed(ctx, &event.EventConsumeMessage{
+		Topic:    finalityTopic,
+		Key:      vote.ID,
+		Metadata: map[string]any{constants.MetaDataFinal: true},
+		Data:     data,
+	})
+}
@@ -0,0 +1,285 @@
+package finality
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/ethereum"
+	"github.com/nocturna-ta/result/internal/infrastructures/privacy"
+	"github.com/nocturna-ta/result/internal/interfaces/dao"
+	"github.com/nocturna-ta/result/internal/usecases"
+	"github.com/nocturna-ta/result/internal/usecases/consumer"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type voteResultRepo struct {
+	repository.VoteResultRepository
+
+	mu    sync.Mutex
+	votes map[string]*model.VoteResult
+}
+
+func (r *voteResultRepo) GetVoteResultByID(_ context.Context, id string) (*model.VoteResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	vote, ok := r.votes[id]
+	if !ok {
+		return nil, dao.ErrNoResult
+	}
+	copied := *vote
+	return &copied, nil
+}
+
+func (r *voteResultRepo) UpdateVoteResult(_ context.Context, result *model.VoteResult) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	vote := r.votes[result.ID]
+	vote.Status = result.Status
+	vote.TransactionHash = result.TransactionHash
+	vote.ErrorMessage = result.ErrorMessage
+	vote.ProcessedAt = result.ProcessedAt
+	vote.UpdatedAt = result.UpdatedAt
+	return nil
+}
+
+func (r *voteResultRepo) UpdateVoteChainStatus(_ context.Context, result *model.VoteResult) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	vote := r.votes[result.ID]
+	vote.ChainStatus = result.ChainStatus
+	vote.BlockNumber = result.BlockNumber
+	vote.BlockHash = result.BlockHash
+	vote.BlockTime = result.BlockTime
+	vote.ChainCheckedAt = result.ChainCheckedAt
+	return nil
+}
+
+func (r *voteResultRepo) GetVotesToFinalize(_ context.Context, limit int) ([]*model.VoteResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var votes []*model.VoteResult
+	for _, vote := range r.votes {
+		if vote.Status == string(model.VoteStatusFinalizing) && len(votes) < limit {
+			copied := *vote
+			votes = append(votes, &copied)
+		}
+	}
+	return votes, nil
+}
+
+func (r *voteResultRepo) vote(id string) *model.VoteResult {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *r.votes[id]
+	return &copied
+}
+
+// certificationUc freezes the tallies of the certified region.
+type certificationUc struct {
+	usecases.CertificationUseCases
+	region string
+}
+
+func (c *certificationUc) FrozenBy(_ context.Context, _, region string) (*model.Certification, error) {
+	if region != c.region {
+		return nil, nil
+	}
+	return &model.Certification{ID: "certification-1", Scope: model.CertificationScopeRegion, ScopeID: region}, nil
+}
+
+// chain is a simulated chain with funded accounts sending the transactions of
+// votes.
+type chain struct {
+	t       *testing.T
+	backend *backends.SimulatedBackend
+	keys    map[string]*ecdsa.PrivateKey
+}
+
+func newChain(t *testing.T, accounts ...string) *chain {
+	t.Helper()
+
+	c := &chain{t: t, keys: make(map[string]*ecdsa.PrivateKey)}
+	alloc := types.GenesisAlloc{}
+	for _, account := range accounts {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.keys[account] = key
+		alloc[crypto.PubkeyToAddress(key.PublicKey)] = types.Account{Balance: big.NewInt(1e18)}
+	}
+	c.backend = backends.NewSimulatedBackend(alloc, 30_000_000)
+	t.Cleanup(func() { _ = c.backend.Close() })
+	return c
+}
+
+// transfer signs and submits the first transaction of the account. The fees
+// are those suggested times priceFactor, so a transfer can replace another.
+func (c *chain) transfer(account string, priceFactor int64) *types.Transaction {
+	c.t.Helper()
+	ctx := context.Background()
+
+	tip, err := c.backend.SuggestGasTipCap(ctx)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	head, err := c.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	feeCap := new(big.Int).Add(tip, new(big.Int).Mul(head.BaseFee, big.NewInt(2)))
+	tip.Mul(tip, big.NewInt(priceFactor))
+	feeCap.Mul(feeCap, big.NewInt(priceFactor))
+
+	to := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	tx, err := types.SignTx(types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(1337),
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		Gas:       21_000,
+		To:        &to,
+		Value:     big.NewInt(1),
+	}), types.LatestSignerForChainID(big.NewInt(1337)), c.keys[account])
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	c.resend(tx)
+	return tx
+}
+
+// resend submits a transaction, again after a reorg. The pool catches up with
+// a new head in the background, so a nonce it still counts as used is retried.
+func (c *chain) resend(tx *types.Transaction) {
+	c.t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		err := c.backend.SendTransaction(context.Background(), tx)
+		if err == nil || err.Error() == "already known" {
+			return
+		}
+		if !strings.Contains(err.Error(), "nonce too low") || time.Now().After(deadline) {
+			c.t.Fatal(err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// receipt waits for the transaction to be mined on the canonical chain.
+func (c *chain) receipt(tx *types.Transaction) *types.Receipt {
+	c.t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		receipt, err := c.backend.TransactionReceipt(context.Background(), tx.Hash())
+		if err == nil {
+			return receipt
+		}
+		if time.Now().After(deadline) {
+			c.t.Fatalf("transaction %s was not mined: %v", tx.Hash().Hex(), err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestFinalizeAgainstSimulatedChain(t *testing.T) {
+	c := newChain(t, "deep", "certified", "shallow", "dropped")
+
+	deep := c.transfer("deep", 1)
+	certified := c.transfer("certified", 1)
+	block1 := c.backend.Commit()
+	c.receipt(deep)
+	c.receipt(certified)
+	block2 := c.backend.Commit()
+
+	shallow := c.transfer("shallow", 1)
+	dropped := c.transfer("dropped", 1)
+	block3 := c.backend.Commit()
+	c.receipt(shallow)
+	c.receipt(dropped)
+	c.backend.Commit()
+
+	finalizing := func(id, region string, tx *types.Transaction) *model.VoteResult {
+		return &model.VoteResult{ID: id, Region: region, Status: string(model.VoteStatusFinalizing), TransactionHash: tx.Hash().Hex()}
+	}
+	repo := &voteResultRepo{votes: map[string]*model.VoteResult{
+		"deep":      finalizing("deep", "region-1", deep),
+		"certified": finalizing("certified", "region-2", certified),
+		"shallow":   finalizing("shallow", "region-1", shallow),
+		"dropped":   finalizing("dropped", "region-1", dropped),
+	}}
+
+	policy, err := privacy.New(config.PrivacyConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := config.FinalityConfig{ConfirmationDepth: 3}
+	m := New(&Options{
+		Consumer:       consumer.New(&consumer.Options{ResultRepo: repo, Privacy: policy, ConfirmationDepth: cfg.ConfirmationDepth}),
+		VoteResultRepo: repo,
+		Verifier:       ethereum.NewVerifier(c.backend),
+		Certification:  &certificationUc{region: "region-2"},
+		Cfg:            cfg,
+	})
+	ctx := context.Background()
+
+	expect := func(id string, status model.VoteStatus, chainStatus model.ChainStatus, blockHash common.Hash, blockNumber uint64) {
+		t.Helper()
+		vote := repo.vote(id)
+		if vote.Status != string(status) || vote.ChainStatus != string(chainStatus) {
+			t.Errorf("vote %s is %s on chain %s, want %s on chain %s", id, vote.Status, vote.ChainStatus, status, chainStatus)
+		}
+		if vote.BlockHash != blockHash.Hex() || vote.BlockNumber == nil || *vote.BlockNumber != blockNumber {
+			t.Errorf("vote %s is in block %s, want %s at %d", id, vote.BlockHash, blockHash.Hex(), blockNumber)
+		}
+	}
+
+	// At head 4, only the transactions of block 1 are 3 blocks deep.
+	if err = m.Finalize(ctx); err != nil {
+		t.Fatal(err)
+	}
+	expect("deep", model.VoteStatusConfirmed, model.ChainStatusVerified, block1, 1)
+	expect("certified", model.VoteStatusFinalizing, model.ChainStatusVerified, block1, 1)
+	expect("shallow", model.VoteStatusFinalizing, model.ChainStatusVerified, block3, 3)
+	expect("dropped", model.VoteStatusFinalizing, model.ChainStatusVerified, block3, 3)
+
+	// Reorg block 3 away: the shallow transaction is mined again in the new
+	// branch, while the dropped one is replaced by another transaction of the
+	// same nonce and never comes back.
+	if err = c.backend.Fork(ctx, block2); err != nil {
+		t.Fatal(err)
+	}
+	c.resend(shallow)
+	c.transfer("dropped", 10)
+	newBlock3 := c.backend.Commit()
+	c.backend.Commit()
+	c.backend.Commit()
+	c.receipt(shallow)
+	if newBlock3 == block3 {
+		t.Fatal("the fork did not replace block 3")
+	}
+
+	// At head 5, the shallow transaction is 2 blocks deep in its new block.
+	if err = m.Finalize(ctx); err != nil {
+		t.Fatal(err)
+	}
+	expect("certified", model.VoteStatusFinalizing, model.ChainStatusVerified, block1, 1)
+	expect("shallow", model.VoteStatusFinalizing, model.ChainStatusVerified, newBlock3, 3)
+	expect("dropped", model.VoteStatusFinalizing, model.ChainStatusReverted, block3, 3)
+
+	c.backend.Commit()
+	if err = m.Finalize(ctx); err != nil {
+		t.Fatal(err)
+	}
+	expect("shallow", model.VoteStatusConfirmed, model.ChainStatusVerified, newBlock3, 3)
+	expect("dropped", model.VoteStatusFinalizing, model.ChainStatusReverted, block3, 3)
+	expect("certified", model.VoteStatusFinalizing, model.ChainStatusVerified, block1, 1)
+}
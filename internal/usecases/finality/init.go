@@ -0,0 +1,48 @@
+package finality
+
+import (
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/ethereum"
+	"github.com/nocturna-ta/result/internal/usecases"
+	"time"
+)
+
+type Module struct {
+	consumer       usecases.Consumer
+	voteResultRepo repository.VoteResultRepository
+	verifier       *ethereum.Verifier
+	certification  usecases.CertificationUseCases
+	cfg            config.FinalityConfig
+}
+
+type Options struct {
+	// Consumer applies the final confirmations, so tallies, feeds and
+	// certification guards see them as any other status change.
+	Consumer       usecases.Consumer
+	VoteResultRepo repository.VoteResultRepository
+	// Verifier reads the chain. Votes are not finalized without it.
+	Verifier *ethereum.Verifier
+	// Certification keeps votes of certified tallies finalizing, rather than
+	// handing the consumer a confirmation it would quarantine on every batch.
+	Certification usecases.CertificationUseCases
+	Cfg           config.FinalityConfig
+}
+
+func New(opts *Options) usecases.FinalityUseCases {
+	cfg := opts.Cfg
+	if cfg.Interval <= 0 {
+		cfg.Interval = 15 * time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 500
+	}
+
+	return &Module{
+		consumer:       opts.Consumer,
+		voteResultRepo: opts.VoteResultRepo,
+		verifier:       opts.Verifier,
+		certification:  opts.Certification,
+		cfg:            cfg,
+	}
+}
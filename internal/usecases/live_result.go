@@ -17,5 +17,8 @@ type LiveResultUsecases interface {
 
 	// Management functions
 	GetConnectedClients(ctx context.Context) int
+	HasSubscribers(ctx context.Context) bool
 	StartPeriodicBroadcast(ctx context.Context, interval time.Duration)
+	LastPeriodicBroadcast(ctx context.Context) time.Time
+	StartEmbargoWatcher(ctx context.Context)
 }
@@ -2,23 +2,38 @@ package live_result
 
 import (
 	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/disclosure"
+	"github.com/nocturna-ta/result/internal/infrastructures/electorate"
+	"github.com/nocturna-ta/result/internal/infrastructures/embargo"
 	"github.com/nocturna-ta/result/internal/infrastructures/websocket"
 	"github.com/nocturna-ta/result/internal/usecases"
+	"sync/atomic"
 )
 
 type Module struct {
 	voteResultRepo repository.VoteResultRepository
 	hub            *websocket.Hub
+	embargo        *embargo.Schedule
+	disclosure     *disclosure.Control
+	electorate     *electorate.Register
+
+	lastBroadcastTick atomic.Int64
 }
 
 type Options struct {
 	VoteResultRepo repository.VoteResultRepository
 	Hub            *websocket.Hub
+	Embargo        *embargo.Schedule
+	Disclosure     *disclosure.Control
+	Electorate     *electorate.Register
 }
 
 func New(opts *Options) usecases.LiveResultUsecases {
 	return &Module{
 		voteResultRepo: opts.VoteResultRepo,
 		hub:            opts.Hub,
+		embargo:        opts.Embargo,
+		disclosure:     opts.Disclosure,
+		electorate:     opts.Electorate,
 	}
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"github.com/nocturna-ta/golib/log"
 	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
+	"github.com/nocturna-ta/result/internal/infrastructures/websocket"
 	"github.com/nocturna-ta/result/internal/usecases/response"
 	"time"
 )
@@ -35,7 +37,10 @@ func (m *Module) BroadcastVoteUpdate(ctx context.Context, voteID string) error {
 		UpdatedAt:       voteResult.UpdatedAt,
 	}
 
-	m.hub.BroadcastVoteUpdate(voteResponse)
+	m.hub.BroadcastVoteUpdate(voteResponse, m.restricted(voteResult.ElectionPairID, voteResult.Region))
+	if !voteResult.VotedAt.IsZero() {
+		metrics.VoteBroadcastLatency.Observe(time.Since(voteResult.VotedAt).Seconds())
+	}
 
 	log.WithFields(log.Fields{
 		"vote_id":          voteID,
@@ -62,21 +67,24 @@ func (m *Module) BroadcastElectionUpdate(ctx context.Context, electionPairID str
 	}
 
 	electionResponse := &response.ElectionVoteResultResponse{
-		ElectionPairID: electionResult.ElectionPairID,
-		Region:         electionResult.Region,
-		TotalVotes:     electionResult.TotalVotes,
-		ConfirmedVotes: electionResult.ConfirmedVotes,
-		PendingVotes:   electionResult.PendingVotes,
-		ErrorVotes:     electionResult.ErrorVotes,
-		LastUpdated:    electionResult.LastUpdated,
+		ElectionPairID:  electionResult.ElectionPairID,
+		Region:          electionResult.Region,
+		TotalVotes:      electionResult.TotalVotes,
+		ConfirmedVotes:  electionResult.ConfirmedVotes,
+		FinalizingVotes: electionResult.FinalizingVotes,
+		PendingVotes:    electionResult.PendingVotes,
+		ErrorVotes:      electionResult.ErrorVotes,
+		LastUpdated:     electionResult.LastUpdated,
 	}
+	m.disclosure.Elections(electionResponse)
 
-	m.hub.BroadcastElectionUpdate(electionResponse)
+	m.hub.BroadcastElectionUpdate(electionResponse, m.restricted(electionPairID, ""))
 
 	log.WithFields(log.Fields{
 		"election_pair_id": electionPairID,
 		"total_votes":      electionResult.TotalVotes,
 		"confirmed_votes":  electionResult.ConfirmedVotes,
+		"finalizing_votes": electionResult.FinalizingVotes,
 		"clients":          m.hub.GetClientCount(),
 	}).InfoWithCtx(ctx, "[LiveResultService.BroadcastElectionUpdate] Election update broadcasted")
 
@@ -97,21 +105,25 @@ func (m *Module) BroadcastRegionUpdate(ctx context.Context, region string) error
 	}
 
 	regionResponse := &response.RegionVoteResultResponse{
-		Region:         regionResult.Region,
-		TotalVotes:     regionResult.TotalVotes,
-		ConfirmedVotes: regionResult.ConfirmedVotes,
-		PendingVotes:   regionResult.PendingVotes,
-		ErrorVotes:     regionResult.ErrorVotes,
-		LastUpdated:    regionResult.LastUpdated,
+		Region:          regionResult.Region,
+		TotalVotes:      regionResult.TotalVotes,
+		ConfirmedVotes:  regionResult.ConfirmedVotes,
+		FinalizingVotes: regionResult.FinalizingVotes,
+		PendingVotes:    regionResult.PendingVotes,
+		ErrorVotes:      regionResult.ErrorVotes,
+		LastUpdated:     regionResult.LastUpdated,
 	}
+	m.disclosure.Regions(regionResponse)
+	m.electorate.Regions(ctx, regionResponse)
 
-	m.hub.BroadcastRegionUpdate(regionResponse)
+	m.hub.BroadcastRegionUpdate(regionResponse, m.restricted("", region))
 
 	log.WithFields(log.Fields{
-		"region":          region,
-		"total_votes":     regionResult.TotalVotes,
-		"confirmed_votes": regionResult.ConfirmedVotes,
-		"clients":         m.hub.GetClientCount(),
+		"region":           region,
+		"total_votes":      regionResult.TotalVotes,
+		"confirmed_votes":  regionResult.ConfirmedVotes,
+		"finalizing_votes": regionResult.FinalizingVotes,
+		"clients":          m.hub.GetClientCount(),
 	}).InfoWithCtx(ctx, "[LiveResultService.BroadcastRegionUpdate] Region update broadcasted")
 
 	return nil
@@ -133,20 +145,22 @@ func (m *Module) BroadcastStatisticsUpdate(ctx context.Context) error {
 	stats.CalculateSuccessRate()
 
 	statsResponse := &response.VoteStatisticsResponse{
-		TotalVotes:     stats.TotalVotes,
-		ConfirmedVotes: stats.ConfirmedVotes,
-		PendingVotes:   stats.PendingVotes,
-		ErrorVotes:     stats.ErrorVotes,
-		SuccessRate:    stats.SuccessRate,
-		LastUpdated:    stats.LastUpdated,
+		TotalVotes:      stats.TotalVotes,
+		ConfirmedVotes:  stats.ConfirmedVotes,
+		FinalizingVotes: stats.FinalizingVotes,
+		PendingVotes:    stats.PendingVotes,
+		ErrorVotes:      stats.ErrorVotes,
+		SuccessRate:     stats.SuccessRate,
+		LastUpdated:     stats.LastUpdated,
 	}
-	m.hub.BroadcastStatisticsUpdate(statsResponse)
+	m.hub.BroadcastStatisticsUpdate(statsResponse, m.restricted("", ""))
 
 	log.WithFields(log.Fields{
-		"total_votes":     stats.TotalVotes,
-		"confirmed_votes": stats.ConfirmedVotes,
-		"success_rate":    stats.SuccessRate,
-		"clients":         m.hub.GetClientCount(),
+		"total_votes":      stats.TotalVotes,
+		"confirmed_votes":  stats.ConfirmedVotes,
+		"finalizing_votes": stats.FinalizingVotes,
+		"success_rate":     stats.SuccessRate,
+		"clients":          m.hub.GetClientCount(),
 	}).InfoWithCtx(ctx, "[LiveResultService.BroadcastStatisticsUpdate] Statistics update broadcasted")
 
 	return nil
@@ -185,6 +199,10 @@ func (m *Module) GetConnectedClients(ctx context.Context) int {
 	return m.hub.GetClientCount()
 }
 
+func (m *Module) HasSubscribers(ctx context.Context) bool {
+	return m.hub.HasSubscribers()
+}
+
 func (m *Module) StartPeriodicBroadcast(ctx context.Context, interval time.Duration) {
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
@@ -193,13 +211,17 @@ func (m *Module) StartPeriodicBroadcast(ctx context.Context, interval time.Durat
 		"interval": interval,
 	}).InfoWithCtx(ctx, "[LiveResultUseCase.StartPeriodicBroadcast] Starting periodic broadcast")
 
+	m.lastBroadcastTick.Store(time.Now().UnixNano())
+	defer m.lastBroadcastTick.Store(0)
+
 	for {
 		select {
 		case <-ctx.Done():
 			log.InfoWithCtx(ctx, "[LiveResultUseCase.StartPeriodicBroadcast] Stopping periodic broadcast")
 			return
 		case <-ticker.C:
-			if m.hub.GetClientCount() > 0 {
+			m.lastBroadcastTick.Store(time.Now().UnixNano())
+			if m.hub.HasSubscribers() {
 				if err := m.BroadcastStatisticsUpdate(ctx); err != nil {
 					log.WithFields(log.Fields{
 						"error": err,
@@ -209,3 +231,60 @@ func (m *Module) StartPeriodicBroadcast(ctx context.Context, interval time.Durat
 		}
 	}
 }
+
+func (m *Module) LastPeriodicBroadcast(ctx context.Context) time.Time {
+	nanos := m.lastBroadcastTick.Load()
+	if nanos == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nanos)
+}
+
+// StartEmbargoWatcher broadcasts a polls closed message each time an embargo
+// lifts, followed by the results it released, until the context is done.
+func (m *Module) StartEmbargoWatcher(ctx context.Context) {
+	after := time.Now()
+	for {
+		liftAt, rules := m.embargo.NextLift(after)
+		if liftAt.IsZero() {
+			return
+		}
+
+		timer := time.NewTimer(time.Until(liftAt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		for _, rule := range rules {
+			m.hub.BroadcastPollsClosed(&websocket.PollsClosed{
+				ElectionPairID: rule.ElectionPairID,
+				Region:         rule.Region,
+				LiftedAt:       liftAt,
+			})
+
+			log.WithFields(log.Fields{
+				"election_pair_id": rule.ElectionPairID,
+				"region":           rule.Region,
+				"lifted_at":        liftAt,
+			}).InfoWithCtx(ctx, "[LiveResultUseCase.StartEmbargoWatcher] Embargo lifted")
+
+			if err := m.BroadcastAllUpdates(ctx, rule.ElectionPairID, rule.Region); err != nil {
+				log.WithFields(log.Fields{
+					"error": err,
+				}).ErrorWithCtx(ctx, "[LiveResultUseCase.StartEmbargoWatcher] Failed to broadcast released results")
+			}
+		}
+
+		after = liftAt
+	}
+}
+
+// restricted reports whether updates for the election pair in the region are
+// still under embargo and may only reach observers.
+func (m *Module) restricted(electionPairID, region string) bool {
+	_, embargoed := m.embargo.Embargoed(electionPairID, region, time.Now())
+	return embargoed
+}
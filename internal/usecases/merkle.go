@@ -0,0 +1,18 @@
+package usecases
+
+import (
+	"context"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+)
+
+type MerkleUseCases interface {
+	// Run publishes commitments periodically until ctx is done.
+	Run(ctx context.Context)
+	// Commit builds the trees over the confirmed votes of every election and
+	// region, and publishes the roots that changed.
+	Commit(ctx context.Context) error
+
+	GetCommitments(ctx context.Context, electionID string) ([]*response.MerkleCommitmentResponse, error)
+	GetCommitment(ctx context.Context, id string) (*response.MerkleCommitmentResponse, error)
+	GetVoteProof(ctx context.Context, voteID string) (*response.MerkleProofResponse, error)
+}
@@ -0,0 +1,190 @@
+package merkle
+
+import (
+	"context"
+	"encoding/hex"
+	"github.com/google/uuid"
+	"github.com/nocturna-ta/golib/log"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/infrastructures/merkle"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
+	"sort"
+	"time"
+)
+
+type scope struct {
+	electionID string
+	region     string
+	leaves     []*model.MerkleLeaf
+}
+
+func (m *Module) Run(ctx context.Context) {
+	if !m.cfg.Enabled {
+		return
+	}
+
+	interval := m.cfg.Interval
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.Commit(ctx); err != nil {
+			log.WithFields(log.Fields{
+				"error": err,
+			}).ErrorWithCtx(ctx, "[MerkleUseCases.Run] Failed to publish commitments")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *Module) Commit(ctx context.Context) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "MerkleUseCases.Commit")
+	defer span.End()
+
+	pairs, err := m.electionPairRepo.GetElectionPairs(ctx, "")
+	if err != nil {
+		return err
+	}
+	elections := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		elections[pair.ID] = pair.ElectionID
+	}
+
+	// Votes for pairs outside the registry belong to no election and are not
+	// committed.
+	scopes := make(map[string]*scope)
+	filter := model.VoteResultFilter{Status: string(model.VoteStatusConfirmed)}
+	err = m.voteResultRepo.StreamVoteResults(ctx, filter, func(result *model.VoteResult) error {
+		electionID, ok := elections[result.ElectionPairID]
+		if !ok {
+			return nil
+		}
+
+		key := scopeKey(electionID, result.Region)
+		s, ok := scopes[key]
+		if !ok {
+			s = &scope{electionID: electionID, region: result.Region}
+			scopes[key] = s
+		}
+		s.leaves = append(s.leaves, &model.MerkleLeaf{
+			VoteID:          result.ID,
+			ElectionPairID:  result.ElectionPairID,
+			Region:          result.Region,
+			TransactionHash: result.TransactionHash,
+		})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	latest, err := m.merkleRepo.GetLatestMerkleCommitments(ctx, "")
+	if err != nil {
+		return err
+	}
+	previous := make(map[string]*model.MerkleCommitment, len(latest))
+	for _, commitment := range latest {
+		key := scopeKey(commitment.ElectionID, commitment.Region)
+		previous[key] = commitment
+		// A region whose votes are no longer confirmed gets an empty root.
+		if _, ok := scopes[key]; !ok && commitment.LeafCount > 0 {
+			scopes[key] = &scope{electionID: commitment.ElectionID, region: commitment.Region}
+		}
+	}
+
+	keys := make([]string, 0, len(scopes))
+	for key := range scopes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var firstErr error
+	publishedAt := time.Now()
+	for _, key := range keys {
+		if err = m.publish(ctx, scopes[key], previous[key], publishedAt); err != nil {
+			metrics.MerkleCommitments.WithLabelValues("failed").Inc()
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	return firstErr
+}
+
+// publish commits the votes of a scope when their root differs from the
+// previous commitment, whose leaves are then dropped.
+func (m *Module) publish(ctx context.Context, s *scope, previous *model.MerkleCommitment, publishedAt time.Time) error {
+	sort.Slice(s.leaves, func(i, j int) bool {
+		return s.leaves[i].VoteID < s.leaves[j].VoteID
+	})
+	hashes := make([][]byte, len(s.leaves))
+	for i, leaf := range s.leaves {
+		hashes[i] = merkle.LeafHash(leaf.VoteID, leaf.ElectionPairID, leaf.Region, leaf.TransactionHash)
+	}
+
+	root := hex.EncodeToString(merkle.Build(hashes).Root())
+	if previous != nil && previous.Root == root {
+		return nil
+	}
+
+	commitment := &model.MerkleCommitment{
+		ID:          uuid.NewString(),
+		ElectionID:  s.electionID,
+		Region:      s.region,
+		Root:        root,
+		LeafCount:   uint64(len(s.leaves)),
+		PublishedAt: publishedAt,
+	}
+	for i, leaf := range s.leaves {
+		leaf.CommitmentID = commitment.ID
+		leaf.LeafIndex = uint64(i)
+		leaf.LeafHash = hex.EncodeToString(hashes[i])
+		leaf.PublishedAt = publishedAt
+	}
+
+	if err := m.merkleRepo.InsertMerkleCommitment(ctx, commitment, s.leaves); err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"election_id": s.electionID,
+			"region":      s.region,
+		}).ErrorWithCtx(ctx, "[MerkleUseCases.Commit] Failed to publish commitment")
+		// Leaves stored before the failure would shadow the previous commitment.
+		if err := m.merkleRepo.DeleteMerkleLeaves(ctx, commitment.ID); err != nil {
+			log.WithFields(log.Fields{
+				"error":         err,
+				"commitment_id": commitment.ID,
+			}).ErrorWithCtx(ctx, "[MerkleUseCases.Commit] Failed to delete leaves of failed commitment")
+		}
+		return err
+	}
+	metrics.MerkleCommitments.WithLabelValues("published").Inc()
+
+	log.WithFields(log.Fields{
+		"commitment_id": commitment.ID,
+		"election_id":   commitment.ElectionID,
+		"region":        commitment.Region,
+		"root":          commitment.Root,
+		"leaf_count":    commitment.LeafCount,
+	}).InfoWithCtx(ctx, "[MerkleUseCases.Commit] Commitment published")
+
+	if previous != nil {
+		if err := m.merkleRepo.DeleteMerkleLeaves(ctx, previous.ID); err != nil {
+			log.WithFields(log.Fields{
+				"error":         err,
+				"commitment_id": previous.ID,
+			}).ErrorWithCtx(ctx, "[MerkleUseCases.Commit] Failed to delete leaves of superseded commitment")
+		}
+	}
+
+	return nil
+}
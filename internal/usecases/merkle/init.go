@@ -0,0 +1,51 @@
+package merkle
+
+import (
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/embargo"
+	"github.com/nocturna-ta/result/internal/infrastructures/merkle"
+	"github.com/nocturna-ta/result/internal/usecases"
+	"sync"
+)
+
+type Module struct {
+	merkleRepo       repository.MerkleRepository
+	voteResultRepo   repository.VoteResultRepository
+	electionPairRepo repository.ElectionPairRepository
+	embargo          *embargo.Schedule
+	cfg              config.MerkleConfig
+
+	mu sync.Mutex
+	// trees holds the tree of the latest commitment proven per election and
+	// region, so proofs do not reload every leaf.
+	trees map[string]*committedTree
+}
+
+type committedTree struct {
+	commitmentID string
+	tree         *merkle.Tree
+}
+
+type Options struct {
+	MerkleRepo       repository.MerkleRepository
+	VoteResultRepo   repository.VoteResultRepository
+	ElectionPairRepo repository.ElectionPairRepository
+	Embargo          *embargo.Schedule
+	Cfg              config.MerkleConfig
+}
+
+func New(opts *Options) usecases.MerkleUseCases {
+	return &Module{
+		merkleRepo:       opts.MerkleRepo,
+		voteResultRepo:   opts.VoteResultRepo,
+		electionPairRepo: opts.ElectionPairRepo,
+		embargo:          opts.Embargo,
+		cfg:              opts.Cfg,
+		trees:            make(map[string]*committedTree),
+	}
+}
+
+func scopeKey(electionID, region string) string {
+	return electionID + "|" + region
+}
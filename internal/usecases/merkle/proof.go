@@ -0,0 +1,256 @@
+package merkle
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/golib/log"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/infrastructures/embargo"
+	"github.com/nocturna-ta/result/internal/infrastructures/merkle"
+	"github.com/nocturna-ta/result/internal/interfaces/dao"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"time"
+)
+
+func (m *Module) GetCommitments(ctx context.Context, electionID string) ([]*response.MerkleCommitmentResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "MerkleUseCases.GetCommitments")
+	defer span.End()
+
+	commitments, err := m.merkleRepo.GetLatestMerkleCommitments(ctx, electionID)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"election_id": electionID,
+		}).ErrorWithCtx(ctx, "[MerkleUseCases.GetCommitments] Failed to get commitments")
+		return nil, err
+	}
+
+	withheld, err := m.withheld(ctx, electionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Leaf counts are confirmed vote counts, so commitments under embargo are
+	// left out like the results they cover.
+	responses := make([]*response.MerkleCommitmentResponse, 0, len(commitments))
+	for _, commitment := range commitments {
+		if _, embargoed := withheld(commitment); embargoed {
+			continue
+		}
+		responses = append(responses, toCommitmentResponse(commitment))
+	}
+
+	return responses, nil
+}
+
+func (m *Module) GetCommitment(ctx context.Context, id string) (*response.MerkleCommitmentResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "MerkleUseCases.GetCommitment")
+	defer span.End()
+
+	commitment, err := m.getCommitment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	withheld, err := m.withheld(ctx, commitment.ElectionID)
+	if err != nil {
+		return nil, err
+	}
+	if until, embargoed := withheld(commitment); embargoed {
+		return nil, embargo.Error(until)
+	}
+
+	return toCommitmentResponse(commitment), nil
+}
+
+// GetVoteProof returns the inclusion path of a confirmed vote in the latest
+// root it is committed to.
+func (m *Module) GetVoteProof(ctx context.Context, voteID string) (*response.MerkleProofResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "MerkleUseCases.GetVoteProof")
+	defer span.End()
+
+	if voteID == "" {
+		return nil, &custerr.ErrChain{
+			Message: "vote ID is required",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+
+	leaf, err := m.merkleRepo.GetMerkleLeaf(ctx, voteID)
+	if err != nil {
+		if errors.Is(err, dao.ErrNoResult) {
+			return nil, &custerr.ErrChain{
+				Message: "vote is not committed to a published root, it may not be confirmed yet",
+				Code:    404,
+				Type:    response2.ErrNotFound,
+			}
+		}
+		log.WithFields(log.Fields{
+			"error":   err,
+			"vote_id": voteID,
+		}).ErrorWithCtx(ctx, "[MerkleUseCases.GetVoteProof] Failed to get merkle leaf")
+		return nil, err
+	}
+
+	if until, embargoed := m.embargo.Embargoed(leaf.ElectionPairID, leaf.Region, time.Now()); embargoed && !embargo.Privileged(ctx) {
+		return nil, embargo.Error(until)
+	}
+
+	commitment, err := m.getCommitment(ctx, leaf.CommitmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := m.tree(ctx, commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := tree.Proof(int(leaf.LeafIndex))
+	if err != nil {
+		return nil, err
+	}
+
+	res := &response.MerkleProofResponse{
+		VoteID:          leaf.VoteID,
+		ElectionID:      commitment.ElectionID,
+		ElectionPairID:  leaf.ElectionPairID,
+		Region:          leaf.Region,
+		TransactionHash: leaf.TransactionHash,
+		CommitmentID:    commitment.ID,
+		Root:            commitment.Root,
+		PublishedAt:     commitment.PublishedAt,
+		LeafIndex:       leaf.LeafIndex,
+		LeafCount:       commitment.LeafCount,
+		LeafHash:        leaf.LeafHash,
+		Path:            make([]*response.MerkleProofStepResponse, 0, len(path)),
+	}
+	for _, step := range path {
+		position := "right"
+		if step.Left {
+			position = "left"
+		}
+		res.Path = append(res.Path, &response.MerkleProofStepResponse{
+			Hash:     hex.EncodeToString(step.Hash),
+			Position: position,
+		})
+	}
+
+	return res, nil
+}
+
+func (m *Module) getCommitment(ctx context.Context, id string) (*model.MerkleCommitment, error) {
+	commitment, err := m.merkleRepo.GetMerkleCommitment(ctx, id)
+	if err != nil {
+		if errors.Is(err, dao.ErrNoResult) {
+			return nil, &custerr.ErrChain{
+				Message: "commitment not found",
+				Code:    404,
+				Type:    response2.ErrNotFound,
+			}
+		}
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[MerkleUseCases] Failed to get commitment")
+		return nil, err
+	}
+	return commitment, nil
+}
+
+// tree rebuilds the tree of a commitment from its stored leaves, checking it
+// still has the published root.
+func (m *Module) tree(ctx context.Context, commitment *model.MerkleCommitment) (*merkle.Tree, error) {
+	key := scopeKey(commitment.ElectionID, commitment.Region)
+
+	m.mu.Lock()
+	cached, ok := m.trees[key]
+	m.mu.Unlock()
+	if ok && cached.commitmentID == commitment.ID {
+		return cached.tree, nil
+	}
+
+	stored, err := m.merkleRepo.GetMerkleLeafHashes(ctx, commitment.ID)
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([][]byte, 0, len(stored))
+	for _, leafHash := range stored {
+		hash, err := hex.DecodeString(leafHash)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash)
+	}
+
+	tree := merkle.Build(hashes)
+	if root := hex.EncodeToString(tree.Root()); root != commitment.Root {
+		log.WithFields(log.Fields{
+			"commitment_id": commitment.ID,
+			"root":          commitment.Root,
+			"rebuilt_root":  root,
+		}).ErrorWithCtx(ctx, "[MerkleUseCases] Stored leaves do not match the published root")
+		return nil, fmt.Errorf("leaves of commitment %s do not match its root", commitment.ID)
+	}
+
+	m.mu.Lock()
+	m.trees[key] = &committedTree{commitmentID: commitment.ID, tree: tree}
+	m.mu.Unlock()
+
+	return tree, nil
+}
+
+// withheld returns whether a commitment of the election is under embargo for
+// the caller: while any pair of the election is embargoed in its region.
+func (m *Module) withheld(ctx context.Context, electionID string) (func(*model.MerkleCommitment) (time.Time, bool), error) {
+	if embargo.Privileged(ctx) {
+		return func(*model.MerkleCommitment) (time.Time, bool) { return time.Time{}, false }, nil
+	}
+
+	pairs, err := m.electionPairRepo.GetElectionPairs(ctx, electionID)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"election_id": electionID,
+		}).ErrorWithCtx(ctx, "[MerkleUseCases] Failed to get election pairs")
+		return nil, err
+	}
+	byElection := make(map[string][]string)
+	for _, pair := range pairs {
+		byElection[pair.ElectionID] = append(byElection[pair.ElectionID], pair.ID)
+	}
+
+	checkedAt := time.Now()
+	return func(commitment *model.MerkleCommitment) (time.Time, bool) {
+		var (
+			latest   time.Time
+			withheld bool
+		)
+		for _, pairID := range byElection[commitment.ElectionID] {
+			if until, embargoed := m.embargo.Embargoed(pairID, commitment.Region, checkedAt); embargoed {
+				withheld = true
+				if until.After(latest) {
+					latest = until
+				}
+			}
+		}
+		return latest, withheld
+	}, nil
+}
+
+func toCommitmentResponse(commitment *model.MerkleCommitment) *response.MerkleCommitmentResponse {
+	return &response.MerkleCommitmentResponse{
+		ID:          commitment.ID,
+		ElectionID:  commitment.ElectionID,
+		Region:      commitment.Region,
+		Root:        commitment.Root,
+		LeafCount:   commitment.LeafCount,
+		PublishedAt: commitment.PublishedAt,
+	}
+}
@@ -0,0 +1,11 @@
+package usecases
+
+import (
+	"context"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+)
+
+type PseudonymUseCases interface {
+	LookupPseudonym(ctx context.Context, req *request.PseudonymLookupRequest) (*response.PseudonymLookupResponse, error)
+}
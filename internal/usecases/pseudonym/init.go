@@ -0,0 +1,20 @@
+package pseudonym
+
+import (
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/usecases"
+)
+
+type Module struct {
+	pseudonymRepo repository.PseudonymRepository
+}
+
+type Options struct {
+	PseudonymRepo repository.PseudonymRepository
+}
+
+func New(opts *Options) usecases.PseudonymUseCases {
+	return &Module{
+		pseudonymRepo: opts.PseudonymRepo,
+	}
+}
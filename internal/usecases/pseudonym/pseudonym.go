@@ -0,0 +1,104 @@
+package pseudonym
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/golib/log"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/infrastructures/auth"
+	"github.com/nocturna-ta/result/internal/infrastructures/privacy"
+	"github.com/nocturna-ta/result/internal/interfaces/dao"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"github.com/nocturna-ta/result/pkg/constants"
+	"strings"
+	"time"
+)
+
+// LookupPseudonym resolves a pseudonym to the voter ID for an admin. Every
+// attempt is recorded before anything is revealed, and nothing is revealed when
+// the record cannot be written.
+func (m *Module) LookupPseudonym(ctx context.Context, req *request.PseudonymLookupRequest) (*response.PseudonymLookupResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "PseudonymUseCases.LookupPseudonym")
+	defer span.End()
+
+	if err := auth.Authorize(ctx, constants.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	pseudonym := strings.TrimSpace(req.Pseudonym)
+	reason := strings.TrimSpace(req.Reason)
+	if !privacy.IsPseudonym(pseudonym) {
+		return nil, &custerr.ErrChain{
+			Message: "invalid pseudonym",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+	if reason == "" {
+		return nil, &custerr.ErrChain{
+			Message: "reason is required",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+
+	mapping, err := m.pseudonymRepo.GetVoterPseudonym(ctx, pseudonym)
+	if err != nil && !errors.Is(err, dao.ErrNoResult) {
+		log.WithFields(log.Fields{
+			"error":     err,
+			"pseudonym": pseudonym,
+		}).ErrorWithCtx(ctx, "[PseudonymUseCases.LookupPseudonym] Failed to get voter pseudonym")
+		return nil, err
+	}
+
+	lookup := &model.PseudonymLookup{
+		ID:         uuid.NewString(),
+		Pseudonym:  pseudonym,
+		Reason:     reason,
+		Found:      mapping != nil,
+		LookedUpAt: time.Now(),
+	}
+	if principal := auth.PrincipalFromContext(ctx); principal != nil {
+		lookup.UserID = principal.UserId
+		lookup.UserName = principal.Name
+	}
+
+	if err := m.pseudonymRepo.InsertPseudonymLookup(ctx, lookup); err != nil {
+		log.WithFields(log.Fields{
+			"error":     err,
+			"pseudonym": pseudonym,
+		}).ErrorWithCtx(ctx, "[PseudonymUseCases.LookupPseudonym] Failed to record pseudonym lookup")
+		return nil, &custerr.ErrChain{
+			Message: "failed to record pseudonym lookup",
+			Code:    500,
+			Type:    response2.ErrInternalServerError,
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"lookup_id": lookup.ID,
+		"pseudonym": pseudonym,
+		"user_id":   lookup.UserID,
+		"reason":    reason,
+		"found":     lookup.Found,
+	}).InfoWithCtx(ctx, "[PseudonymUseCases.LookupPseudonym] Pseudonym lookup recorded")
+
+	if mapping == nil {
+		return nil, &custerr.ErrChain{
+			Message: "pseudonym not found",
+			Code:    404,
+			Type:    response2.ErrNotFound,
+		}
+	}
+
+	return &response.PseudonymLookupResponse{
+		Pseudonym: mapping.Pseudonym,
+		VoterID:   mapping.VoterID,
+		CreatedAt: mapping.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,18 @@
+package usecases
+
+import (
+	"context"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+)
+
+type ReconciliationUseCases interface {
+	// Run reconciles confirmed votes with the chain periodically until ctx is done.
+	Run(ctx context.Context)
+	// Reconcile checks one batch of confirmed votes on chain and records the
+	// outcome on each vote.
+	Reconcile(ctx context.Context) error
+
+	// GetFlaggedVotes lists the votes whose transaction is missing, failed or
+	// reverted on chain, or only those of chainStatus when given.
+	GetFlaggedVotes(ctx context.Context, chainStatus string, limit, offset int) ([]*response.VoteResultResponse, error)
+}
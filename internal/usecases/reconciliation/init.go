@@ -0,0 +1,43 @@
+package reconciliation
+
+import (
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/ethereum"
+	"github.com/nocturna-ta/result/internal/infrastructures/privacy"
+	"github.com/nocturna-ta/result/internal/usecases"
+	"time"
+)
+
+type Module struct {
+	voteResultRepo repository.VoteResultRepository
+	verifier       *ethereum.Verifier
+	privacy        *privacy.Policy
+	cfg            config.ReconciliationConfig
+}
+
+type Options struct {
+	VoteResultRepo repository.VoteResultRepository
+	// Verifier reads the chain. Reconciliation does not run without it, while
+	// flagged votes can still be listed.
+	Verifier *ethereum.Verifier
+	Privacy  *privacy.Policy
+	Cfg      config.ReconciliationConfig
+}
+
+func New(opts *Options) usecases.ReconciliationUseCases {
+	cfg := opts.Cfg
+	if cfg.Interval <= 0 {
+		cfg.Interval = time.Minute
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 500
+	}
+
+	return &Module{
+		voteResultRepo: opts.VoteResultRepo,
+		verifier:       opts.Verifier,
+		privacy:        opts.Privacy,
+		cfg:            cfg,
+	}
+}
@@ -0,0 +1,169 @@
+package reconciliation
+
+import (
+	"context"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/golib/log"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"time"
+)
+
+// flaggedStatuses are the chain statuses of confirmed votes whose transaction
+// does not back them.
+var flaggedStatuses = []string{
+	string(model.ChainStatusMissing),
+	string(model.ChainStatusFailed),
+	string(model.ChainStatusReverted),
+}
+
+func (m *Module) Run(ctx context.Context) {
+	if !m.cfg.Enabled || m.verifier == nil {
+		return
+	}
+
+	ticker := time.NewTicker(m.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.Reconcile(ctx); err != nil {
+			log.WithFields(log.Fields{
+				"error": err,
+			}).ErrorWithCtx(ctx, "[ReconciliationUseCases.Run] Failed to reconcile votes")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *Module) Reconcile(ctx context.Context) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ReconciliationUseCases.Reconcile")
+	defer span.End()
+
+	head, err := m.verifier.Head(ctx)
+	if err != nil {
+		return err
+	}
+	var minBlock uint64
+	if head > m.cfg.RecheckDepth {
+		minBlock = head - m.cfg.RecheckDepth
+	}
+
+	votes, err := m.voteResultRepo.GetVotesToReconcile(ctx, minBlock, m.cfg.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, vote := range votes {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		m.reconcile(ctx, vote)
+	}
+
+	return nil
+}
+
+// reconcile checks the transaction of one vote and records the outcome. Votes
+// whose check fails are left as they are and retried on the next batch.
+func (m *Module) reconcile(ctx context.Context, vote *model.VoteResult) {
+	verification, err := m.verifier.Verify(ctx, vote.TransactionHash, vote.BlockHash)
+	if err != nil {
+		metrics.VotesReconciled.WithLabelValues("error").Inc()
+		log.WithFields(log.Fields{
+			"error":            err,
+			"vote_id":          vote.ID,
+			"transaction_hash": vote.TransactionHash,
+		}).ErrorWithCtx(ctx, "[ReconciliationUseCases.Reconcile] Failed to verify transaction")
+		return
+	}
+
+	previous := vote.ChainStatus
+	verification.Record(vote, time.Now())
+
+	if err = m.voteResultRepo.UpdateVoteChainStatus(ctx, vote); err != nil {
+		metrics.VotesReconciled.WithLabelValues("error").Inc()
+		log.WithFields(log.Fields{
+			"error":   err,
+			"vote_id": vote.ID,
+		}).ErrorWithCtx(ctx, "[ReconciliationUseCases.Reconcile] Failed to update vote chain status")
+		return
+	}
+	metrics.VotesReconciled.WithLabelValues(vote.ChainStatus).Inc()
+
+	if verification.Status != model.ChainStatusVerified && previous != vote.ChainStatus {
+		log.WithFields(log.Fields{
+			"vote_id":          vote.ID,
+			"transaction_hash": vote.TransactionHash,
+			"chain_status":     vote.ChainStatus,
+			"previous_status":  previous,
+		}).WarnWithCtx(ctx, "[ReconciliationUseCases.Reconcile] Confirmed vote is not backed on chain")
+	}
+}
+
+func (m *Module) GetFlaggedVotes(ctx context.Context, chainStatus string, limit, offset int) ([]*response.VoteResultResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ReconciliationUseCases.GetFlaggedVotes")
+	defer span.End()
+
+	statuses := flaggedStatuses
+	if chainStatus != "" {
+		valid := false
+		for _, status := range flaggedStatuses {
+			valid = valid || status == chainStatus
+		}
+		if !valid {
+			return nil, &custerr.ErrChain{
+				Message: "chain status must be missing, failed or reverted",
+				Code:    400,
+				Type:    response2.ErrBadRequest,
+			}
+		}
+		statuses = []string{chainStatus}
+	}
+
+	switch {
+	case limit <= 0:
+		limit = 50
+	case limit > 1000:
+		limit = 1000
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	votes, err := m.voteResultRepo.GetVoteResultsByChainStatus(ctx, statuses, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*response.VoteResultResponse, 0, len(votes))
+	for _, vote := range votes {
+		responses = append(responses, &response.VoteResultResponse{
+			ID:              vote.ID,
+			VoterID:         vote.VoterID,
+			ElectionPairID:  vote.ElectionPairID,
+			Region:          vote.Region,
+			Status:          vote.Status,
+			TransactionHash: vote.TransactionHash,
+			ErrorMessage:    vote.ErrorMessage,
+			VotedAt:         vote.VotedAt,
+			ProcessedAt:     vote.ProcessedAt,
+			CreatedAt:       vote.CreatedAt,
+			UpdatedAt:       vote.UpdatedAt,
+			ChainStatus:     vote.ChainStatus,
+			BlockNumber:     vote.BlockNumber,
+			BlockHash:       vote.BlockHash,
+			BlockTime:       vote.BlockTime,
+		})
+	}
+	m.privacy.Apply(ctx, responses...)
+
+	return responses, nil
+}
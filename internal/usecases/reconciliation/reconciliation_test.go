@@ -0,0 +1,243 @@
+package reconciliation
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/ethereum"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type voteResultRepo struct {
+	repository.VoteResultRepository
+
+	mu    sync.Mutex
+	votes map[string]*model.VoteResult
+}
+
+func (r *voteResultRepo) GetVotesToReconcile(context.Context, uint64, int) ([]*model.VoteResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	votes := make([]*model.VoteResult, 0, len(r.votes))
+	for _, vote := range r.votes {
+		copied := *vote
+		votes = append(votes, &copied)
+	}
+	return votes, nil
+}
+
+func (r *voteResultRepo) UpdateVoteChainStatus(_ context.Context, result *model.VoteResult) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *result
+	r.votes[result.ID] = &copied
+	return nil
+}
+
+func (r *voteResultRepo) vote(id string) *model.VoteResult {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.votes[id]
+}
+
+// chain is a simulated chain with funded accounts sending the transactions of
+// votes.
+type chain struct {
+	t       *testing.T
+	backend *backends.SimulatedBackend
+	keys    map[string]*ecdsa.PrivateKey
+}
+
+func newChain(t *testing.T, accounts ...string) *chain {
+	t.Helper()
+
+	c := &chain{t: t, keys: make(map[string]*ecdsa.PrivateKey)}
+	alloc := types.GenesisAlloc{}
+	for _, account := range accounts {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.keys[account] = key
+		alloc[crypto.PubkeyToAddress(key.PublicKey)] = types.Account{Balance: big.NewInt(1e18)}
+	}
+	c.backend = backends.NewSimulatedBackend(alloc, 30_000_000)
+	t.Cleanup(func() { _ = c.backend.Close() })
+	return c
+}
+
+// send signs and submits a transaction of the account. A nil recipient creates
+// a contract from data. The fees are those suggested times priceFactor, so a
+// transaction can replace another of the same nonce.
+func (c *chain) send(account string, nonce uint64, to *common.Address, data []byte, priceFactor int64) *types.Transaction {
+	c.t.Helper()
+	ctx := context.Background()
+
+	tip, err := c.backend.SuggestGasTipCap(ctx)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	head, err := c.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	feeCap := new(big.Int).Add(tip, new(big.Int).Mul(head.BaseFee, big.NewInt(2)))
+	tip.Mul(tip, big.NewInt(priceFactor))
+	feeCap.Mul(feeCap, big.NewInt(priceFactor))
+
+	tx, err := types.SignTx(types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(1337),
+		Nonce:     nonce,
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		Gas:       100_000,
+		To:        to,
+		Value:     big.NewInt(1),
+		Data:      data,
+	}), types.LatestSignerForChainID(big.NewInt(1337)), c.keys[account])
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	c.resend(tx)
+	return tx
+}
+
+// resend submits a transaction, again after a reorg. The pool catches up with
+// a new head in the background, so a nonce it still counts as used is retried.
+func (c *chain) resend(tx *types.Transaction) {
+	c.t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		err := c.backend.SendTransaction(context.Background(), tx)
+		if err == nil || err.Error() == "already known" {
+			return
+		}
+		if !strings.Contains(err.Error(), "nonce too low") || time.Now().After(deadline) {
+			c.t.Fatal(err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func (c *chain) transfer(account string, nonce uint64, priceFactor int64) *types.Transaction {
+	to := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	return c.send(account, nonce, &to, nil, priceFactor)
+}
+
+// receipt waits for the transaction to be mined on the canonical chain.
+func (c *chain) receipt(tx *types.Transaction) *types.Receipt {
+	c.t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		receipt, err := c.backend.TransactionReceipt(context.Background(), tx.Hash())
+		if err == nil {
+			return receipt
+		}
+		if time.Now().After(deadline) {
+			c.t.Fatalf("transaction %s was not mined: %v", tx.Hash().Hex(), err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestReconcileAgainstSimulatedChain(t *testing.T) {
+	c := newChain(t, "kept", "dropped", "moved", "failed")
+
+	kept := c.transfer("kept", 0, 1)
+	// Contract creation code that reverts, so the transaction is mined but fails.
+	failed := c.send("failed", 0, nil, common.FromHex("0x60006000fd"), 1)
+	block1 := c.backend.Commit()
+	c.receipt(kept)
+	c.receipt(failed)
+
+	dropped := c.transfer("dropped", 0, 1)
+	moved := c.transfer("moved", 0, 1)
+	block2 := c.backend.Commit()
+	c.receipt(dropped)
+	c.receipt(moved)
+
+	confirmed := func(id string, tx *types.Transaction) *model.VoteResult {
+		return &model.VoteResult{ID: id, Status: string(model.VoteStatusConfirmed), TransactionHash: tx.Hash().Hex()}
+	}
+	repo := &voteResultRepo{votes: map[string]*model.VoteResult{
+		"kept":    confirmed("kept", kept),
+		"failed":  confirmed("failed", failed),
+		"dropped": confirmed("dropped", dropped),
+		"moved":   confirmed("moved", moved),
+		"unsent": {ID: "unsent", Status: string(model.VoteStatusConfirmed),
+			TransactionHash: common.HexToHash("0x01").Hex()},
+		"malformed": {ID: "malformed", Status: string(model.VoteStatusConfirmed), TransactionHash: "tx-123"},
+	}}
+	m := New(&Options{
+		VoteResultRepo: repo,
+		Verifier:       ethereum.NewVerifier(c.backend),
+		Cfg:            config.ReconciliationConfig{Enabled: true},
+	})
+	ctx := context.Background()
+
+	if err := m.Reconcile(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	expect := func(id string, status model.ChainStatus, blockHash common.Hash, blockNumber uint64) {
+		t.Helper()
+		vote := repo.vote(id)
+		if vote.ChainStatus != string(status) {
+			t.Errorf("vote %s chain status = %q, want %q", id, vote.ChainStatus, status)
+		}
+		if vote.ChainCheckedAt == nil {
+			t.Errorf("vote %s has no chain check time", id)
+		}
+		if blockHash == (common.Hash{}) {
+			if vote.BlockHash != "" || vote.BlockNumber != nil {
+				t.Errorf("vote %s is in block %s, want none", id, vote.BlockHash)
+			}
+			return
+		}
+		if vote.BlockHash != blockHash.Hex() || vote.BlockNumber == nil || *vote.BlockNumber != blockNumber {
+			t.Errorf("vote %s is in block %s, want %s at %d", id, vote.BlockHash, blockHash.Hex(), blockNumber)
+		}
+	}
+
+	expect("kept", model.ChainStatusVerified, block1, 1)
+	expect("failed", model.ChainStatusFailed, block1, 1)
+	expect("dropped", model.ChainStatusVerified, block2, 2)
+	expect("moved", model.ChainStatusVerified, block2, 2)
+	expect("unsent", model.ChainStatusMissing, common.Hash{}, 0)
+	expect("malformed", model.ChainStatusMissing, common.Hash{}, 0)
+
+	// Reorg block 2 away: the moved transaction is mined again in the new
+	// branch, while the dropped one is replaced by another transaction of the
+	// same nonce and never comes back.
+	if err := c.backend.Fork(ctx, block1); err != nil {
+		t.Fatal(err)
+	}
+	c.resend(moved)
+	c.transfer("dropped", 0, 10)
+	newBlock2 := c.backend.Commit()
+	c.backend.Commit()
+	c.receipt(moved)
+	if newBlock2 == block2 {
+		t.Fatal("the fork did not replace block 2")
+	}
+
+	if err := m.Reconcile(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	expect("kept", model.ChainStatusVerified, block1, 1)
+	expect("failed", model.ChainStatusFailed, block1, 1)
+	expect("dropped", model.ChainStatusReverted, block2, 2)
+	expect("moved", model.ChainStatusVerified, newBlock2, 2)
+	expect("unsent", model.ChainStatusMissing, common.Hash{}, 0)
+}
@@ -0,0 +1,11 @@
+package usecases
+
+import (
+	"context"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+)
+
+type RegionUseCases interface {
+	GetRegionHierarchy(ctx context.Context) (*response.RegionHierarchyResponse, error)
+	GetRegionChildren(ctx context.Context, code string) (*response.RegionChildrenResponse, error)
+}
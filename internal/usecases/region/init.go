@@ -0,0 +1,32 @@
+package region
+
+import (
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/disclosure"
+	"github.com/nocturna-ta/result/internal/infrastructures/embargo"
+	"github.com/nocturna-ta/result/internal/infrastructures/registry"
+	"github.com/nocturna-ta/result/internal/usecases"
+)
+
+type Module struct {
+	voteResultRepo repository.VoteResultRepository
+	hierarchy      *registry.RegionHierarchy
+	embargo        *embargo.Schedule
+	disclosure     *disclosure.Control
+}
+
+type Options struct {
+	VoteResultRepo repository.VoteResultRepository
+	Hierarchy      *registry.RegionHierarchy
+	Embargo        *embargo.Schedule
+	Disclosure     *disclosure.Control
+}
+
+func New(opts *Options) usecases.RegionUseCases {
+	return &Module{
+		voteResultRepo: opts.VoteResultRepo,
+		hierarchy:      opts.Hierarchy,
+		embargo:        opts.Embargo,
+		disclosure:     opts.Disclosure,
+	}
+}
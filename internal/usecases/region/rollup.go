@@ -0,0 +1,282 @@
+package region
+
+import (
+	"context"
+	"errors"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/golib/log"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/infrastructures/embargo"
+	"github.com/nocturna-ta/result/internal/interfaces/dao"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"time"
+)
+
+// rollup holds the vote counts of every region of the hierarchy. Each region
+// vote count is added to its polling station and every ancestor of it, so a
+// parent always counts exactly the votes of its children.
+type rollup struct {
+	counts   map[string]*model.RegionResult
+	regions  map[string][]string
+	national model.RegionResult
+	unmapped []*model.RegionResult
+}
+
+func (m *Module) GetRegionHierarchy(ctx context.Context) (*response.RegionHierarchyResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "RegionUseCases.GetRegionHierarchy")
+	defer span.End()
+
+	if m.hierarchy == nil {
+		return nil, errNotConfigured()
+	}
+
+	r, err := m.rollup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roots := m.hierarchy.Roots()
+	if until, embargoed := m.withheld(ctx, r, roots...); embargoed {
+		return nil, embargo.Error(until)
+	}
+	var unmapped []string
+	for _, result := range r.unmapped {
+		unmapped = append(unmapped, result.Region)
+	}
+	if until, embargoed := m.embargoedRegions(ctx, unmapped...); embargoed {
+		return nil, embargo.Error(until)
+	}
+
+	national := toRollup(&model.RegionNode{}, &r.national)
+	m.disclose(national)
+
+	res := &response.RegionHierarchyResponse{
+		TotalVotes:      national.TotalVotes,
+		ConfirmedVotes:  national.ConfirmedVotes,
+		FinalizingVotes: national.FinalizingVotes,
+		PendingVotes:    national.PendingVotes,
+		ErrorVotes:      national.ErrorVotes,
+		LastUpdated:     national.LastUpdated,
+		Provinces:       m.children(r, roots),
+	}
+
+	for _, result := range r.unmapped {
+		res.UnmappedRegions = append(res.UnmappedRegions, &response.RegionVoteResultResponse{
+			Region:          result.Region,
+			TotalVotes:      result.TotalVotes,
+			ConfirmedVotes:  result.ConfirmedVotes,
+			FinalizingVotes: result.FinalizingVotes,
+			PendingVotes:    result.PendingVotes,
+			ErrorVotes:      result.ErrorVotes,
+			LastUpdated:     result.LastUpdated,
+		})
+	}
+	m.disclosure.Regions(res.UnmappedRegions...)
+
+	return res, nil
+}
+
+func (m *Module) GetRegionChildren(ctx context.Context, code string) (*response.RegionChildrenResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "RegionUseCases.GetRegionChildren")
+	defer span.End()
+
+	if m.hierarchy == nil {
+		return nil, errNotConfigured()
+	}
+
+	node, ok := m.hierarchy.Node(code)
+	if !ok {
+		return nil, &custerr.ErrChain{
+			Message: "region not found in the region hierarchy",
+			Code:    404,
+			Type:    response2.ErrNotFound,
+		}
+	}
+
+	r, err := m.rollup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if until, embargoed := m.withheld(ctx, r, node); embargoed {
+		return nil, embargo.Error(until)
+	}
+
+	region := toRollup(node, r.counts[node.Code])
+	m.disclose(region)
+
+	res := &response.RegionChildrenResponse{
+		Region:   region,
+		Children: m.children(r, node.Children),
+	}
+	for _, ancestor := range m.hierarchy.Path(node.ParentCode) {
+		res.Path = append(res.Path, &response.RegionNodeResponse{
+			Code:  ancestor.Code,
+			Name:  ancestor.Name,
+			Level: ancestor.Level,
+		})
+	}
+
+	return res, nil
+}
+
+// rollup reads the vote count of every region votes carry and adds it up the
+// hierarchy. Regions matching no polling station are kept apart.
+func (m *Module) rollup(ctx context.Context) (*rollup, error) {
+	results, err := m.voteResultRepo.GetRegionStatistics(ctx)
+	if err != nil && !errors.Is(err, dao.ErrNoResult) {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[RegionUseCases] Failed to get region statistics")
+		return nil, err
+	}
+
+	r := &rollup{
+		counts:  make(map[string]*model.RegionResult),
+		regions: make(map[string][]string),
+	}
+	for _, result := range results {
+		leaf, ok := m.hierarchy.Leaf(result.Region)
+		if !ok {
+			r.unmapped = append(r.unmapped, result)
+			continue
+		}
+
+		r.regions[leaf.Code] = append(r.regions[leaf.Code], result.Region)
+		add(&r.national, result)
+		for node, ok := leaf, true; ok; node, ok = m.hierarchy.Node(node.ParentCode) {
+			sum, found := r.counts[node.Code]
+			if !found {
+				sum = &model.RegionResult{Region: node.Code}
+				r.counts[node.Code] = sum
+			}
+			add(sum, result)
+		}
+	}
+
+	return r, nil
+}
+
+// withheld reports whether any region under the nodes is embargoed for the
+// caller. A rollup is all or nothing, since its count less the released
+// children would reveal the rest.
+func (m *Module) withheld(ctx context.Context, r *rollup, nodes ...*model.RegionNode) (time.Time, bool) {
+	var (
+		latest   time.Time
+		withheld bool
+	)
+	for _, node := range nodes {
+		regions := append([]string{node.Code}, r.regions[node.Code]...)
+		if until, embargoed := m.embargoedRegions(ctx, regions...); embargoed {
+			withheld = true
+			if until.After(latest) {
+				latest = until
+			}
+		}
+		if until, embargoed := m.withheld(ctx, r, node.Children...); embargoed {
+			withheld = true
+			if until.After(latest) {
+				latest = until
+			}
+		}
+	}
+	return latest, withheld
+}
+
+func (m *Module) embargoedRegions(ctx context.Context, regions ...string) (time.Time, bool) {
+	if len(regions) == 0 || embargo.Privileged(ctx) {
+		return time.Time{}, false
+	}
+
+	var (
+		latest    time.Time
+		withheld  bool
+		checkedAt = time.Now()
+	)
+	for _, region := range regions {
+		if until, embargoed := m.embargo.Embargoed("", region, checkedAt); embargoed {
+			withheld = true
+			if until.After(latest) {
+				latest = until
+			}
+		}
+	}
+	return latest, withheld
+}
+
+// children returns the rollups of the nodes, regions without votes included,
+// protected together as one table whose total is their parent.
+func (m *Module) children(r *rollup, nodes []*model.RegionNode) []*response.RegionRollupResponse {
+	rollups := make([]*response.RegionRollupResponse, 0, len(nodes))
+	for _, node := range nodes {
+		rollups = append(rollups, toRollup(node, r.counts[node.Code]))
+	}
+	m.disclose(rollups...)
+	return rollups
+}
+
+// disclose applies disclosure control to the rollups as region results
+// published together.
+func (m *Module) disclose(rollups ...*response.RegionRollupResponse) {
+	rows := make([]*response.RegionVoteResultResponse, len(rollups))
+	for i, rollup := range rollups {
+		rows[i] = &response.RegionVoteResultResponse{
+			Region:          rollup.Code,
+			TotalVotes:      rollup.TotalVotes,
+			ConfirmedVotes:  rollup.ConfirmedVotes,
+			FinalizingVotes: rollup.FinalizingVotes,
+			PendingVotes:    rollup.PendingVotes,
+			ErrorVotes:      rollup.ErrorVotes,
+		}
+	}
+	m.disclosure.Regions(rows...)
+
+	for i, rollup := range rollups {
+		rollup.TotalVotes = rows[i].TotalVotes
+		rollup.ConfirmedVotes = rows[i].ConfirmedVotes
+		rollup.FinalizingVotes = rows[i].FinalizingVotes
+		rollup.PendingVotes = rows[i].PendingVotes
+		rollup.ErrorVotes = rows[i].ErrorVotes
+		rollup.Suppressed = rows[i].Suppressed
+		rollup.RoundedTo = rows[i].RoundedTo
+	}
+}
+
+func toRollup(node *model.RegionNode, counts *model.RegionResult) *response.RegionRollupResponse {
+	rollup := &response.RegionRollupResponse{
+		Code:       node.Code,
+		Name:       node.Name,
+		Level:      node.Level,
+		ParentCode: node.ParentCode,
+	}
+	if counts != nil {
+		rollup.TotalVotes = counts.TotalVotes
+		rollup.ConfirmedVotes = counts.ConfirmedVotes
+		rollup.FinalizingVotes = counts.FinalizingVotes
+		rollup.PendingVotes = counts.PendingVotes
+		rollup.ErrorVotes = counts.ErrorVotes
+		rollup.LastUpdated = counts.LastUpdated
+	}
+	return rollup
+}
+
+func add(sum, result *model.RegionResult) {
+	sum.TotalVotes += result.TotalVotes
+	sum.ConfirmedVotes += result.ConfirmedVotes
+	sum.FinalizingVotes += result.FinalizingVotes
+	sum.PendingVotes += result.PendingVotes
+	sum.ErrorVotes += result.ErrorVotes
+	if result.LastUpdated.After(sum.LastUpdated) {
+		sum.LastUpdated = result.LastUpdated
+	}
+}
+
+func errNotConfigured() error {
+	return &custerr.ErrChain{
+		Message: "region hierarchy is not configured",
+		Code:    404,
+		Type:    response2.ErrNotFound,
+	}
+}
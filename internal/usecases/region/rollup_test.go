@@ -0,0 +1,208 @@
+package region
+
+import (
+	"context"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/registry"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"testing"
+	"time"
+)
+
+type voteResultRepo struct {
+	repository.VoteResultRepository
+
+	results []*model.RegionResult
+}
+
+func (r *voteResultRepo) GetRegionStatistics(_ context.Context) ([]*model.RegionResult, error) {
+	return r.results, nil
+}
+
+type counts struct {
+	total, confirmed, finalizing, pending, errors uint64
+}
+
+func (c counts) add(other counts) counts {
+	return counts{
+		c.total + other.total,
+		c.confirmed + other.confirmed,
+		c.finalizing + other.finalizing,
+		c.pending + other.pending,
+		c.errors + other.errors,
+	}
+}
+
+func rollupCounts(r *response.RegionRollupResponse) counts {
+	return counts{r.TotalVotes, r.ConfirmedVotes, r.FinalizingVotes, r.PendingVotes, r.ErrorVotes}
+}
+
+func result(region string, c counts) *model.RegionResult {
+	return &model.RegionResult{
+		Region:          region,
+		TotalVotes:      c.total,
+		ConfirmedVotes:  c.confirmed,
+		FinalizingVotes: c.finalizing,
+		PendingVotes:    c.pending,
+		ErrorVotes:      c.errors,
+		LastUpdated:     time.Date(2024, 2, 14, 13, 0, 0, 0, time.UTC),
+	}
+}
+
+func testModule(t *testing.T) *Module {
+	t.Helper()
+
+	hierarchy, err := registry.NewRegionHierarchy([]*model.RegionNode{{
+		Code: "31",
+		Children: []*model.RegionNode{{
+			Code: "31.71",
+			Children: []*model.RegionNode{{
+				Code: "31.71.01",
+				Children: []*model.RegionNode{
+					{Code: "31.71.01.001", Aliases: []string{"TPS 001 Gambir"}},
+					{Code: "31.71.01.002", Aliases: []string{"TPS 002 Gambir"}},
+				},
+			}, {
+				Code:     "31.71.02",
+				Children: []*model.RegionNode{{Code: "31.71.02.001"}},
+			}},
+		}, {
+			Code: "31.73",
+			Children: []*model.RegionNode{{
+				Code:     "31.73.01",
+				Children: []*model.RegionNode{{Code: "31.73.01.001"}},
+			}},
+		}},
+	}, {
+		Code: "32",
+		Children: []*model.RegionNode{{
+			Code: "32.73",
+			Children: []*model.RegionNode{{
+				Code:     "32.73.01",
+				Children: []*model.RegionNode{{Code: "32.73.01.001"}, {Code: "32.73.01.002"}},
+			}},
+		}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return New(&Options{
+		VoteResultRepo: &voteResultRepo{results: []*model.RegionResult{
+			result("31.71.01.001", counts{10, 6, 2, 1, 1}),
+			result("TPS 001 Gambir", counts{5, 3, 1, 1, 0}),
+			result(" tps 002 gambir", counts{4, 4, 0, 0, 0}),
+			result("31.71.02.001", counts{7, 5, 1, 0, 1}),
+			result("31.73.01.001", counts{3, 2, 0, 1, 0}),
+			result("32.73.01.001", counts{8, 8, 0, 0, 0}),
+			result("Unmapped Station", counts{6, 6, 0, 0, 0}),
+			result("31.71.01", counts{2, 1, 1, 0, 0}),
+		}},
+		Hierarchy: hierarchy,
+	}).(*Module)
+}
+
+// TestRollupParentsSumChildren walks the hierarchy down from the provinces and
+// checks every region counts exactly the votes of its children, and every
+// polling station those of the regions matching its code or aliases.
+func TestRollupParentsSumChildren(t *testing.T) {
+	m := testModule(t)
+	ctx := context.Background()
+
+	stations := map[string]counts{
+		"31.71.01.001": {15, 9, 3, 2, 1},
+		"31.71.01.002": {4, 4, 0, 0, 0},
+		"31.71.02.001": {7, 5, 1, 0, 1},
+		"31.73.01.001": {3, 2, 0, 1, 0},
+		"32.73.01.001": {8, 8, 0, 0, 0},
+	}
+	levels := make(map[string]bool)
+
+	var walk func(code string) counts
+	walk = func(code string) counts {
+		t.Helper()
+		res, err := m.GetRegionChildren(ctx, code)
+		if err != nil {
+			t.Fatalf("GetRegionChildren(%s) = %v", code, err)
+		}
+		levels[res.Region.Level] = true
+
+		got := rollupCounts(res.Region)
+		if len(res.Children) == 0 {
+			if got != stations[code] {
+				t.Errorf("polling station %s counts %+v, want %+v", code, got, stations[code])
+			}
+			return got
+		}
+
+		var sum counts
+		for _, child := range res.Children {
+			if child.ParentCode != code {
+				t.Errorf("child %s of %s has parent %s", child.Code, code, child.ParentCode)
+			}
+			if rollupCounts(child) != walk(child.Code) {
+				t.Errorf("child %s of %s counts %+v, unlike its own rollup", child.Code, code, rollupCounts(child))
+			}
+			sum = sum.add(rollupCounts(child))
+		}
+		if got != sum {
+			t.Errorf("%s %s counts %+v, want the sum of its children %+v", res.Region.Level, code, got, sum)
+		}
+		return got
+	}
+
+	res, err := m.GetRegionHierarchy(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var national counts
+	for _, province := range res.Provinces {
+		if rollupCounts(province) != walk(province.Code) {
+			t.Errorf("province %s counts %+v, unlike its own rollup", province.Code, rollupCounts(province))
+		}
+		national = national.add(rollupCounts(province))
+	}
+
+	got := counts{res.TotalVotes, res.ConfirmedVotes, res.FinalizingVotes, res.PendingVotes, res.ErrorVotes}
+	if want := (counts{37, 28, 4, 3, 2}); got != want || national != want {
+		t.Errorf("national counts %+v and provinces sum to %+v, want %+v", got, national, want)
+	}
+	for _, level := range model.RegionLevels {
+		if !levels[level] {
+			t.Errorf("no %s was rolled up", level)
+		}
+	}
+}
+
+func TestRollupKeepsUnmappedRegionsOut(t *testing.T) {
+	m := testModule(t)
+
+	res, err := m.GetRegionHierarchy(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A district code is no polling station, so its votes are unmapped too.
+	want := map[string]counts{
+		"Unmapped Station": {6, 6, 0, 0, 0},
+		"31.71.01":         {2, 1, 1, 0, 0},
+	}
+	if len(res.UnmappedRegions) != len(want) {
+		t.Fatalf("unmapped regions = %d, want %d", len(res.UnmappedRegions), len(want))
+	}
+	for _, region := range res.UnmappedRegions {
+		got := counts{region.TotalVotes, region.ConfirmedVotes, region.FinalizingVotes, region.PendingVotes, region.ErrorVotes}
+		if got != want[region.Region] {
+			t.Errorf("unmapped region %q counts %+v, want %+v", region.Region, got, want[region.Region])
+		}
+	}
+
+	district, err := m.GetRegionChildren(context.Background(), "31.71.01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if district.Region.TotalVotes != 19 {
+		t.Errorf("district 31.71.01 counts %d votes, want the 19 of its polling stations", district.Region.TotalVotes)
+	}
+}
@@ -7,3 +7,74 @@ type VoteResultEntry struct {
 	Region          string `json:"region"`
 	TransactionHash string `json:"transaction_hash"`
 }
+
+type ExportRequest struct {
+	Format         string `json:"format" example:"csv"`
+	ElectionPairID string `json:"election_pair_id"`
+	Region         string `json:"region"`
+	Status         string `json:"status"`
+	StartDate      string `json:"start_date" example:"2025-01-01"`
+	EndDate        string `json:"end_date" example:"2025-01-31"`
+}
+
+type TimeSeriesRequest struct {
+	TimeZone       string `json:"tz" example:"Asia/Makassar"`
+	Bucket         string `json:"bucket" example:"hour"`
+	Axis           string `json:"axis" example:"voted_at"`
+	StartDate      string `json:"start_date" example:"2025-01-01"`
+	EndDate        string `json:"end_date" example:"2025-01-02"`
+	ElectionPairID string `json:"election_pair_id"`
+	Region         string `json:"region"`
+}
+
+type PseudonymLookupRequest struct {
+	Pseudonym string `json:"pseudonym" example:"vp_4f9c2a7d1e8b3c6a5d0f7e2b9a8c1d3e"`
+	Reason    string `json:"reason" example:"court order 2025/123"`
+}
+
+type AnomalyRequest struct {
+	Kind           string `json:"kind" example:"region_spike"`
+	ElectionPairID string `json:"election_pair_id"`
+	Region         string `json:"region"`
+	StartDate      string `json:"start_date" example:"2025-01-01T00:00:00Z"`
+	EndDate        string `json:"end_date" example:"2025-01-02T00:00:00Z"`
+	Limit          int    `json:"limit"`
+	Offset         int    `json:"offset"`
+}
+
+type WebhookSubscriptionRequest struct {
+	URL            string   `json:"url" example:"https://partner.example.com/hooks/results"`
+	Secret         string   `json:"secret,omitempty"`
+	EventTypes     []string `json:"event_types" example:"election_update,region_update"`
+	ElectionPairID string   `json:"election_pair_id"`
+	Region         string   `json:"region"`
+	Active         *bool    `json:"active,omitempty"`
+}
+
+type ElectionPairRequest struct {
+	// ID is the election_pair_id votes carry. It is only read on create.
+	ID              string `json:"id" example:"pair-2029-01"`
+	ElectionID      string `json:"election_id" example:"presidential-2029"`
+	PairNumber      int    `json:"pair_number" example:"1"`
+	CandidateName   string `json:"candidate_name"`
+	RunningMateName string `json:"running_mate_name"`
+	Party           string `json:"party"`
+}
+
+type RegisteredVotersRequest struct {
+	// ElectionID is left empty for a count applying to every election held in the region.
+	ElectionID       string `json:"election_id" example:"presidential-2029"`
+	Region           string `json:"region"`
+	RegisteredVoters uint64 `json:"registered_voters" example:"290"`
+}
+
+type RegisteredVotersImportRequest struct {
+	RegisteredVoters []*RegisteredVotersRequest `json:"registered_voters"`
+}
+
+type CertificationRequest struct {
+	// Scope is region or election.
+	Scope      string `json:"scope" example:"region"`
+	Region     string `json:"region"`
+	ElectionID string `json:"election_id" example:"presidential-2029"`
+}
@@ -1,6 +1,10 @@
 package response
 
-import "time"
+import (
+	"encoding/json"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"time"
+)
 
 type VoteResultResponse struct {
 	ID              string     `json:"id"`
@@ -14,32 +18,372 @@ type VoteResultResponse struct {
 	ProcessedAt     *time.Time `json:"processed_at,omitempty"`
 	CreatedAt       time.Time  `json:"created_at"`
 	UpdatedAt       time.Time  `json:"updated_at"`
+	// ChainStatus is the outcome of checking the transaction on chain, with the
+	// block it was mined in. Unset until the vote is reconciled.
+	ChainStatus string     `json:"chain_status,omitempty" example:"verified"`
+	BlockNumber *uint64    `json:"block_number,omitempty"`
+	BlockHash   string     `json:"block_hash,omitempty"`
+	BlockTime   *time.Time `json:"block_time,omitempty"`
 }
 
+// ElectionVoteResultResponse counts the votes of an election pair by status.
+// ConfirmedVotes are final; FinalizingVotes are confirmed too but provisional
+// until their block is deep enough that a reorg can no longer drop them.
 type ElectionVoteResultResponse struct {
-	ElectionPairID string    `json:"election_pair_id"`
-	Region         string    `json:"region"`
-	TotalVotes     uint64    `json:"total_votes"`
-	ConfirmedVotes uint64    `json:"confirmed_votes"`
-	PendingVotes   uint64    `json:"pending_votes"`
-	ErrorVotes     uint64    `json:"error_votes"`
-	LastUpdated    time.Time `json:"last_updated"`
+	ElectionPairID  string    `json:"election_pair_id"`
+	Region          string    `json:"region"`
+	TotalVotes      uint64    `json:"total_votes"`
+	ConfirmedVotes  uint64    `json:"confirmed_votes"`
+	FinalizingVotes uint64    `json:"finalizing_votes"`
+	PendingVotes    uint64    `json:"pending_votes"`
+	ErrorVotes      uint64    `json:"error_votes"`
+	LastUpdated     time.Time `json:"last_updated"`
+	// Suppressed lists the counts withheld by disclosure control, which read 0.
+	Suppressed []string `json:"suppressed,omitempty"`
+	// RoundedTo is the base the counts were rounded to by disclosure control.
+	RoundedTo uint64 `json:"rounded_to,omitempty"`
+	// CertificationID is set when the counts are those of a certified snapshot.
+	CertificationID string `json:"certification_id,omitempty"`
 }
 
 type RegionVoteResultResponse struct {
-	Region         string    `json:"region"`
-	TotalVotes     uint64    `json:"total_votes"`
-	ConfirmedVotes uint64    `json:"confirmed_votes"`
-	PendingVotes   uint64    `json:"pending_votes"`
-	ErrorVotes     uint64    `json:"error_votes"`
-	LastUpdated    time.Time `json:"last_updated"`
+	Region          string    `json:"region"`
+	TotalVotes      uint64    `json:"total_votes"`
+	ConfirmedVotes  uint64    `json:"confirmed_votes"`
+	FinalizingVotes uint64    `json:"finalizing_votes"`
+	PendingVotes    uint64    `json:"pending_votes"`
+	ErrorVotes      uint64    `json:"error_votes"`
+	LastUpdated     time.Time `json:"last_updated"`
+	// Suppressed lists the counts withheld by disclosure control, which read 0.
+	Suppressed []string `json:"suppressed,omitempty"`
+	// RoundedTo is the base the counts were rounded to by disclosure control.
+	RoundedTo uint64 `json:"rounded_to,omitempty"`
+	// RegisteredVoters is unset when no registered voter count is imported for
+	// the region. TurnoutPercent is the total votes over it, unset as well when
+	// the total is withheld.
+	RegisteredVoters uint64   `json:"registered_voters,omitempty"`
+	TurnoutPercent   *float64 `json:"turnout_percent,omitempty"`
+	// CertificationID is set when the counts are those of a certified snapshot.
+	CertificationID string `json:"certification_id,omitempty"`
 }
 
 type VoteStatisticsResponse struct {
-	TotalVotes     uint64    `json:"total_votes"`
-	ConfirmedVotes uint64    `json:"confirmed_votes"`
-	PendingVotes   uint64    `json:"pending_votes"`
-	ErrorVotes     uint64    `json:"error_votes"`
-	SuccessRate    float64   `json:"success_rate"`
-	LastUpdated    time.Time `json:"last_updated"`
+	TotalVotes      uint64    `json:"total_votes"`
+	ConfirmedVotes  uint64    `json:"confirmed_votes"`
+	FinalizingVotes uint64    `json:"finalizing_votes"`
+	PendingVotes    uint64    `json:"pending_votes"`
+	ErrorVotes      uint64    `json:"error_votes"`
+	SuccessRate     float64   `json:"success_rate"`
+	LastUpdated     time.Time `json:"last_updated"`
+}
+
+type TimeSeriesResponse struct {
+	TimeZone       string                     `json:"tz"`
+	Bucket         string                     `json:"bucket"`
+	Axis           string                     `json:"axis"`
+	Start          time.Time                  `json:"start"`
+	End            time.Time                  `json:"end"`
+	ElectionPairID string                     `json:"election_pair_id,omitempty"`
+	Region         string                     `json:"region,omitempty"`
+	Points         []*TimeSeriesPointResponse `json:"points"`
+}
+
+// TimeSeriesPointResponse is one bucket of a series. Timestamp is the bucket start
+// with the offset of the requested time zone.
+type TimeSeriesPointResponse struct {
+	Timestamp       time.Time `json:"timestamp"`
+	TotalVotes      uint64    `json:"total_votes"`
+	ConfirmedVotes  uint64    `json:"confirmed_votes"`
+	FinalizingVotes uint64    `json:"finalizing_votes"`
+	PendingVotes    uint64    `json:"pending_votes"`
+	ErrorVotes      uint64    `json:"error_votes"`
+}
+
+// VoteBreakdownResponse holds vote counts per bucket, election pair, region and
+// status. Only combinations with at least one vote are listed.
+type VoteBreakdownResponse struct {
+	TimeZone       string                     `json:"tz"`
+	Bucket         string                     `json:"bucket"`
+	Axis           string                     `json:"axis"`
+	Start          time.Time                  `json:"start"`
+	End            time.Time                  `json:"end"`
+	ElectionPairID string                     `json:"election_pair_id,omitempty"`
+	Region         string                     `json:"region,omitempty"`
+	Items          []*VoteBucketCountResponse `json:"items"`
+}
+
+type VoteBucketCountResponse struct {
+	Timestamp      time.Time `json:"timestamp"`
+	ElectionPairID string    `json:"election_pair_id"`
+	Region         string    `json:"region"`
+	Status         string    `json:"status"`
+	Votes          uint64    `json:"votes"`
+}
+
+type ExportJobResponse struct {
+	ID          string                `json:"id"`
+	Status      string                `json:"status"`
+	Format      string                `json:"format"`
+	Filter      request.ExportRequest `json:"filter"`
+	TotalRows   uint64                `json:"total_rows"`
+	RowsWritten uint64                `json:"rows_written"`
+	Error       string                `json:"error,omitempty"`
+	CreatedAt   time.Time             `json:"created_at"`
+	StartedAt   *time.Time            `json:"started_at,omitempty"`
+	CompletedAt *time.Time            `json:"completed_at,omitempty"`
+	ExpiresAt   *time.Time            `json:"expires_at,omitempty"`
+}
+
+type ExportFileResponse struct {
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	Path        string `json:"-"`
+}
+
+type PseudonymLookupResponse struct {
+	Pseudonym string    `json:"pseudonym"`
+	VoterID   string    `json:"voter_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type AnomalyResponse struct {
+	ID              string    `json:"id"`
+	Kind            string    `json:"kind"`
+	ElectionPairID  string    `json:"election_pair_id,omitempty"`
+	Region          string    `json:"region,omitempty"`
+	VoteID          string    `json:"vote_id,omitempty"`
+	VoterID         string    `json:"voter_id,omitempty"`
+	TransactionHash string    `json:"transaction_hash,omitempty"`
+	Value           float64   `json:"value"`
+	Baseline        float64   `json:"baseline"`
+	Description     string    `json:"description"`
+	DetectedAt      time.Time `json:"detected_at"`
+}
+
+type WebhookSubscriptionResponse struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+	// Secret is only returned when the subscription is created.
+	Secret              string     `json:"secret,omitempty"`
+	EventTypes          []string   `json:"event_types"`
+	ElectionPairID      string     `json:"election_pair_id,omitempty"`
+	Region              string     `json:"region,omitempty"`
+	Active              bool       `json:"active"`
+	ConsecutiveFailures uint64     `json:"consecutive_failures"`
+	LastError           string     `json:"last_error,omitempty"`
+	LastSuccessAt       *time.Time `json:"last_success_at,omitempty"`
+	LastFailureAt       *time.Time `json:"last_failure_at,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID             string    `json:"id"`
+	DeliveryID     string    `json:"delivery_id"`
+	SubscriptionID string    `json:"subscription_id"`
+	EventType      string    `json:"event_type"`
+	Attempt        int       `json:"attempt"`
+	Status         string    `json:"status"`
+	ResponseCode   int       `json:"response_code,omitempty"`
+	Error          string    `json:"error,omitempty"`
+	DurationMs     int64     `json:"duration_ms"`
+	AttemptedAt    time.Time `json:"attempted_at"`
+}
+
+type ElectionPairResponse struct {
+	ID              string    `json:"id"`
+	ElectionID      string    `json:"election_id"`
+	PairNumber      int       `json:"pair_number"`
+	CandidateName   string    `json:"candidate_name"`
+	RunningMateName string    `json:"running_mate_name,omitempty"`
+	Party           string    `json:"party,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// StandingsResponse ranks the election pairs of an election by confirmed votes,
+// nationally or in one region.
+type StandingsResponse struct {
+	ElectionID string `json:"election_id"`
+	// Region is empty for national standings.
+	Region     string                       `json:"region,omitempty"`
+	TotalVotes uint64                       `json:"total_votes"`
+	Standings  []*CandidateStandingResponse `json:"standings"`
+	// Winner is the leading pair, unset while the lead is tied or no vote is counted.
+	Winner *CandidateStandingResponse `json:"winner,omitempty"`
+	// Margin is the lead of the first pair over the second, in votes and in
+	// percentage points of the vote share.
+	Margin        uint64  `json:"margin"`
+	MarginPercent float64 `json:"margin_percent"`
+	Tie           bool    `json:"tie"`
+	// Suppressed is set when disclosure control withheld the votes of a pair,
+	// which is then ranked last without a vote share.
+	Suppressed bool `json:"suppressed,omitempty"`
+	// RegisteredVoters is unset when no registered voter count is imported for
+	// the election. TurnoutPercent counts every vote received for the election,
+	// whatever its status, and is unset when a count was withheld.
+	RegisteredVoters uint64   `json:"registered_voters,omitempty"`
+	TurnoutPercent   *float64 `json:"turnout_percent,omitempty"`
+	// CertificationID is set when the standings rank a certified snapshot.
+	CertificationID string    `json:"certification_id,omitempty"`
+	LastUpdated     time.Time `json:"last_updated"`
+}
+
+type CandidateStandingResponse struct {
+	// Rank is shared by tied pairs, and the next rank skips accordingly.
+	Rank            int    `json:"rank"`
+	ElectionPairID  string `json:"election_pair_id"`
+	PairNumber      int    `json:"pair_number"`
+	CandidateName   string `json:"candidate_name"`
+	RunningMateName string `json:"running_mate_name,omitempty"`
+	Party           string `json:"party,omitempty"`
+	// Votes counts the confirmed votes of the pair, FinalizingVotes of them
+	// still provisional until their block is deep enough.
+	Votes           uint64 `json:"votes"`
+	FinalizingVotes uint64 `json:"finalizing_votes,omitempty"`
+	// SharePercent has two decimals, and the shares of a standing sum to exactly 100.
+	SharePercent float64 `json:"share_percent"`
+	Suppressed   bool    `json:"suppressed,omitempty"`
+}
+
+// RegionRollupResponse is the vote count of a region of the hierarchy. Before
+// disclosure control, its counts are the sum of the counts of its children.
+type RegionRollupResponse struct {
+	Code            string    `json:"code"`
+	Name            string    `json:"name"`
+	Level           string    `json:"level"`
+	ParentCode      string    `json:"parent_code,omitempty"`
+	TotalVotes      uint64    `json:"total_votes"`
+	ConfirmedVotes  uint64    `json:"confirmed_votes"`
+	FinalizingVotes uint64    `json:"finalizing_votes"`
+	PendingVotes    uint64    `json:"pending_votes"`
+	ErrorVotes      uint64    `json:"error_votes"`
+	LastUpdated     time.Time `json:"last_updated"`
+	// Suppressed lists the counts withheld by disclosure control, which read 0.
+	Suppressed []string `json:"suppressed,omitempty"`
+	// RoundedTo is the base the counts were rounded to by disclosure control.
+	RoundedTo uint64 `json:"rounded_to,omitempty"`
+}
+
+// RegionChildrenResponse drills down one level of the region hierarchy.
+type RegionChildrenResponse struct {
+	Region *RegionRollupResponse `json:"region"`
+	// Path lists the ancestors of the region from the province down.
+	Path     []*RegionNodeResponse   `json:"path,omitempty"`
+	Children []*RegionRollupResponse `json:"children"`
+}
+
+type RegionNodeResponse struct {
+	Code  string `json:"code"`
+	Name  string `json:"name"`
+	Level string `json:"level"`
+}
+
+// RegionHierarchyResponse is the top of the region hierarchy: the national
+// count and the provinces it is the sum of.
+type RegionHierarchyResponse struct {
+	TotalVotes      uint64                  `json:"total_votes"`
+	ConfirmedVotes  uint64                  `json:"confirmed_votes"`
+	FinalizingVotes uint64                  `json:"finalizing_votes"`
+	PendingVotes    uint64                  `json:"pending_votes"`
+	ErrorVotes      uint64                  `json:"error_votes"`
+	LastUpdated     time.Time               `json:"last_updated"`
+	Provinces       []*RegionRollupResponse `json:"provinces"`
+	// UnmappedRegions are the regions votes carry that match no polling station.
+	// Their votes are left out of the hierarchy until the reference file maps them.
+	UnmappedRegions []*RegionVoteResultResponse `json:"unmapped_regions,omitempty"`
+}
+
+type RegisteredVotersResponse struct {
+	ElectionID       string    `json:"election_id,omitempty"`
+	Region           string    `json:"region"`
+	RegisteredVoters uint64    `json:"registered_voters"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+type RegisteredVotersImportResponse struct {
+	Imported int `json:"imported"`
+}
+
+// TurnoutLeaderboardResponse ranks regions by turnout.
+type TurnoutLeaderboardResponse struct {
+	// ElectionID is empty when every vote of a region counts.
+	ElectionID string                   `json:"election_id,omitempty"`
+	Regions    []*RegionTurnoutResponse `json:"regions"`
+	// Unranked counts the regions left out for want of a registered voter count
+	// or because disclosure control withheld their total.
+	Unranked int `json:"unranked"`
+}
+
+type RegionTurnoutResponse struct {
+	// Rank is shared by regions with the same turnout.
+	Rank             int     `json:"rank"`
+	Region           string  `json:"region"`
+	RegisteredVoters uint64  `json:"registered_voters"`
+	TotalVotes       uint64  `json:"total_votes"`
+	TurnoutPercent   float64 `json:"turnout_percent"`
+	// RoundedTo is the base the total was rounded to by disclosure control.
+	RoundedTo uint64 `json:"rounded_to,omitempty"`
+}
+
+type CertificationResponse struct {
+	ID            string    `json:"id"`
+	Scope         string    `json:"scope"`
+	Region        string    `json:"region,omitempty"`
+	ElectionID    string    `json:"election_id,omitempty"`
+	ContentHash   string    `json:"content_hash"`
+	CertifiedBy   string    `json:"certified_by"`
+	CertifierName string    `json:"certifier_name,omitempty"`
+	CertifiedAt   time.Time `json:"certified_at"`
+	// Snapshot is the certified content whose SHA-256 digest is ContentHash. It
+	// holds counts as certified, so it is left out for public callers while
+	// disclosure control is enabled.
+	Snapshot json.RawMessage `json:"snapshot,omitempty" swaggertype:"object"`
+}
+
+type QuarantinedEventResponse struct {
+	ID              string    `json:"id"`
+	CertificationID string    `json:"certification_id"`
+	Topic           string    `json:"topic"`
+	MessageKey      string    `json:"message_key,omitempty"`
+	VoteID          string    `json:"vote_id"`
+	ElectionPairID  string    `json:"election_pair_id,omitempty"`
+	Region          string    `json:"region,omitempty"`
+	Reason          string    `json:"reason"`
+	Payload         string    `json:"payload"`
+	QuarantinedAt   time.Time `json:"quarantined_at"`
+}
+
+type MerkleCommitmentResponse struct {
+	ID          string    `json:"id"`
+	ElectionID  string    `json:"election_id"`
+	Region      string    `json:"region"`
+	Root        string    `json:"root" example:"9f2c4e0a8d1b7c3e5f6a0b9d8c7e6f5a4b3c2d1e0f9a8b7c6d5e4f3a2b1c0d9e"`
+	LeafCount   uint64    `json:"leaf_count"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+// MerkleProofResponse proves a confirmed vote is a leaf of a published root.
+// The leaf is SHA-256(0x00 || vote_id || 0x00 || election_pair_id || 0x00 ||
+// region || 0x00 || transaction_hash), and each step of the path hashes
+// SHA-256(0x01 || left || right) with the sibling on its position.
+type MerkleProofResponse struct {
+	VoteID          string                     `json:"vote_id"`
+	ElectionID      string                     `json:"election_id"`
+	ElectionPairID  string                     `json:"election_pair_id"`
+	Region          string                     `json:"region"`
+	TransactionHash string                     `json:"transaction_hash"`
+	CommitmentID    string                     `json:"commitment_id"`
+	Root            string                     `json:"root"`
+	PublishedAt     time.Time                  `json:"published_at"`
+	LeafIndex       uint64                     `json:"leaf_index"`
+	LeafCount       uint64                     `json:"leaf_count"`
+	LeafHash        string                     `json:"leaf_hash"`
+	Path            []*MerkleProofStepResponse `json:"path"`
+}
+
+type MerkleProofStepResponse struct {
+	Hash string `json:"hash"`
+	// Position is left or right, the side the sibling is hashed on.
+	Position string `json:"position" example:"left"`
 }
@@ -0,0 +1,11 @@
+package usecases
+
+import (
+	"context"
+)
+
+type ResultFeedUseCases interface {
+	// MarkChanged queues the tally of the election pair in the region for publishing.
+	MarkChanged(electionPairID, region string)
+	Run(ctx context.Context)
+}
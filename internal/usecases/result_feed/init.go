@@ -0,0 +1,61 @@
+package result_feed
+
+import (
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/embargo"
+	"github.com/nocturna-ta/result/internal/infrastructures/kafka"
+	"github.com/nocturna-ta/result/internal/usecases"
+	"github.com/nocturna-ta/result/pkg/event"
+	"sync"
+	"time"
+)
+
+type Module struct {
+	voteResultRepo repository.VoteResultRepository
+	producer       *kafka.Producer
+	embargo        *embargo.Schedule
+	topic          string
+	cfg            config.ResultFeedConfig
+
+	mu      sync.Mutex
+	pending map[string]*tally
+	// published holds the last event published per key, to skip unchanged
+	// tallies and keep versions growing.
+	published map[string]*event.ResultUpdated
+}
+
+type tally struct {
+	electionPairID string
+	region         string
+	// passes is the number of flushes left to re-read the tally.
+	passes int
+}
+
+type Options struct {
+	VoteResultRepo repository.VoteResultRepository
+	Producer       *kafka.Producer
+	Embargo        *embargo.Schedule
+	Topic          string
+	Cfg            config.ResultFeedConfig
+}
+
+func New(opts *Options) usecases.ResultFeedUseCases {
+	cfg := opts.Cfg
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = time.Second
+	}
+	if cfg.Settle <= 0 {
+		cfg.Settle = 1
+	}
+
+	return &Module{
+		voteResultRepo: opts.VoteResultRepo,
+		producer:       opts.Producer,
+		embargo:        opts.Embargo,
+		topic:          opts.Topic,
+		cfg:            cfg,
+		pending:        make(map[string]*tally),
+		published:      make(map[string]*event.ResultUpdated),
+	}
+}
@@ -0,0 +1,141 @@
+package result_feed
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/nocturna-ta/golib/log"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
+	"github.com/nocturna-ta/result/internal/interfaces/dao"
+	"github.com/nocturna-ta/result/pkg/event"
+	"time"
+)
+
+func (m *Module) MarkChanged(electionPairID, region string) {
+	if electionPairID == "" && region == "" {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := event.ResultUpdatedKey(electionPairID, region)
+	if t, ok := m.pending[key]; ok {
+		t.passes = m.cfg.Settle
+		return
+	}
+	m.pending[key] = &tally{
+		electionPairID: electionPairID,
+		region:         region,
+		passes:         m.cfg.Settle,
+	}
+}
+
+// Run publishes the changed tallies every flush interval until ctx is done.
+// Tallies are published from this goroutine only, so events of a key reach the
+// topic in version order.
+func (m *Module) Run(ctx context.Context) {
+	if !m.cfg.Enabled || m.producer == nil {
+		return
+	}
+
+	ticker := time.NewTicker(m.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.flush(ctx)
+		}
+	}
+}
+
+func (m *Module) flush(ctx context.Context) {
+	now := time.Now()
+
+	var due []tally
+	m.mu.Lock()
+	for key, t := range m.pending {
+		due = append(due, *t)
+		t.passes--
+		if t.passes <= 0 {
+			delete(m.pending, key)
+		}
+	}
+	m.mu.Unlock()
+
+	for _, t := range due {
+		// Embargoed tallies wait, and are published once the embargo lifts.
+		if _, embargoed := m.embargo.Embargoed(t.electionPairID, t.region, now); embargoed {
+			m.MarkChanged(t.electionPairID, t.region)
+			continue
+		}
+
+		if err := m.publish(ctx, t.electionPairID, t.region); err != nil {
+			metrics.ResultUpdatesPublished.WithLabelValues("failed").Inc()
+			log.WithFields(log.Fields{
+				"error":            err,
+				"election_pair_id": t.electionPairID,
+				"region":           t.region,
+			}).ErrorWithCtx(ctx, "[ResultFeedUseCases.Run] Failed to publish result update, retrying on the next flush")
+			m.MarkChanged(t.electionPairID, t.region)
+		}
+	}
+}
+
+// publish reads the tally and publishes it when it differs from the last event
+// published for its key.
+func (m *Module) publish(ctx context.Context, electionPairID, region string) error {
+	key := event.ResultUpdatedKey(electionPairID, region)
+	last := m.published[key]
+
+	updated := &event.ResultUpdated{
+		ElectionPairID: electionPairID,
+		Region:         region,
+		UpdatedAt:      time.Now(),
+	}
+
+	result, err := m.voteResultRepo.GetElectionRegionResult(ctx, electionPairID, region)
+	switch {
+	case errors.Is(err, dao.ErrNoResult):
+		// Every vote moved away; publish the empty tally once it was announced.
+		if last == nil {
+			return nil
+		}
+	case err != nil:
+		return err
+	default:
+		updated.Counts = event.ResultCounts{
+			Total:      result.TotalVotes,
+			Pending:    result.PendingVotes,
+			Confirmed:  result.ConfirmedVotes,
+			Finalizing: result.FinalizingVotes,
+			Error:      result.ErrorVotes,
+		}
+		updated.Version = result.LastUpdated.UnixNano()
+		updated.UpdatedAt = result.LastUpdated
+	}
+
+	if last != nil {
+		if last.Counts == updated.Counts {
+			return nil
+		}
+		if updated.Version <= last.Version {
+			updated.Version = last.Version + 1
+		}
+	}
+
+	payload, err := json.Marshal(updated)
+	if err != nil {
+		return err
+	}
+	if err = m.producer.Publish(ctx, m.topic, key, payload); err != nil {
+		return err
+	}
+
+	m.published[key] = updated
+	metrics.ResultUpdatesPublished.WithLabelValues("published").Inc()
+	return nil
+}
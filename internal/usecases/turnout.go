@@ -0,0 +1,18 @@
+package usecases
+
+import (
+	"context"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+)
+
+type TurnoutUseCases interface {
+	// Registered voters
+	ImportRegisteredVoters(ctx context.Context, req *request.RegisteredVotersImportRequest) (*response.RegisteredVotersImportResponse, error)
+	ImportRegisteredVoterCounts(ctx context.Context, counts []*model.RegisteredVoters) error
+	GetRegisteredVoters(ctx context.Context, electionID string) ([]*response.RegisteredVotersResponse, error)
+	DeleteRegisteredVoters(ctx context.Context, electionID, region string) error
+
+	GetTurnoutLeaderboard(ctx context.Context, electionID string, limit int, ascending bool) (*response.TurnoutLeaderboardResponse, error)
+}
@@ -0,0 +1,38 @@
+package turnout
+
+import (
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/disclosure"
+	"github.com/nocturna-ta/result/internal/infrastructures/electorate"
+	"github.com/nocturna-ta/result/internal/infrastructures/embargo"
+	"github.com/nocturna-ta/result/internal/usecases"
+)
+
+type Module struct {
+	registeredVotersRepo repository.RegisteredVotersRepository
+	electionPairRepo     repository.ElectionPairRepository
+	voteResultRepo       repository.VoteResultRepository
+	electorate           *electorate.Register
+	embargo              *embargo.Schedule
+	disclosure           *disclosure.Control
+}
+
+type Options struct {
+	RegisteredVotersRepo repository.RegisteredVotersRepository
+	ElectionPairRepo     repository.ElectionPairRepository
+	VoteResultRepo       repository.VoteResultRepository
+	Electorate           *electorate.Register
+	Embargo              *embargo.Schedule
+	Disclosure           *disclosure.Control
+}
+
+func New(opts *Options) usecases.TurnoutUseCases {
+	return &Module{
+		registeredVotersRepo: opts.RegisteredVotersRepo,
+		electionPairRepo:     opts.ElectionPairRepo,
+		voteResultRepo:       opts.VoteResultRepo,
+		electorate:           opts.Electorate,
+		embargo:              opts.Embargo,
+		disclosure:           opts.Disclosure,
+	}
+}
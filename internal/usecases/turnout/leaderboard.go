@@ -0,0 +1,222 @@
+package turnout
+
+import (
+	"context"
+	"errors"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/golib/log"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/infrastructures/electorate"
+	"github.com/nocturna-ta/result/internal/infrastructures/embargo"
+	"github.com/nocturna-ta/result/internal/interfaces/dao"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"sort"
+	"time"
+)
+
+const (
+	defaultLeaderboardLimit = 20
+	maxLeaderboardLimit     = 1000
+)
+
+// GetTurnoutLeaderboard ranks the regions with registered voters by turnout,
+// highest first unless ascending. With an election, only the votes for its
+// registered pairs count. Regions under embargo are left out.
+func (m *Module) GetTurnoutLeaderboard(ctx context.Context, electionID string, limit int, ascending bool) (*response.TurnoutLeaderboardResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "TurnoutUseCases.GetTurnoutLeaderboard")
+	defer span.End()
+
+	switch {
+	case limit <= 0:
+		limit = defaultLeaderboardLimit
+	case limit > maxLeaderboardLimit:
+		limit = maxLeaderboardLimit
+	}
+
+	registered := m.electorate.Counts(ctx, electionID)
+	if len(registered) == 0 {
+		return nil, &custerr.ErrChain{
+			Message: "no registered voters imported",
+			Code:    404,
+			Type:    response2.ErrNotFound,
+		}
+	}
+
+	votes, pairIDs, err := m.regionVotes(ctx, electionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		rows           []*response.RegionVoteResultResponse
+		embargoedUntil time.Time
+		checkedAt      = time.Now()
+		privileged     = embargo.Privileged(ctx)
+		res            = &response.TurnoutLeaderboardResponse{ElectionID: electionID}
+	)
+	regions := make(map[string]bool, len(registered)+len(votes))
+	for region := range registered {
+		regions[region] = true
+	}
+	for region := range votes {
+		regions[region] = true
+	}
+	for region := range regions {
+		if until, embargoed := m.embargoed(pairIDs, region, checkedAt); embargoed && !privileged {
+			if until.After(embargoedUntil) {
+				embargoedUntil = until
+			}
+			continue
+		}
+
+		row := &response.RegionVoteResultResponse{Region: region}
+		if result, ok := votes[region]; ok {
+			row.TotalVotes = result.TotalVotes
+			row.ConfirmedVotes = result.ConfirmedVotes
+			row.FinalizingVotes = result.FinalizingVotes
+			row.PendingVotes = result.PendingVotes
+			row.ErrorVotes = result.ErrorVotes
+		}
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 && !embargoedUntil.IsZero() {
+		return nil, embargo.Error(embargoedUntil)
+	}
+
+	m.disclosure.Regions(rows...)
+
+	for _, row := range rows {
+		count, ok := registered[row.Region]
+		if !ok || count == 0 || withheld(row) {
+			res.Unranked++
+			continue
+		}
+		res.Regions = append(res.Regions, &response.RegionTurnoutResponse{
+			Region:           row.Region,
+			RegisteredVoters: count,
+			TotalVotes:       row.TotalVotes,
+			TurnoutPercent:   *electorate.Percent(row.TotalVotes, count),
+			RoundedTo:        row.RoundedTo,
+		})
+	}
+
+	sort.Slice(res.Regions, func(i, j int) bool {
+		a, b := res.Regions[i], res.Regions[j]
+		if a.TurnoutPercent != b.TurnoutPercent {
+			return (a.TurnoutPercent > b.TurnoutPercent) != ascending
+		}
+		return a.Region < b.Region
+	})
+	for i, region := range res.Regions {
+		region.Rank = i + 1
+		if i > 0 && region.TurnoutPercent == res.Regions[i-1].TurnoutPercent {
+			region.Rank = res.Regions[i-1].Rank
+		}
+	}
+	if len(res.Regions) > limit {
+		res.Regions = res.Regions[:limit]
+	}
+	if res.Regions == nil {
+		res.Regions = []*response.RegionTurnoutResponse{}
+	}
+
+	return res, nil
+}
+
+// regionVotes returns the votes of every region, only those for the pairs of
+// the election when one is given, with the pairs counted.
+func (m *Module) regionVotes(ctx context.Context, electionID string) (map[string]*model.RegionResult, []string, error) {
+	votes := make(map[string]*model.RegionResult)
+
+	if electionID == "" {
+		results, err := m.voteResultRepo.GetRegionStatistics(ctx)
+		if err != nil && !errors.Is(err, dao.ErrNoResult) {
+			log.WithFields(log.Fields{
+				"error": err,
+			}).ErrorWithCtx(ctx, "[TurnoutUseCases.GetTurnoutLeaderboard] Failed to get region statistics")
+			return nil, nil, err
+		}
+		for _, result := range results {
+			votes[result.Region] = result
+		}
+		return votes, nil, nil
+	}
+
+	pairs, err := m.electionPairRepo.GetElectionPairs(ctx, electionID)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"election_id": electionID,
+		}).ErrorWithCtx(ctx, "[TurnoutUseCases.GetTurnoutLeaderboard] Failed to get election pairs")
+		return nil, nil, err
+	}
+	if len(pairs) == 0 {
+		return nil, nil, &custerr.ErrChain{
+			Message: "no election pairs registered for the given election",
+			Code:    404,
+			Type:    response2.ErrNotFound,
+		}
+	}
+
+	pairIDs := make([]string, 0, len(pairs))
+	for _, pair := range pairs {
+		pairIDs = append(pairIDs, pair.ID)
+	}
+
+	results, err := m.voteResultRepo.GetElectionResultsByPairs(ctx, pairIDs, true)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"election_id": electionID,
+		}).ErrorWithCtx(ctx, "[TurnoutUseCases.GetTurnoutLeaderboard] Failed to get election results by region")
+		return nil, nil, err
+	}
+	for _, result := range results {
+		sum, ok := votes[result.Region]
+		if !ok {
+			sum = &model.RegionResult{Region: result.Region}
+			votes[result.Region] = sum
+		}
+		sum.TotalVotes += result.TotalVotes
+		sum.ConfirmedVotes += result.ConfirmedVotes
+		sum.FinalizingVotes += result.FinalizingVotes
+		sum.PendingVotes += result.PendingVotes
+		sum.ErrorVotes += result.ErrorVotes
+	}
+
+	return votes, pairIDs, nil
+}
+
+// embargoed reports whether the votes of the region, or of any of the pairs in
+// it when pairs are given, are still withheld.
+func (m *Module) embargoed(pairIDs []string, region string, now time.Time) (time.Time, bool) {
+	if len(pairIDs) == 0 {
+		return m.embargo.Embargoed("", region, now)
+	}
+
+	var (
+		latest   time.Time
+		withheld bool
+	)
+	for _, pairID := range pairIDs {
+		if until, embargoed := m.embargo.Embargoed(pairID, region, now); embargoed {
+			withheld = true
+			if until.After(latest) {
+				latest = until
+			}
+		}
+	}
+	return latest, withheld
+}
+
+func withheld(row *response.RegionVoteResultResponse) bool {
+	for _, field := range row.Suppressed {
+		if field == "total_votes" {
+			return true
+		}
+	}
+	return false
+}
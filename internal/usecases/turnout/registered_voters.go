@@ -0,0 +1,171 @@
+package turnout
+
+import (
+	"context"
+	"fmt"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/golib/log"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"strings"
+	"time"
+)
+
+func (m *Module) ImportRegisteredVoters(ctx context.Context, req *request.RegisteredVotersImportRequest) (*response.RegisteredVotersImportResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "TurnoutUseCases.ImportRegisteredVoters")
+	defer span.End()
+
+	counts := make([]*model.RegisteredVoters, 0, len(req.RegisteredVoters))
+	for _, count := range req.RegisteredVoters {
+		if count == nil {
+			continue
+		}
+		counts = append(counts, &model.RegisteredVoters{
+			ElectionID:       count.ElectionID,
+			Region:           count.Region,
+			RegisteredVoters: count.RegisteredVoters,
+		})
+	}
+
+	if err := m.ImportRegisteredVoterCounts(ctx, counts); err != nil {
+		return nil, err
+	}
+
+	return &response.RegisteredVotersImportResponse{Imported: len(counts)}, nil
+}
+
+// ImportRegisteredVoterCounts replaces the registered voters of the elections
+// and regions listed. Every count is checked before anything is written.
+func (m *Module) ImportRegisteredVoterCounts(ctx context.Context, counts []*model.RegisteredVoters) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "TurnoutUseCases.ImportRegisteredVoterCounts")
+	defer span.End()
+
+	if len(counts) == 0 {
+		return &custerr.ErrChain{
+			Message: "registered_voters is required",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+
+	seen := make(map[string]bool, len(counts))
+	for i, count := range counts {
+		count.ElectionID = strings.TrimSpace(count.ElectionID)
+		count.Region = strings.TrimSpace(count.Region)
+
+		var message string
+		switch key := count.ElectionID + "/" + count.Region; {
+		case count.Region == "":
+			message = fmt.Sprintf("registered voters %d: region is required", i)
+		case count.RegisteredVoters == 0:
+			message = fmt.Sprintf("registered voters %d: registered_voters must be positive", i)
+		case seen[key]:
+			message = fmt.Sprintf("registered voters %d: region %s is listed twice for election %q", i, count.Region, count.ElectionID)
+		default:
+			seen[key] = true
+			continue
+		}
+		return &custerr.ErrChain{
+			Message: message,
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+
+	now := time.Now()
+	for _, count := range counts {
+		count.UpdatedAt = now
+	}
+
+	if err := m.registeredVotersRepo.InsertRegisteredVoters(ctx, counts); err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"count": len(counts),
+		}).ErrorWithCtx(ctx, "[TurnoutUseCases.ImportRegisteredVoterCounts] Failed to import registered voters")
+		return err
+	}
+	m.electorate.Invalidate()
+
+	log.WithFields(log.Fields{
+		"count": len(counts),
+	}).InfoWithCtx(ctx, "[TurnoutUseCases.ImportRegisteredVoterCounts] Registered voters imported")
+
+	return nil
+}
+
+func (m *Module) GetRegisteredVoters(ctx context.Context, electionID string) ([]*response.RegisteredVotersResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "TurnoutUseCases.GetRegisteredVoters")
+	defer span.End()
+
+	counts, err := m.registeredVotersRepo.GetRegisteredVoters(ctx, electionID)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"election_id": electionID,
+		}).ErrorWithCtx(ctx, "[TurnoutUseCases.GetRegisteredVoters] Failed to get registered voters")
+		return nil, err
+	}
+
+	responses := make([]*response.RegisteredVotersResponse, 0, len(counts))
+	for _, count := range counts {
+		responses = append(responses, &response.RegisteredVotersResponse{
+			ElectionID:       count.ElectionID,
+			Region:           count.Region,
+			RegisteredVoters: count.RegisteredVoters,
+			UpdatedAt:        count.UpdatedAt,
+		})
+	}
+
+	return responses, nil
+}
+
+func (m *Module) DeleteRegisteredVoters(ctx context.Context, electionID, region string) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "TurnoutUseCases.DeleteRegisteredVoters")
+	defer span.End()
+
+	if region == "" {
+		return &custerr.ErrChain{
+			Message: "region is required",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+
+	counts, err := m.registeredVotersRepo.GetRegisteredVoters(ctx, electionID)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"election_id": electionID,
+		}).ErrorWithCtx(ctx, "[TurnoutUseCases.DeleteRegisteredVoters] Failed to get registered voters")
+		return err
+	}
+	found := false
+	for _, count := range counts {
+		if count.ElectionID == electionID && count.Region == region {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return &custerr.ErrChain{
+			Message: "registered voters not found",
+			Code:    404,
+			Type:    response2.ErrNotFound,
+		}
+	}
+
+	if err = m.registeredVotersRepo.DeleteRegisteredVoters(ctx, electionID, region); err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"election_id": electionID,
+			"region":      region,
+		}).ErrorWithCtx(ctx, "[TurnoutUseCases.DeleteRegisteredVoters] Failed to delete registered voters")
+		return err
+	}
+	m.electorate.Invalidate()
+
+	return nil
+}
@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"context"
+	"github.com/nocturna-ta/result/internal/usecases/request"
 	"github.com/nocturna-ta/result/internal/usecases/response"
 	"time"
 )
@@ -25,6 +26,8 @@ type VoteResultUseCases interface {
 	// Statistics
 	GetOverallStatistics(ctx context.Context) (*response.VoteStatisticsResponse, error)
 	GetDailyStatistics(ctx context.Context, startDate, endDate time.Time) ([]*response.VoteStatisticsResponse, error)
+	GetTimeSeriesStatistics(ctx context.Context, req *request.TimeSeriesRequest) (*response.TimeSeriesResponse, error)
+	GetVoteBreakdown(ctx context.Context, req *request.TimeSeriesRequest) (*response.VoteBreakdownResponse, error)
 
 	// Count operations
 	CountVotesByStatus(ctx context.Context, status string) (uint64, error)
@@ -32,6 +35,6 @@ type VoteResultUseCases interface {
 	CountVotesByRegion(ctx context.Context, region string) (uint64, error)
 
 	// Time-based queries
-	GetVoteResultsByHour(ctx context.Context, date time.Time) ([]*response.VoteResultResponse, error)
-	GetVoteResultsByDay(ctx context.Context, date time.Time) ([]*response.VoteResultResponse, error)
+	GetVoteResultsByHour(ctx context.Context, date time.Time, limit, offset int) ([]*response.VoteResultResponse, error)
+	GetVoteResultsByDay(ctx context.Context, date time.Time, limit, offset int) ([]*response.VoteResultResponse, error)
 }
@@ -0,0 +1,138 @@
+package vote_result
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/nocturna-ta/golib/log"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/infrastructures/cache"
+	"github.com/nocturna-ta/result/internal/infrastructures/embargo"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
+	"github.com/nocturna-ta/result/internal/usecases"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"time"
+)
+
+const (
+	tagStatistics = "statistics"
+	tagElections  = "elections"
+	tagElection   = "election:"
+)
+
+// CachedModule serves the aggregate queries from a short lived cache and passes
+// every other call through to the wrapped use cases.
+type CachedModule struct {
+	usecases.VoteResultUseCases
+	cache   *cache.Cache
+	cfg     config.CacheConfig
+	embargo *embargo.Schedule
+}
+
+type CachedOpts struct {
+	VoteResult usecases.VoteResultUseCases
+	Cache      *cache.Cache
+	Cfg        config.CacheConfig
+	Embargo    *embargo.Schedule
+}
+
+func NewCached(opts *CachedOpts) *CachedModule {
+	return &CachedModule{
+		VoteResultUseCases: opts.VoteResult,
+		cache:              opts.Cache,
+		cfg:                opts.Cfg,
+		embargo:            opts.Embargo,
+	}
+}
+
+func (m *CachedModule) GetElectionResults(ctx context.Context, electionPairID string) (*response.ElectionVoteResultResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultUseCases.CachedGetElectionResults")
+	defer span.End()
+
+	tags := []string{tagElection + electionPairID, tagElections}
+	return load(ctx, m, "GetElectionResults", "election_results:"+electionPairID, m.cfg.ElectionResultsTTL, tags,
+		func(ctx context.Context) (*response.ElectionVoteResultResponse, error) {
+			return m.VoteResultUseCases.GetElectionResults(ctx, electionPairID)
+		})
+}
+
+func (m *CachedModule) GetRegionStatistics(ctx context.Context) ([]*response.RegionVoteResultResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultUseCases.CachedGetRegionStatistics")
+	defer span.End()
+
+	return load(ctx, m, "GetRegionStatistics", "region_statistics", m.cfg.RegionStatisticsTTL, []string{tagStatistics},
+		m.VoteResultUseCases.GetRegionStatistics)
+}
+
+func (m *CachedModule) GetOverallStatistics(ctx context.Context) (*response.VoteStatisticsResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultUseCases.CachedGetOverallStatistics")
+	defer span.End()
+
+	return load(ctx, m, "GetOverallStatistics", "overall_statistics", m.cfg.OverallStatisticsTTL, []string{tagStatistics},
+		m.VoteResultUseCases.GetOverallStatistics)
+}
+
+func (m *CachedModule) GetDailyStatistics(ctx context.Context, startDate, endDate time.Time) ([]*response.VoteStatisticsResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultUseCases.CachedGetDailyStatistics")
+	defer span.End()
+
+	key := "daily_statistics:" + startDate.UTC().Format(time.RFC3339) + ":" + endDate.UTC().Format(time.RFC3339)
+	return load(ctx, m, "GetDailyStatistics", key, m.cfg.DailyStatisticsTTL, []string{tagStatistics},
+		func(ctx context.Context) ([]*response.VoteStatisticsResponse, error) {
+			return m.VoteResultUseCases.GetDailyStatistics(ctx, startDate, endDate)
+		})
+}
+
+// InvalidateVoteChange drops the aggregates a changed vote contributes to. Every
+// vote counts toward the statistics; without an election pair the change may
+// belong to any election, so all election results are dropped.
+func (m *CachedModule) InvalidateVoteChange(ctx context.Context, electionPairID string) {
+	m.cache.InvalidateTags(tagStatistics)
+	metrics.CacheInvalidations.WithLabelValues(tagStatistics).Inc()
+
+	if electionPairID == "" {
+		m.cache.InvalidateTags(tagElections)
+		metrics.CacheInvalidations.WithLabelValues(tagElections).Inc()
+		return
+	}
+
+	m.cache.InvalidateTags(tagElection + electionPairID)
+	metrics.CacheInvalidations.WithLabelValues("election").Inc()
+}
+
+// HandleVoteEvent invalidates the cache from a vote submit or vote processed
+// message. Processed messages carry no election pair and invalidate every election.
+func (m *CachedModule) HandleVoteEvent(ctx context.Context, topic string, data []byte) {
+	var change struct {
+		ElectionPairID string `json:"election_pair_id"`
+	}
+	if err := json.Unmarshal(data, &change); err != nil {
+		// Still invalidate: an unreadable event may stand for any vote.
+		log.WithFields(log.Fields{
+			"error": err,
+			"topic": topic,
+		}).ErrorWithCtx(ctx, "[ResultUseCases.HandleVoteEvent] Failed to unmarshal vote event")
+	}
+
+	m.InvalidateVoteChange(ctx, change.ElectionPairID)
+}
+
+func load[T any](ctx context.Context, m *CachedModule, query, key string, ttl time.Duration, tags []string, fn func(ctx context.Context) (T, error)) (T, error) {
+	// Under an embargo observers see more than the public, so they never share entries.
+	if m.embargo != nil && embargo.Privileged(ctx) {
+		key += "|privileged"
+	}
+
+	value, hit, err := cache.Load(ctx, m.cache, key, ttl, tags, fn)
+	if err != nil {
+		return value, err
+	}
+
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	metrics.CacheRequests.WithLabelValues(query, result).Inc()
+
+	return value, nil
+}
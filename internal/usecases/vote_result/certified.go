@@ -0,0 +1,152 @@
+package vote_result
+
+import (
+	"context"
+	"errors"
+	"github.com/nocturna-ta/golib/log"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/infrastructures/embargo"
+	"github.com/nocturna-ta/result/internal/interfaces/dao"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"time"
+)
+
+// certifiedRegion returns the certified snapshot of the region, or nil when the
+// region is not certified and its live counts are served.
+func (m *Module) certifiedRegion(ctx context.Context, region string) *model.CertificationSnapshot {
+	if m.certificationRepo == nil {
+		return nil
+	}
+
+	certification, err := m.certificationRepo.GetCertificationByScope(ctx, model.CertificationScopeRegion, region)
+	if err != nil {
+		if !errors.Is(err, dao.ErrNoResult) {
+			log.WithFields(log.Fields{
+				"error":  err,
+				"region": region,
+			}).ErrorWithCtx(ctx, "[ResultUseCases.certifiedRegion] Failed to get certification")
+		}
+		return nil
+	}
+
+	snapshot, err := certification.Content()
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    certification.ID,
+		}).ErrorWithCtx(ctx, "[ResultUseCases.certifiedRegion] Failed to decode certification snapshot")
+		return nil
+	}
+	return snapshot
+}
+
+// certifiedElection returns the certified counts of the election pair, from the
+// snapshot of its election, or nil when the election is not certified.
+func (m *Module) certifiedElection(ctx context.Context, electionPairID string) (*model.CertificationSnapshot, *model.CertifiedResult) {
+	if m.certificationRepo == nil || m.electionPairRepo == nil {
+		return nil, nil
+	}
+
+	pair, err := m.electionPairRepo.GetElectionPair(ctx, electionPairID)
+	if err != nil {
+		if !errors.Is(err, dao.ErrNoResult) {
+			log.WithFields(log.Fields{
+				"error":          err,
+				"electionPairID": electionPairID,
+			}).ErrorWithCtx(ctx, "[ResultUseCases.certifiedElection] Failed to get election pair")
+		}
+		return nil, nil
+	}
+
+	certification, err := m.certificationRepo.GetCertificationByScope(ctx, model.CertificationScopeElection, pair.ElectionID)
+	if err != nil {
+		if !errors.Is(err, dao.ErrNoResult) {
+			log.WithFields(log.Fields{
+				"error":       err,
+				"election_id": pair.ElectionID,
+			}).ErrorWithCtx(ctx, "[ResultUseCases.certifiedElection] Failed to get certification")
+		}
+		return nil, nil
+	}
+
+	snapshot, err := certification.Content()
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    certification.ID,
+		}).ErrorWithCtx(ctx, "[ResultUseCases.certifiedElection] Failed to decode certification snapshot")
+		return nil, nil
+	}
+	for _, result := range snapshot.Results {
+		if result.ElectionPairID == electionPairID {
+			return snapshot, result
+		}
+	}
+	return nil, nil
+}
+
+// certifiedRegions returns the snapshots of every certified region by region.
+func (m *Module) certifiedRegions(ctx context.Context) map[string]*model.CertificationSnapshot {
+	if m.certificationRepo == nil {
+		return nil
+	}
+
+	certifications, err := m.certificationRepo.GetCertifications(ctx)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[ResultUseCases.certifiedRegions] Failed to get certifications")
+		return nil
+	}
+
+	snapshots := make(map[string]*model.CertificationSnapshot)
+	for _, certification := range certifications {
+		if certification.Scope != model.CertificationScopeRegion {
+			continue
+		}
+		snapshot, err := certification.Content()
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error": err,
+				"id":    certification.ID,
+			}).ErrorWithCtx(ctx, "[ResultUseCases.certifiedRegions] Failed to decode certification snapshot")
+			continue
+		}
+		snapshots[certification.ScopeID] = snapshot
+	}
+	return snapshots
+}
+
+// certifiedElectionResults serves the per pair counts of a certified region,
+// with the same embargo and disclosure as its live counts.
+func (m *Module) certifiedElectionResults(ctx context.Context, snapshot *model.CertificationSnapshot) ([]*response.ElectionVoteResultResponse, error) {
+	var (
+		responses      []*response.ElectionVoteResultResponse
+		embargoedUntil time.Time
+	)
+	for _, result := range snapshot.Results {
+		if until, embargoed := m.embargoed(ctx, result.ElectionPairID, snapshot.Region); embargoed {
+			embargoedUntil = until
+			continue
+		}
+		responses = append(responses, &response.ElectionVoteResultResponse{
+			ElectionPairID:  result.ElectionPairID,
+			Region:          snapshot.Region,
+			TotalVotes:      result.TotalVotes,
+			ConfirmedVotes:  result.ConfirmedVotes,
+			FinalizingVotes: result.FinalizingVotes,
+			PendingVotes:    result.PendingVotes,
+			ErrorVotes:      result.ErrorVotes,
+			LastUpdated:     snapshot.LastUpdated,
+			CertificationID: snapshot.CertificationID,
+		})
+	}
+
+	if len(responses) == 0 && !embargoedUntil.IsZero() {
+		return nil, embargo.Error(embargoedUntil)
+	}
+
+	m.disclosure.Elections(responses...)
+
+	return responses, nil
+}
@@ -0,0 +1,25 @@
+package vote_result
+
+import (
+	"context"
+	"github.com/nocturna-ta/result/internal/infrastructures/embargo"
+	"time"
+)
+
+// embargoed reports whether the results of the election pair in the region are
+// withheld from the caller, with the time they are released. Observers and
+// admins see everything.
+func (m *Module) embargoed(ctx context.Context, electionPairID, region string) (time.Time, bool) {
+	until, embargoed := m.embargo.Embargoed(electionPairID, region, time.Now())
+	if !embargoed || embargo.Privileged(ctx) {
+		return until, false
+	}
+	return until, true
+}
+
+func (m *Module) checkEmbargo(ctx context.Context, electionPairID, region string) error {
+	if until, embargoed := m.embargoed(ctx, electionPairID, region); embargoed {
+		return embargo.Error(until)
+	}
+	return nil
+}
@@ -1,20 +1,50 @@
 package vote_result
 
 import (
+	"github.com/nocturna-ta/result/config"
 	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/disclosure"
+	"github.com/nocturna-ta/result/internal/infrastructures/electorate"
+	"github.com/nocturna-ta/result/internal/infrastructures/embargo"
+	"github.com/nocturna-ta/result/internal/infrastructures/privacy"
 	"github.com/nocturna-ta/result/internal/usecases"
 )
 
 type Module struct {
-	voteResultRepo repository.VoteResultRepository
+	voteResultRepo    repository.VoteResultRepository
+	statistics        config.StatisticsConfig
+	embargo           *embargo.Schedule
+	privacy           *privacy.Policy
+	disclosure        *disclosure.Control
+	electorate        *electorate.Register
+	certificationRepo repository.CertificationRepository
+	electionPairRepo  repository.ElectionPairRepository
 }
 
 type Opts struct {
 	VoteResultRepo repository.VoteResultRepository
+	Statistics     config.StatisticsConfig
+	Embargo        *embargo.Schedule
+	Privacy        *privacy.Policy
+	Disclosure     *disclosure.Control
+	Electorate     *electorate.Register
+	// CertificationRepo serves certified regions and elections from their snapshot. Live
+	// counts are served when nil.
+	CertificationRepo repository.CertificationRepository
+	// ElectionPairRepo finds the election of a pair, so a pair of a certified
+	// election is served from the election's snapshot.
+	ElectionPairRepo repository.ElectionPairRepository
 }
 
 func New(opts *Opts) usecases.VoteResultUseCases {
 	return &Module{
-		voteResultRepo: opts.VoteResultRepo,
+		voteResultRepo:    opts.VoteResultRepo,
+		statistics:        opts.Statistics,
+		embargo:           opts.Embargo,
+		privacy:           opts.Privacy,
+		disclosure:        opts.Disclosure,
+		electorate:        opts.Electorate,
+		certificationRepo: opts.CertificationRepo,
+		electionPairRepo:  opts.ElectionPairRepo,
 	}
 }
@@ -0,0 +1,233 @@
+package vote_result
+
+import (
+	"context"
+	"fmt"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/golib/log"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// GetTimeSeriesStatistics returns vote counts per bucket between the requested
+// dates, bucketed on the wall clock of the requested time zone. Every bucket in
+// the range is present, with zero counts where no vote fell, so the series can
+// be plotted as is.
+func (m *Module) GetTimeSeriesStatistics(ctx context.Context, req *request.TimeSeriesRequest) (*response.TimeSeriesResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultUseCases.GetTimeSeriesStatistics")
+	defer span.End()
+
+	filter, err := m.parseTimeSeriesRequest(req, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.checkEmbargo(ctx, filter.ElectionPairID, filter.Region); err != nil {
+		return nil, err
+	}
+
+	points, err := m.voteResultRepo.GetVoteTimeSeries(ctx, filter)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":  err,
+			"filter": filter,
+		}).ErrorWithCtx(ctx, "[ResultUseCases.GetTimeSeriesStatistics] Failed to get vote time series")
+		return nil, err
+	}
+
+	return &response.TimeSeriesResponse{
+		TimeZone:       filter.Location.String(),
+		Bucket:         string(filter.Bucket),
+		Axis:           string(filter.Axis),
+		Start:          filter.Start,
+		End:            filter.End,
+		ElectionPairID: filter.ElectionPairID,
+		Region:         filter.Region,
+		Points:         fillTimeSeries(filter, points),
+	}, nil
+}
+
+// GetVoteBreakdown returns vote counts per bucket, election pair, region and
+// status for the same ranges as GetTimeSeriesStatistics. It replaces walking the
+// raw rows of an hour or a day.
+func (m *Module) GetVoteBreakdown(ctx context.Context, req *request.TimeSeriesRequest) (*response.VoteBreakdownResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "ResultUseCases.GetVoteBreakdown")
+	defer span.End()
+
+	filter, err := m.parseTimeSeriesRequest(req, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.checkEmbargo(ctx, filter.ElectionPairID, filter.Region); err != nil {
+		return nil, err
+	}
+
+	counts, err := m.voteResultRepo.GetVoteBucketCounts(ctx, filter)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error":  err,
+			"filter": filter,
+		}).ErrorWithCtx(ctx, "[ResultUseCases.GetVoteBreakdown] Failed to get vote bucket counts")
+		return nil, err
+	}
+
+	items := make([]*response.VoteBucketCountResponse, 0, len(counts))
+	for _, c := range counts {
+		items = append(items, &response.VoteBucketCountResponse{
+			Timestamp:      c.Bucket.In(filter.Location),
+			ElectionPairID: c.ElectionPairID,
+			Region:         c.Region,
+			Status:         c.Status,
+			Votes:          c.Votes,
+		})
+	}
+
+	return &response.VoteBreakdownResponse{
+		TimeZone:       filter.Location.String(),
+		Bucket:         string(filter.Bucket),
+		Axis:           string(filter.Axis),
+		Start:          filter.Start,
+		End:            filter.End,
+		ElectionPairID: filter.ElectionPairID,
+		Region:         filter.Region,
+		Items:          items,
+	}, nil
+}
+
+func (m *Module) parseTimeSeriesRequest(req *request.TimeSeriesRequest, now time.Time) (model.TimeSeriesFilter, error) {
+	filter := model.TimeSeriesFilter{
+		Axis:           model.TimeAxis(req.Axis),
+		Bucket:         model.BucketSize(req.Bucket),
+		ElectionPairID: req.ElectionPairID,
+		Region:         req.Region,
+	}
+
+	if filter.Axis == "" {
+		filter.Axis = model.TimeAxisVotedAt
+	}
+	if !filter.Axis.Valid() {
+		return filter, badRequest("axis must be one of voted_at, processed_at, created_at")
+	}
+
+	if filter.Bucket == "" {
+		filter.Bucket = model.BucketHour
+	}
+	if !filter.Bucket.Valid() {
+		return filter, badRequest("bucket must be one of minute, 15m, hour, day")
+	}
+
+	tz := req.TimeZone
+	if tz == "" {
+		tz = m.statistics.DefaultTimeZone
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return filter, badRequest(fmt.Sprintf("unknown time zone %q", tz))
+	}
+	filter.Location = loc
+
+	end := now
+	if req.EndDate != "" {
+		var dateOnly bool
+		end, dateOnly, err = parseSeriesTime(req.EndDate, loc)
+		if err != nil {
+			return filter, badRequest("invalid end date format, expected YYYY-MM-DD or RFC3339")
+		}
+		// A bare end date includes that whole day.
+		if dateOnly {
+			end = end.AddDate(0, 0, 1)
+		}
+	}
+
+	var start time.Time
+	switch {
+	case req.StartDate != "":
+		start, _, err = parseSeriesTime(req.StartDate, loc)
+		if err != nil {
+			return filter, badRequest("invalid start date format, expected YYYY-MM-DD or RFC3339")
+		}
+	case filter.Bucket == model.BucketDay:
+		start = end.AddDate(0, 0, -30)
+	default:
+		start = end.Add(-24 * time.Hour)
+	}
+
+	if !start.Before(end) {
+		return filter, badRequest("start date must be before end date")
+	}
+
+	// Widen the range to whole buckets so the first and last points are complete.
+	filter.Start = filter.Bucket.Floor(start, loc)
+	filter.End = filter.Bucket.Floor(end, loc)
+	if filter.End.Before(end) {
+		filter.End = filter.Bucket.Next(filter.End)
+	}
+
+	if buckets := countBuckets(filter); m.statistics.MaxSeriesPoints > 0 && buckets > m.statistics.MaxSeriesPoints {
+		return filter, badRequest(fmt.Sprintf("range spans %d buckets, the maximum is %d; use a larger bucket or a shorter range", buckets, m.statistics.MaxSeriesPoints))
+	}
+
+	return filter, nil
+}
+
+func parseSeriesTime(value string, loc *time.Location) (time.Time, bool, error) {
+	if t, err := time.ParseInLocation(dateLayout, value, loc); err == nil {
+		return t, true, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	return t, false, err
+}
+
+func countBuckets(filter model.TimeSeriesFilter) int {
+	var approx time.Duration
+	switch filter.Bucket {
+	case model.BucketMinute:
+		approx = time.Minute
+	case model.BucketFifteenMinute:
+		approx = 15 * time.Minute
+	case model.BucketHour:
+		approx = time.Hour
+	default:
+		approx = 24 * time.Hour
+	}
+	return int(filter.End.Sub(filter.Start) / approx)
+}
+
+// fillTimeSeries lays the database buckets onto every bucket of the range,
+// leaving zero counts where the database returned nothing.
+func fillTimeSeries(filter model.TimeSeriesFilter, points []*model.TimeSeriesPoint) []*response.TimeSeriesPointResponse {
+	byBucket := make(map[int64]*model.TimeSeriesPoint, len(points))
+	for _, p := range points {
+		byBucket[p.Bucket.Unix()] = p
+	}
+
+	series := make([]*response.TimeSeriesPointResponse, 0, countBuckets(filter)+1)
+	for t := filter.Start; t.Before(filter.End); t = filter.Bucket.Next(t) {
+		point := &response.TimeSeriesPointResponse{Timestamp: t}
+		if p, ok := byBucket[t.Unix()]; ok {
+			point.TotalVotes = p.TotalVotes
+			point.ConfirmedVotes = p.ConfirmedVotes
+			point.FinalizingVotes = p.FinalizingVotes
+			point.PendingVotes = p.PendingVotes
+			point.ErrorVotes = p.ErrorVotes
+		}
+		series = append(series, point)
+	}
+
+	return series
+}
+
+func badRequest(message string) error {
+	return &custerr.ErrChain{
+		Message: message,
+		Code:    400,
+		Type:    response2.ErrBadRequest,
+	}
+}
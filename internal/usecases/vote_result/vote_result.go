@@ -7,6 +7,7 @@ import (
 	"github.com/nocturna-ta/golib/log"
 	response2 "github.com/nocturna-ta/golib/response"
 	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/infrastructures/embargo"
 	"github.com/nocturna-ta/result/internal/interfaces/dao"
 	"github.com/nocturna-ta/result/internal/usecases/response"
 	"time"
@@ -41,7 +42,7 @@ func (m *Module) GetVoteResultByID(ctx context.Context, id string) (*response.Vo
 		return nil, err
 	}
 
-	return &response.VoteResultResponse{
+	vote := &response.VoteResultResponse{
 		ID:              result.ID,
 		VoterID:         result.VoterID,
 		ElectionPairID:  result.ElectionPairID,
@@ -53,7 +54,14 @@ func (m *Module) GetVoteResultByID(ctx context.Context, id string) (*response.Vo
 		ProcessedAt:     result.ProcessedAt,
 		CreatedAt:       result.CreatedAt,
 		UpdatedAt:       result.UpdatedAt,
-	}, nil
+		ChainStatus:     result.ChainStatus,
+		BlockNumber:     result.BlockNumber,
+		BlockHash:       result.BlockHash,
+		BlockTime:       result.BlockTime,
+	}
+	m.privacy.Apply(ctx, vote)
+
+	return vote, nil
 }
 
 func (m *Module) GetVoteResultsByElectionPair(ctx context.Context, electionPairID string, limit, offset int) ([]*response.VoteResultResponse, error) {
@@ -121,6 +129,9 @@ func (m *Module) GetVoteResultsByElectionPair(ctx context.Context, electionPairI
 			Type:    response2.ErrNotFound,
 		}
 	}
+
+	m.privacy.Apply(ctx, responses...)
+
 	return responses, nil
 }
 
@@ -190,6 +201,8 @@ func (m *Module) GetVoteResultsByRegion(ctx context.Context, region string, limi
 		}
 	}
 
+	m.privacy.Apply(ctx, responses...)
+
 	return responses, nil
 }
 
@@ -257,6 +270,8 @@ func (m *Module) GetVoteResultsByStatus(ctx context.Context, status string, limi
 		}
 	}
 
+	m.privacy.Apply(ctx, responses...)
+
 	return responses, nil
 }
 
@@ -335,6 +350,8 @@ func (m *Module) GetVoteResultsByDateRange(ctx context.Context, startDate, endDa
 		}
 	}
 
+	m.privacy.Apply(ctx, responses...)
+
 	return responses, nil
 }
 
@@ -350,6 +367,25 @@ func (m *Module) GetElectionResults(ctx context.Context, electionPairID string)
 		}
 	}
 
+	if err := m.checkEmbargo(ctx, electionPairID, ""); err != nil {
+		return nil, err
+	}
+
+	if snapshot, certified := m.certifiedElection(ctx, electionPairID); certified != nil {
+		electionResult := &response.ElectionVoteResultResponse{
+			ElectionPairID:  certified.ElectionPairID,
+			TotalVotes:      certified.TotalVotes,
+			ConfirmedVotes:  certified.ConfirmedVotes,
+			FinalizingVotes: certified.FinalizingVotes,
+			PendingVotes:    certified.PendingVotes,
+			ErrorVotes:      certified.ErrorVotes,
+			LastUpdated:     snapshot.LastUpdated,
+			CertificationID: snapshot.CertificationID,
+		}
+		m.disclosure.Elections(electionResult)
+		return electionResult, nil
+	}
+
 	result, err := m.voteResultRepo.GetElectionResults(ctx, electionPairID)
 	if err != nil {
 		log.WithFields(log.Fields{
@@ -367,15 +403,19 @@ func (m *Module) GetElectionResults(ctx context.Context, electionPairID string)
 		return nil, err
 	}
 
-	return &response.ElectionVoteResultResponse{
-		ElectionPairID: result.ElectionPairID,
-		Region:         result.Region,
-		TotalVotes:     result.TotalVotes,
-		ConfirmedVotes: result.ConfirmedVotes,
-		PendingVotes:   result.PendingVotes,
-		ErrorVotes:     result.ErrorVotes,
-		LastUpdated:    result.LastUpdated,
-	}, nil
+	electionResult := &response.ElectionVoteResultResponse{
+		ElectionPairID:  result.ElectionPairID,
+		Region:          result.Region,
+		TotalVotes:      result.TotalVotes,
+		ConfirmedVotes:  result.ConfirmedVotes,
+		FinalizingVotes: result.FinalizingVotes,
+		PendingVotes:    result.PendingVotes,
+		ErrorVotes:      result.ErrorVotes,
+		LastUpdated:     result.LastUpdated,
+	}
+	m.disclosure.Elections(electionResult)
+
+	return electionResult, nil
 }
 
 func (m *Module) GetElectionResultsByRegion(ctx context.Context, region string) ([]*response.ElectionVoteResultResponse, error) {
@@ -390,6 +430,10 @@ func (m *Module) GetElectionResultsByRegion(ctx context.Context, region string)
 		}
 	}
 
+	if snapshot := m.certifiedRegion(ctx, region); snapshot != nil {
+		return m.certifiedElectionResults(ctx, snapshot)
+	}
+
 	results, err := m.voteResultRepo.GetElectionResultsByRegion(ctx, region)
 	if err != nil {
 		log.WithFields(log.Fields{
@@ -407,19 +451,30 @@ func (m *Module) GetElectionResultsByRegion(ctx context.Context, region string)
 		return nil, err
 	}
 
-	var responses []*response.ElectionVoteResultResponse
+	var (
+		responses      []*response.ElectionVoteResultResponse
+		embargoedUntil time.Time
+	)
 	for _, result := range results {
+		if until, embargoed := m.embargoed(ctx, result.ElectionPairID, region); embargoed {
+			embargoedUntil = until
+			continue
+		}
 		responses = append(responses, &response.ElectionVoteResultResponse{
-			ElectionPairID: result.ElectionPairID,
-			Region:         result.Region,
-			TotalVotes:     result.TotalVotes,
-			ConfirmedVotes: result.ConfirmedVotes,
-			PendingVotes:   result.PendingVotes,
-			ErrorVotes:     result.ErrorVotes,
-			LastUpdated:    result.LastUpdated,
+			ElectionPairID:  result.ElectionPairID,
+			Region:          result.Region,
+			TotalVotes:      result.TotalVotes,
+			ConfirmedVotes:  result.ConfirmedVotes,
+			FinalizingVotes: result.FinalizingVotes,
+			PendingVotes:    result.PendingVotes,
+			ErrorVotes:      result.ErrorVotes,
+			LastUpdated:     result.LastUpdated,
 		})
 	}
 
+	if len(responses) == 0 && !embargoedUntil.IsZero() {
+		return nil, embargo.Error(embargoedUntil)
+	}
 	if len(responses) == 0 {
 		return nil, &custerr.ErrChain{
 			Message: "no election results found for the given region",
@@ -428,6 +483,8 @@ func (m *Module) GetElectionResultsByRegion(ctx context.Context, region string)
 		}
 	}
 
+	m.disclosure.Elections(responses...)
+
 	return responses, nil
 }
 
@@ -443,6 +500,26 @@ func (m *Module) GetRegionResults(ctx context.Context, region string) (*response
 		}
 	}
 
+	if err := m.checkEmbargo(ctx, "", region); err != nil {
+		return nil, err
+	}
+
+	if snapshot := m.certifiedRegion(ctx, region); snapshot != nil {
+		regionResult := &response.RegionVoteResultResponse{
+			Region:          snapshot.Region,
+			TotalVotes:      snapshot.Totals.TotalVotes,
+			ConfirmedVotes:  snapshot.Totals.ConfirmedVotes,
+			FinalizingVotes: snapshot.Totals.FinalizingVotes,
+			PendingVotes:    snapshot.Totals.PendingVotes,
+			ErrorVotes:      snapshot.Totals.ErrorVotes,
+			LastUpdated:     snapshot.LastUpdated,
+			CertificationID: snapshot.CertificationID,
+		}
+		m.disclosure.Regions(regionResult)
+		m.electorate.Regions(ctx, regionResult)
+		return regionResult, nil
+	}
+
 	result, err := m.voteResultRepo.GetRegionResults(ctx, region)
 	if err != nil {
 		log.WithFields(log.Fields{
@@ -460,14 +537,19 @@ func (m *Module) GetRegionResults(ctx context.Context, region string) (*response
 		return nil, err
 	}
 
-	return &response.RegionVoteResultResponse{
-		Region:         result.Region,
-		TotalVotes:     result.TotalVotes,
-		ConfirmedVotes: result.ConfirmedVotes,
-		PendingVotes:   result.PendingVotes,
-		ErrorVotes:     result.ErrorVotes,
-		LastUpdated:    result.LastUpdated,
-	}, nil
+	regionResult := &response.RegionVoteResultResponse{
+		Region:          result.Region,
+		TotalVotes:      result.TotalVotes,
+		ConfirmedVotes:  result.ConfirmedVotes,
+		FinalizingVotes: result.FinalizingVotes,
+		PendingVotes:    result.PendingVotes,
+		ErrorVotes:      result.ErrorVotes,
+		LastUpdated:     result.LastUpdated,
+	}
+	m.disclosure.Regions(regionResult)
+	m.electorate.Regions(ctx, regionResult)
+
+	return regionResult, nil
 }
 
 func (m *Module) GetRegionStatistics(ctx context.Context) ([]*response.RegionVoteResultResponse, error) {
@@ -490,18 +572,43 @@ func (m *Module) GetRegionStatistics(ctx context.Context) ([]*response.RegionVot
 		return nil, err
 	}
 
-	var responses []*response.RegionVoteResultResponse
+	var (
+		responses      []*response.RegionVoteResultResponse
+		embargoedUntil time.Time
+		certified      = m.certifiedRegions(ctx)
+	)
 	for _, result := range results {
+		if until, embargoed := m.embargoed(ctx, "", result.Region); embargoed {
+			embargoedUntil = until
+			continue
+		}
+		if snapshot, ok := certified[result.Region]; ok {
+			responses = append(responses, &response.RegionVoteResultResponse{
+				Region:          result.Region,
+				TotalVotes:      snapshot.Totals.TotalVotes,
+				ConfirmedVotes:  snapshot.Totals.ConfirmedVotes,
+				FinalizingVotes: snapshot.Totals.FinalizingVotes,
+				PendingVotes:    snapshot.Totals.PendingVotes,
+				ErrorVotes:      snapshot.Totals.ErrorVotes,
+				LastUpdated:     snapshot.LastUpdated,
+				CertificationID: snapshot.CertificationID,
+			})
+			continue
+		}
 		responses = append(responses, &response.RegionVoteResultResponse{
-			Region:         result.Region,
-			TotalVotes:     result.TotalVotes,
-			ConfirmedVotes: result.ConfirmedVotes,
-			PendingVotes:   result.PendingVotes,
-			ErrorVotes:     result.ErrorVotes,
-			LastUpdated:    result.LastUpdated,
+			Region:          result.Region,
+			TotalVotes:      result.TotalVotes,
+			ConfirmedVotes:  result.ConfirmedVotes,
+			FinalizingVotes: result.FinalizingVotes,
+			PendingVotes:    result.PendingVotes,
+			ErrorVotes:      result.ErrorVotes,
+			LastUpdated:     result.LastUpdated,
 		})
 	}
 
+	if len(responses) == 0 && !embargoedUntil.IsZero() {
+		return nil, embargo.Error(embargoedUntil)
+	}
 	if len(responses) == 0 {
 		return nil, &custerr.ErrChain{
 			Message: "no region statistics found",
@@ -510,6 +617,9 @@ func (m *Module) GetRegionStatistics(ctx context.Context) ([]*response.RegionVot
 		}
 	}
 
+	m.disclosure.Regions(responses...)
+	m.electorate.Regions(ctx, responses...)
+
 	return responses, nil
 }
 
@@ -517,6 +627,10 @@ func (m *Module) GetOverallStatistics(ctx context.Context) (*response.VoteStatis
 	span, ctx := tracing.StartSpanFromContext(ctx, "ResultUseCases.GetOverallStatistics")
 	defer span.End()
 
+	if err := m.checkEmbargo(ctx, "", ""); err != nil {
+		return nil, err
+	}
+
 	stats, err := m.voteResultRepo.GetOverallStatistics(ctx)
 	if err != nil {
 		log.WithFields(log.Fields{
@@ -534,12 +648,13 @@ func (m *Module) GetOverallStatistics(ctx context.Context) (*response.VoteStatis
 	}
 
 	return &response.VoteStatisticsResponse{
-		TotalVotes:     stats.TotalVotes,
-		ConfirmedVotes: stats.ConfirmedVotes,
-		PendingVotes:   stats.PendingVotes,
-		ErrorVotes:     stats.ErrorVotes,
-		SuccessRate:    stats.SuccessRate,
-		LastUpdated:    stats.LastUpdated,
+		TotalVotes:      stats.TotalVotes,
+		ConfirmedVotes:  stats.ConfirmedVotes,
+		FinalizingVotes: stats.FinalizingVotes,
+		PendingVotes:    stats.PendingVotes,
+		ErrorVotes:      stats.ErrorVotes,
+		SuccessRate:     stats.SuccessRate,
+		LastUpdated:     stats.LastUpdated,
 	}, nil
 }
 
@@ -563,6 +678,10 @@ func (m *Module) GetDailyStatistics(ctx context.Context, startDate, endDate time
 		}
 	}
 
+	if err := m.checkEmbargo(ctx, "", ""); err != nil {
+		return nil, err
+	}
+
 	stats, err := m.voteResultRepo.GetDailyStatistics(ctx, startDate, endDate)
 	if err != nil {
 		log.WithFields(log.Fields{
@@ -584,12 +703,13 @@ func (m *Module) GetDailyStatistics(ctx context.Context, startDate, endDate time
 	var responses []*response.VoteStatisticsResponse
 	for _, stat := range stats {
 		responses = append(responses, &response.VoteStatisticsResponse{
-			TotalVotes:     stat.TotalVotes,
-			ConfirmedVotes: stat.ConfirmedVotes,
-			PendingVotes:   stat.PendingVotes,
-			ErrorVotes:     stat.ErrorVotes,
-			SuccessRate:    stat.SuccessRate,
-			LastUpdated:    stat.LastUpdated,
+			TotalVotes:      stat.TotalVotes,
+			ConfirmedVotes:  stat.ConfirmedVotes,
+			FinalizingVotes: stat.FinalizingVotes,
+			PendingVotes:    stat.PendingVotes,
+			ErrorVotes:      stat.ErrorVotes,
+			SuccessRate:     stat.SuccessRate,
+			LastUpdated:     stat.LastUpdated,
 		})
 	}
 
@@ -616,6 +736,10 @@ func (m *Module) CountVotesByStatus(ctx context.Context, status string) (uint64,
 		}
 	}
 
+	if err := m.checkEmbargo(ctx, "", ""); err != nil {
+		return 0, err
+	}
+
 	count, err := m.voteResultRepo.CountVotesByStatus(ctx, status)
 	if err != nil {
 		log.WithFields(log.Fields{
@@ -648,6 +772,10 @@ func (m *Module) CountVotesByElectionPair(ctx context.Context, electionPairID st
 		}
 	}
 
+	if err := m.checkEmbargo(ctx, electionPairID, ""); err != nil {
+		return 0, err
+	}
+
 	count, err := m.voteResultRepo.CountVotesByElectionPair(ctx, electionPairID)
 	if err != nil {
 		log.WithFields(log.Fields{
@@ -680,6 +808,10 @@ func (m *Module) CountVotesByRegion(ctx context.Context, region string) (uint64,
 		}
 	}
 
+	if err := m.checkEmbargo(ctx, "", region); err != nil {
+		return 0, err
+	}
+
 	count, err := m.voteResultRepo.CountVotesByRegion(ctx, region)
 	if err != nil {
 		log.WithFields(log.Fields{
@@ -700,7 +832,7 @@ func (m *Module) CountVotesByRegion(ctx context.Context, region string) (uint64,
 	return count, nil
 }
 
-func (m *Module) GetVoteResultsByHour(ctx context.Context, date time.Time) ([]*response.VoteResultResponse, error) {
+func (m *Module) GetVoteResultsByHour(ctx context.Context, date time.Time, limit, offset int) ([]*response.VoteResultResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "ResultUseCases.GetVoteResultsByHour")
 	defer span.End()
 
@@ -712,11 +844,23 @@ func (m *Module) GetVoteResultsByHour(ctx context.Context, date time.Time) ([]*r
 		}
 	}
 
-	results, err := m.voteResultRepo.GetVoteResultsByHour(ctx, date)
+	if limit <= 0 {
+		limit = 50
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	results, err := m.voteResultRepo.GetVoteResultsByHour(ctx, date, limit, offset)
 	if err != nil {
 		log.WithFields(log.Fields{
-			"error": err,
-			"date":  date,
+			"error":  err,
+			"date":   date,
+			"limit":  limit,
+			"offset": offset,
 		}).ErrorWithCtx(ctx, "[ResultUseCases.GetVoteResultsByHour] Failed to get vote results by hour")
 
 		if errors.Is(err, dao.ErrNoResult) {
@@ -753,11 +897,13 @@ func (m *Module) GetVoteResultsByHour(ctx context.Context, date time.Time) ([]*r
 		}
 	}
 
+	m.privacy.Apply(ctx, responses...)
+
 	return responses, nil
 
 }
 
-func (m *Module) GetVoteResultsByDay(ctx context.Context, date time.Time) ([]*response.VoteResultResponse, error) {
+func (m *Module) GetVoteResultsByDay(ctx context.Context, date time.Time, limit, offset int) ([]*response.VoteResultResponse, error) {
 	span, ctx := tracing.StartSpanFromContext(ctx, "ResultUseCases.GetVoteResultsByDay")
 	defer span.End()
 
@@ -769,11 +915,23 @@ func (m *Module) GetVoteResultsByDay(ctx context.Context, date time.Time) ([]*re
 		}
 	}
 
-	results, err := m.voteResultRepo.GetVoteResultsByDay(ctx, date)
+	if limit <= 0 {
+		limit = 50
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	results, err := m.voteResultRepo.GetVoteResultsByDay(ctx, date, limit, offset)
 	if err != nil {
 		log.WithFields(log.Fields{
-			"error": err,
-			"date":  date,
+			"error":  err,
+			"date":   date,
+			"limit":  limit,
+			"offset": offset,
 		}).ErrorWithCtx(ctx, "[ResultUseCases.GetVoteResultsByDay] Failed to get vote results by day")
 
 		if errors.Is(err, dao.ErrNoResult) {
@@ -809,5 +967,8 @@ func (m *Module) GetVoteResultsByDay(ctx context.Context, date time.Time) ([]*re
 			Type:    response2.ErrNotFound,
 		}
 	}
+
+	m.privacy.Apply(ctx, responses...)
+
 	return responses, nil
 }
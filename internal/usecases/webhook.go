@@ -0,0 +1,23 @@
+package usecases
+
+import (
+	"context"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+)
+
+type WebhookUseCases interface {
+	// Subscription management
+	CreateWebhookSubscription(ctx context.Context, req *request.WebhookSubscriptionRequest) (*response.WebhookSubscriptionResponse, error)
+	GetWebhookSubscriptions(ctx context.Context) ([]*response.WebhookSubscriptionResponse, error)
+	GetWebhookSubscription(ctx context.Context, id string) (*response.WebhookSubscriptionResponse, error)
+	UpdateWebhookSubscription(ctx context.Context, id string, req *request.WebhookSubscriptionRequest) (*response.WebhookSubscriptionResponse, error)
+	DeleteWebhookSubscription(ctx context.Context, id string) error
+
+	// Delivery log
+	GetWebhookDeliveries(ctx context.Context, id string, limit, offset int) ([]*response.WebhookDeliveryResponse, error)
+	ReplayWebhookDelivery(ctx context.Context, deliveryID string) (*response.WebhookDeliveryResponse, error)
+
+	// Delivery of live result events, run by the consumer
+	Run(ctx context.Context)
+}
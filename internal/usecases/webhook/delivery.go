@@ -0,0 +1,375 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/golib/log"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/infrastructures/metrics"
+	"github.com/nocturna-ta/result/internal/infrastructures/privacy"
+	"github.com/nocturna-ta/result/internal/infrastructures/webhook"
+	"github.com/nocturna-ta/result/internal/infrastructures/websocket"
+	"github.com/nocturna-ta/result/internal/interfaces/dao"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"time"
+)
+
+// delivery is an event on its way to one subscription.
+type delivery struct {
+	subscription *model.WebhookSubscription
+	deliveryID   string
+	eventType    string
+	payload      []byte
+	attempt      int
+}
+
+// Run delivers every live result event the hub broadcasts to the matching
+// active subscriptions, as the public sees them, until ctx is done.
+func (m *Module) Run(ctx context.Context) {
+	if !m.cfg.Enabled || m.hub == nil {
+		return
+	}
+
+	m.refresh(ctx)
+
+	listener := m.hub.Listen(map[websocket.SubscriptionType]*websocket.MessageFilter{
+		websocket.SubscriptionAll: nil,
+	}, m.cfg.QueueSize, privacy.AudiencePublic)
+	defer m.hub.Unlisten(listener)
+
+	for i := 0; i < m.cfg.Workers; i++ {
+		go m.work(ctx)
+	}
+
+	ticker := time.NewTicker(m.cfg.RefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.refresh(ctx)
+		case message, ok := <-listener.Messages:
+			if !ok {
+				return
+			}
+			m.dispatch(ctx, message)
+		}
+	}
+}
+
+func (m *Module) refresh(ctx context.Context) {
+	subscriptions, err := m.webhookRepo.GetWebhookSubscriptions(ctx)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[WebhookUseCases.Run] Failed to refresh webhook subscriptions, keeping the previous ones")
+		return
+	}
+
+	m.mu.Lock()
+	m.subscriptions = subscriptions
+	m.mu.Unlock()
+}
+
+func (m *Module) dispatch(ctx context.Context, message *websocket.LiveMessage) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, subscription := range m.subscriptions {
+		if !subscription.Active || !matches(subscription, message) {
+			continue
+		}
+
+		deliveryID := uuid.NewString()
+		payload, err := json.Marshal(&webhook.Event{
+			ID:        deliveryID,
+			Type:      string(message.Type),
+			Timestamp: message.Timestamp,
+			Data:      message.Data,
+		})
+		if err != nil {
+			log.WithFields(log.Fields{
+				"error": err,
+				"type":  message.Type,
+			}).ErrorWithCtx(ctx, "[WebhookUseCases.Run] Failed to encode webhook event")
+			return
+		}
+
+		m.enqueue(ctx, &delivery{
+			subscription: subscription,
+			deliveryID:   deliveryID,
+			eventType:    string(message.Type),
+			payload:      payload,
+			attempt:      1,
+		})
+	}
+}
+
+func (m *Module) enqueue(ctx context.Context, d *delivery) {
+	select {
+	case m.queue <- d:
+	default:
+		metrics.WebhookDeliveries.WithLabelValues("dropped").Inc()
+		log.WithFields(log.Fields{
+			"subscription_id": d.subscription.ID,
+			"delivery_id":     d.deliveryID,
+			"attempt":         d.attempt,
+		}).WarnWithCtx(ctx, "[WebhookUseCases.Run] Delivery queue full, dropping webhook delivery")
+	}
+}
+
+func (m *Module) work(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case d := <-m.queue:
+			m.deliver(ctx, d)
+		}
+	}
+}
+
+// deliver makes one attempt, logs it and either schedules the next attempt or
+// settles the health of the subscription.
+func (m *Module) deliver(ctx context.Context, d *delivery) {
+	m.mu.RLock()
+	req := &webhook.Request{
+		URL:        d.subscription.URL,
+		Secret:     d.subscription.Secret,
+		DeliveryID: d.deliveryID,
+		EventType:  d.eventType,
+		Payload:    d.payload,
+	}
+	m.mu.RUnlock()
+
+	attempt := m.attempt(ctx, d.subscription.ID, req, d.attempt, d.attempt < m.cfg.MaxAttempts)
+
+	switch attempt.Status {
+	case model.WebhookDeliverySucceeded:
+		m.settle(ctx, d.subscription, nil, attempt.AttemptedAt)
+	case model.WebhookDeliveryRetrying:
+		next := *d
+		next.attempt++
+		time.AfterFunc(webhook.Backoff(d.attempt, m.cfg.InitialBackoff, m.cfg.MaxBackoff), func() {
+			if ctx.Err() == nil {
+				m.enqueue(ctx, &next)
+			}
+		})
+	default:
+		m.settle(ctx, d.subscription, errors.New(attempt.Error), attempt.AttemptedAt)
+	}
+}
+
+// attempt sends the request once and records the attempt in the delivery log.
+func (m *Module) attempt(ctx context.Context, subscriptionID string, req *webhook.Request, attempt int, retry bool) *model.WebhookDelivery {
+	start := time.Now()
+	code, err := m.sender.Send(ctx, req)
+
+	record := &model.WebhookDelivery{
+		ID:             uuid.NewString(),
+		DeliveryID:     req.DeliveryID,
+		SubscriptionID: subscriptionID,
+		EventType:      req.EventType,
+		Payload:        string(req.Payload),
+		Attempt:        attempt,
+		Status:         model.WebhookDeliverySucceeded,
+		ResponseCode:   code,
+		DurationMs:     time.Since(start).Milliseconds(),
+		AttemptedAt:    start,
+	}
+	if err != nil {
+		record.Error = err.Error()
+		record.Status = model.WebhookDeliveryFailed
+		if retry {
+			record.Status = model.WebhookDeliveryRetrying
+		}
+	}
+	metrics.WebhookDeliveries.WithLabelValues(record.Status).Inc()
+
+	if err := m.webhookRepo.InsertWebhookDelivery(ctx, record); err != nil {
+		log.WithFields(log.Fields{
+			"error":       err,
+			"delivery_id": record.DeliveryID,
+			"attempt":     record.Attempt,
+		}).ErrorWithCtx(ctx, "[WebhookUseCases] Failed to log webhook delivery")
+	}
+
+	return record
+}
+
+// settle records the final outcome of a delivery on its subscription. Failures
+// in a row deactivate the endpoint once they reach the configured limit.
+// Successes are only written back when they clear a failure or the last
+// success is older than the refresh interval, to keep updates rare.
+func (m *Module) settle(ctx context.Context, subscription *model.WebhookSubscription, deliveryErr error, at time.Time) {
+	m.mu.Lock()
+	if deliveryErr == nil {
+		stale := subscription.LastSuccessAt == nil || at.Sub(*subscription.LastSuccessAt) > m.cfg.RefreshInterval
+		if subscription.ConsecutiveFailures == 0 && subscription.LastError == "" && !stale {
+			m.mu.Unlock()
+			return
+		}
+		subscription.ConsecutiveFailures = 0
+		subscription.LastError = ""
+		subscription.LastSuccessAt = &at
+	} else {
+		subscription.ConsecutiveFailures++
+		subscription.LastError = deliveryErr.Error()
+		subscription.LastFailureAt = &at
+		if m.cfg.DisableAfterFailures > 0 && subscription.ConsecutiveFailures >= m.cfg.DisableAfterFailures && subscription.Active {
+			subscription.Active = false
+			log.WithFields(log.Fields{
+				"subscription_id": subscription.ID,
+				"url":             subscription.URL,
+				"failures":        subscription.ConsecutiveFailures,
+			}).WarnWithCtx(ctx, "[WebhookUseCases.Run] Deactivating failing webhook endpoint")
+		}
+	}
+	health := *subscription
+	m.mu.Unlock()
+
+	if err := m.webhookRepo.UpdateWebhookHealth(ctx, &health); err != nil {
+		log.WithFields(log.Fields{
+			"error":           err,
+			"subscription_id": health.ID,
+		}).ErrorWithCtx(ctx, "[WebhookUseCases.Run] Failed to update webhook health")
+	}
+}
+
+func (m *Module) GetWebhookDeliveries(ctx context.Context, id string, limit, offset int) ([]*response.WebhookDeliveryResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookUseCases.GetWebhookDeliveries")
+	defer span.End()
+
+	if _, err := m.getSubscription(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = 50
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	deliveries, err := m.webhookRepo.GetWebhookDeliveries(ctx, id, limit, offset)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WebhookUseCases.GetWebhookDeliveries] Failed to get webhook deliveries")
+		return nil, err
+	}
+
+	responses := make([]*response.WebhookDeliveryResponse, 0, len(deliveries))
+	for _, d := range deliveries {
+		responses = append(responses, toDeliveryResponse(d))
+	}
+
+	return responses, nil
+}
+
+// ReplayWebhookDelivery sends the payload of a past delivery again, once, to the
+// current URL and secret of its subscription, even when it is deactivated.
+func (m *Module) ReplayWebhookDelivery(ctx context.Context, deliveryID string) (*response.WebhookDeliveryResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookUseCases.ReplayWebhookDelivery")
+	defer span.End()
+
+	if deliveryID == "" {
+		return nil, &custerr.ErrChain{
+			Message: "delivery ID is required",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+
+	previous, err := m.webhookRepo.GetLatestWebhookDelivery(ctx, deliveryID)
+	if err != nil {
+		if errors.Is(err, dao.ErrNoResult) {
+			return nil, &custerr.ErrChain{
+				Message: "webhook delivery not found",
+				Code:    404,
+				Type:    response2.ErrNotFound,
+			}
+		}
+
+		log.WithFields(log.Fields{
+			"error":       err,
+			"delivery_id": deliveryID,
+		}).ErrorWithCtx(ctx, "[WebhookUseCases.ReplayWebhookDelivery] Failed to get webhook delivery")
+		return nil, err
+	}
+
+	subscription, err := m.getSubscription(ctx, previous.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	attempt := m.attempt(ctx, subscription.ID, &webhook.Request{
+		URL:        subscription.URL,
+		Secret:     subscription.Secret,
+		DeliveryID: previous.DeliveryID,
+		EventType:  previous.EventType,
+		Payload:    []byte(previous.Payload),
+	}, previous.Attempt+1, false)
+
+	log.WithFields(log.Fields{
+		"delivery_id":     deliveryID,
+		"subscription_id": subscription.ID,
+		"status":          attempt.Status,
+	}).InfoWithCtx(ctx, "[WebhookUseCases.ReplayWebhookDelivery] Webhook delivery replayed")
+
+	return toDeliveryResponse(attempt), nil
+}
+
+// matches applies the filters of a subscription. A filter only excludes events
+// naming another election pair or region.
+func matches(subscription *model.WebhookSubscription, message *websocket.LiveMessage) bool {
+	if len(subscription.EventTypes) > 0 {
+		found := false
+		for _, eventType := range subscription.EventTypes {
+			if eventType == string(message.Type) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if message.Filter == nil {
+		return true
+	}
+	if subscription.ElectionPairID != "" && message.Filter.ElectionPairID != "" && subscription.ElectionPairID != message.Filter.ElectionPairID {
+		return false
+	}
+	if subscription.Region != "" && message.Filter.Region != "" && subscription.Region != message.Filter.Region {
+		return false
+	}
+	return true
+}
+
+func toDeliveryResponse(d *model.WebhookDelivery) *response.WebhookDeliveryResponse {
+	return &response.WebhookDeliveryResponse{
+		ID:             d.ID,
+		DeliveryID:     d.DeliveryID,
+		SubscriptionID: d.SubscriptionID,
+		EventType:      d.EventType,
+		Attempt:        d.Attempt,
+		Status:         d.Status,
+		ResponseCode:   d.ResponseCode,
+		Error:          d.Error,
+		DurationMs:     d.DurationMs,
+		AttemptedAt:    d.AttemptedAt,
+	}
+}
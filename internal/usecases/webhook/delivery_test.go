@@ -0,0 +1,301 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/infrastructures/webhook"
+	"github.com/nocturna-ta/result/internal/infrastructures/websocket"
+	"github.com/nocturna-ta/result/internal/interfaces/dao"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+)
+
+const testSecret = "s3cret"
+
+// memoryRepo keeps subscriptions and the delivery log in memory.
+type memoryRepo struct {
+	mu            sync.Mutex
+	subscriptions []*model.WebhookSubscription
+	deliveries    []*model.WebhookDelivery
+	health        []model.WebhookSubscription
+}
+
+func (r *memoryRepo) InsertWebhookSubscription(ctx context.Context, subscription *model.WebhookSubscription) error {
+	return nil
+}
+
+func (r *memoryRepo) UpdateWebhookSubscription(ctx context.Context, subscription *model.WebhookSubscription) error {
+	return nil
+}
+
+func (r *memoryRepo) UpdateWebhookHealth(ctx context.Context, subscription *model.WebhookSubscription) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.health = append(r.health, *subscription)
+	return nil
+}
+
+func (r *memoryRepo) DeleteWebhookSubscription(ctx context.Context, id string) error {
+	return nil
+}
+
+func (r *memoryRepo) GetWebhookSubscription(ctx context.Context, id string) (*model.WebhookSubscription, error) {
+	for _, subscription := range r.subscriptions {
+		if subscription.ID == id {
+			return subscription, nil
+		}
+	}
+	return nil, dao.ErrNoResult
+}
+
+func (r *memoryRepo) GetWebhookSubscriptions(ctx context.Context) ([]*model.WebhookSubscription, error) {
+	return r.subscriptions, nil
+}
+
+func (r *memoryRepo) InsertWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.deliveries = append(r.deliveries, delivery)
+	return nil
+}
+
+func (r *memoryRepo) GetWebhookDeliveries(ctx context.Context, subscriptionID string, limit, offset int) ([]*model.WebhookDelivery, error) {
+	return nil, nil
+}
+
+func (r *memoryRepo) GetLatestWebhookDelivery(ctx context.Context, deliveryID string) (*model.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := len(r.deliveries) - 1; i >= 0; i-- {
+		if r.deliveries[i].DeliveryID == deliveryID {
+			return r.deliveries[i], nil
+		}
+	}
+	return nil, dao.ErrNoResult
+}
+
+func (r *memoryRepo) snapshot() ([]*model.WebhookDelivery, []model.WebhookSubscription) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*model.WebhookDelivery(nil), r.deliveries...), append([]model.WebhookSubscription(nil), r.health...)
+}
+
+// receiver is a webhook endpoint answering with the given status codes in
+// turn, then 200, and recording whether each request was signed correctly.
+type receiver struct {
+	mu       sync.Mutex
+	statuses []int
+	requests []*http.Request
+	valid    []bool
+	bodies   [][]byte
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	rc.mu.Lock()
+	rc.requests = append(rc.requests, r)
+	rc.bodies = append(rc.bodies, body)
+	rc.valid = append(rc.valid, webhook.Verify(testSecret, r.Header.Get(webhook.HeaderTimestamp), body, r.Header.Get(webhook.HeaderSignature)))
+	status := http.StatusOK
+	if len(rc.statuses) > 0 {
+		status, rc.statuses = rc.statuses[0], rc.statuses[1:]
+	}
+	rc.mu.Unlock()
+
+	w.WriteHeader(status)
+}
+
+func (rc *receiver) count() int {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return len(rc.requests)
+}
+
+func startDelivery(t *testing.T, cfg config.WebhookConfig, statuses ...int) (*memoryRepo, *receiver, *websocket.Hub) {
+	t.Helper()
+
+	rc := &receiver{statuses: statuses}
+	server := httptest.NewServer(rc)
+	t.Cleanup(server.Close)
+
+	repo := &memoryRepo{subscriptions: []*model.WebhookSubscription{{
+		ID:     "subscription",
+		URL:    server.URL,
+		Secret: testSecret,
+		Active: true,
+	}}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	hub := websocket.NewHub(ctx, config.LiveResultsConfig{}, nil)
+	go hub.Run()
+	t.Cleanup(hub.Stop)
+
+	cfg.Enabled = true
+	module := New(&Options{WebhookRepo: repo, Hub: hub, Cfg: cfg})
+	go module.Run(ctx)
+
+	// Run registers its listener before it starts reading broadcasts.
+	deadline := time.Now().Add(2 * time.Second)
+	for !hub.HasSubscribers() {
+		if time.Now().After(deadline) {
+			t.Fatal("webhook delivery did not start listening")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	return repo, rc, hub
+}
+
+func waitFor(t *testing.T, what string, done func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !done() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestDeliveryIsSignedAndRetriedOnServerErrors(t *testing.T) {
+	repo, rc, hub := startDelivery(t, config.WebhookConfig{
+		MaxAttempts:    3,
+		InitialBackoff: 20 * time.Millisecond,
+		MaxBackoff:     40 * time.Millisecond,
+	}, http.StatusServiceUnavailable, http.StatusBadGateway)
+
+	hub.BroadcastStatisticsUpdate(&response.VoteStatisticsResponse{TotalVotes: 7}, false)
+	waitFor(t, "three attempts", func() bool {
+		deliveries, _ := repo.snapshot()
+		return len(deliveries) == 3
+	})
+
+	deliveries, health := repo.snapshot()
+	wantStatuses := []string{model.WebhookDeliveryRetrying, model.WebhookDeliveryRetrying, model.WebhookDeliverySucceeded}
+	wantCodes := []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK}
+	for i, delivery := range deliveries {
+		if delivery.Attempt != i+1 || delivery.Status != wantStatuses[i] || delivery.ResponseCode != wantCodes[i] {
+			t.Errorf("attempt %d recorded as %d %s %d, want %d %s %d", i+1,
+				delivery.Attempt, delivery.Status, delivery.ResponseCode, i+1, wantStatuses[i], wantCodes[i])
+		}
+		if delivery.DeliveryID != deliveries[0].DeliveryID {
+			t.Errorf("attempt %d has delivery ID %s, want %s", i+1, delivery.DeliveryID, deliveries[0].DeliveryID)
+		}
+	}
+
+	// Retries back off: the gap before the second retry is at least the doubled delay.
+	if gap := deliveries[2].AttemptedAt.Sub(deliveries[1].AttemptedAt); gap < 40*time.Millisecond {
+		t.Errorf("second retry came %s after the first, want at least 40ms", gap)
+	}
+
+	rc.mu.Lock()
+	for i, request := range rc.requests {
+		if !rc.valid[i] {
+			t.Errorf("request %d has an invalid signature", i+1)
+		}
+		if request.Header.Get(webhook.HeaderDeliveryID) != deliveries[0].DeliveryID {
+			t.Errorf("request %d has delivery ID header %q", i+1, request.Header.Get(webhook.HeaderDeliveryID))
+		}
+		if request.Header.Get(webhook.HeaderEvent) != string(websocket.MessageTypeStatistics) {
+			t.Errorf("request %d has event header %q", i+1, request.Header.Get(webhook.HeaderEvent))
+		}
+	}
+	var event webhook.Event
+	if err := json.Unmarshal(rc.bodies[0], &event); err != nil || event.ID != deliveries[0].DeliveryID {
+		t.Errorf("body = %s, want an event with the delivery ID", rc.bodies[0])
+	}
+	rc.mu.Unlock()
+
+	waitFor(t, "the health update", func() bool {
+		_, health = repo.snapshot()
+		return len(health) == 1
+	})
+	if health[0].ConsecutiveFailures != 0 || health[0].LastSuccessAt == nil {
+		t.Errorf("health = %+v, want a recorded success", health[0])
+	}
+}
+
+func TestDeliveryGivesUpAfterMaxAttempts(t *testing.T) {
+	repo, rc, hub := startDelivery(t, config.WebhookConfig{
+		MaxAttempts:          2,
+		InitialBackoff:       10 * time.Millisecond,
+		DisableAfterFailures: 1,
+	}, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError)
+
+	hub.BroadcastStatisticsUpdate(&response.VoteStatisticsResponse{TotalVotes: 7}, false)
+	waitFor(t, "the health update", func() bool {
+		_, health := repo.snapshot()
+		return len(health) == 1
+	})
+
+	deliveries, health := repo.snapshot()
+	if len(deliveries) != 2 || rc.count() != 2 {
+		t.Fatalf("%d attempts logged and %d received, want 2", len(deliveries), rc.count())
+	}
+	if deliveries[0].Status != model.WebhookDeliveryRetrying || deliveries[1].Status != model.WebhookDeliveryFailed {
+		t.Fatalf("attempts recorded as %s and %s, want retrying then failed", deliveries[0].Status, deliveries[1].Status)
+	}
+	if deliveries[1].Error == "" {
+		t.Fatal("failed attempt has no error")
+	}
+	if health[0].Active || health[0].ConsecutiveFailures != 1 || health[0].LastFailureAt == nil {
+		t.Fatalf("health = %+v, want the endpoint deactivated after one failed delivery", health[0])
+	}
+}
+
+func TestReplayWebhookDelivery(t *testing.T) {
+	rc := &receiver{}
+	server := httptest.NewServer(rc)
+	defer server.Close()
+
+	repo := &memoryRepo{
+		subscriptions: []*model.WebhookSubscription{{ID: "subscription", URL: server.URL, Secret: testSecret}},
+		deliveries: []*model.WebhookDelivery{{
+			ID:             "attempt",
+			DeliveryID:     "delivery",
+			SubscriptionID: "subscription",
+			EventType:      string(websocket.MessageTypeStatistics),
+			Payload:        `{"id":"delivery"}`,
+			Attempt:        3,
+			Status:         model.WebhookDeliveryFailed,
+		}},
+	}
+	module := New(&Options{WebhookRepo: repo, Cfg: config.WebhookConfig{}})
+
+	replayed, err := module.ReplayWebhookDelivery(context.Background(), "delivery")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayed.Attempt != 4 || replayed.Status != model.WebhookDeliverySucceeded || replayed.ResponseCode != http.StatusOK {
+		t.Fatalf("replay = %+v, want attempt 4 succeeded with 200", replayed)
+	}
+	if rc.count() != 1 || !rc.valid[0] || string(rc.bodies[0]) != `{"id":"delivery"}` {
+		t.Fatalf("receiver got %d requests, want the signed original payload once", rc.count())
+	}
+}
+
+func TestSignature(t *testing.T) {
+	payload := []byte(`{"id":"delivery"}`)
+	signature := webhook.Sign(testSecret, "1700000000", payload)
+
+	if !webhook.Verify(testSecret, "1700000000", payload, signature) {
+		t.Fatal("signature does not verify")
+	}
+	if webhook.Verify("other", "1700000000", payload, signature) {
+		t.Fatal("signature verifies with another secret")
+	}
+	if webhook.Verify(testSecret, "1700000001", payload, signature) {
+		t.Fatal("signature verifies with another timestamp")
+	}
+}
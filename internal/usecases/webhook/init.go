@@ -0,0 +1,59 @@
+package webhook
+
+import (
+	"github.com/nocturna-ta/result/config"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/domain/repository"
+	"github.com/nocturna-ta/result/internal/infrastructures/webhook"
+	"github.com/nocturna-ta/result/internal/infrastructures/websocket"
+	"github.com/nocturna-ta/result/internal/usecases"
+	"sync"
+	"time"
+)
+
+type Module struct {
+	webhookRepo repository.WebhookRepository
+	sender      *webhook.Sender
+	hub         *websocket.Hub
+	cfg         config.WebhookConfig
+	queue       chan *delivery
+
+	mu            sync.RWMutex
+	subscriptions []*model.WebhookSubscription
+}
+
+type Options struct {
+	WebhookRepo repository.WebhookRepository
+	Sender      *webhook.Sender
+	Hub         *websocket.Hub
+	Cfg         config.WebhookConfig
+}
+
+func New(opts *Options) usecases.WebhookUseCases {
+	cfg := opts.Cfg
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 1
+	}
+	if cfg.Workers <= 0 {
+		cfg.Workers = 1
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = 1
+	}
+	if cfg.RefreshInterval <= 0 {
+		cfg.RefreshInterval = 30 * time.Second
+	}
+
+	sender := opts.Sender
+	if sender == nil {
+		sender = webhook.NewSender(nil, cfg.Timeout)
+	}
+
+	return &Module{
+		webhookRepo: opts.WebhookRepo,
+		sender:      sender,
+		hub:         opts.Hub,
+		cfg:         cfg,
+		queue:       make(chan *delivery, cfg.QueueSize),
+	}
+}
@@ -0,0 +1,268 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/nocturna-ta/golib/custerr"
+	"github.com/nocturna-ta/golib/log"
+	response2 "github.com/nocturna-ta/golib/response"
+	"github.com/nocturna-ta/golib/tracing"
+	"github.com/nocturna-ta/result/internal/domain/model"
+	"github.com/nocturna-ta/result/internal/infrastructures/websocket"
+	"github.com/nocturna-ta/result/internal/interfaces/dao"
+	"github.com/nocturna-ta/result/internal/usecases/request"
+	"github.com/nocturna-ta/result/internal/usecases/response"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const minSecretLength = 16
+
+// eventTypes are the live message types a subscription can receive.
+var eventTypes = []websocket.MessageType{
+	websocket.MessageTypeVoteUpdate,
+	websocket.MessageTypeElectionUpdate,
+	websocket.MessageTypeRegionUpdate,
+	websocket.MessageTypeStatistics,
+	websocket.MessageTypePollsClosed,
+}
+
+func (m *Module) CreateWebhookSubscription(ctx context.Context, req *request.WebhookSubscriptionRequest) (*response.WebhookSubscriptionResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookUseCases.CreateWebhookSubscription")
+	defer span.End()
+
+	if err := validateSubscription(req); err != nil {
+		return nil, err
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		var err error
+		if secret, err = newSecret(); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	subscription := &model.WebhookSubscription{
+		ID:             uuid.NewString(),
+		URL:            req.URL,
+		Secret:         secret,
+		EventTypes:     normalizeEventTypes(req.EventTypes),
+		ElectionPairID: req.ElectionPairID,
+		Region:         req.Region,
+		Active:         req.Active == nil || *req.Active,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err := m.webhookRepo.InsertWebhookSubscription(ctx, subscription); err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"url":   subscription.URL,
+		}).ErrorWithCtx(ctx, "[WebhookUseCases.CreateWebhookSubscription] Failed to create webhook subscription")
+		return nil, err
+	}
+
+	res := toSubscriptionResponse(subscription)
+	res.Secret = subscription.Secret
+	return res, nil
+}
+
+func (m *Module) GetWebhookSubscriptions(ctx context.Context) ([]*response.WebhookSubscriptionResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookUseCases.GetWebhookSubscriptions")
+	defer span.End()
+
+	subscriptions, err := m.webhookRepo.GetWebhookSubscriptions(ctx)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).ErrorWithCtx(ctx, "[WebhookUseCases.GetWebhookSubscriptions] Failed to get webhook subscriptions")
+		return nil, err
+	}
+
+	responses := make([]*response.WebhookSubscriptionResponse, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		responses = append(responses, toSubscriptionResponse(subscription))
+	}
+
+	return responses, nil
+}
+
+func (m *Module) GetWebhookSubscription(ctx context.Context, id string) (*response.WebhookSubscriptionResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookUseCases.GetWebhookSubscription")
+	defer span.End()
+
+	subscription, err := m.getSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toSubscriptionResponse(subscription), nil
+}
+
+func (m *Module) UpdateWebhookSubscription(ctx context.Context, id string, req *request.WebhookSubscriptionRequest) (*response.WebhookSubscriptionResponse, error) {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookUseCases.UpdateWebhookSubscription")
+	defer span.End()
+
+	if err := validateSubscription(req); err != nil {
+		return nil, err
+	}
+
+	subscription, err := m.getSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription.URL = req.URL
+	subscription.EventTypes = normalizeEventTypes(req.EventTypes)
+	subscription.ElectionPairID = req.ElectionPairID
+	subscription.Region = req.Region
+	if req.Secret != "" {
+		subscription.Secret = req.Secret
+	}
+	if req.Active != nil {
+		// Reactivating an endpoint gives it a fresh failure budget.
+		if *req.Active && !subscription.Active {
+			subscription.ConsecutiveFailures = 0
+		}
+		subscription.Active = *req.Active
+	}
+	subscription.UpdatedAt = time.Now()
+
+	if err = m.webhookRepo.UpdateWebhookSubscription(ctx, subscription); err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WebhookUseCases.UpdateWebhookSubscription] Failed to update webhook subscription")
+		return nil, err
+	}
+
+	return toSubscriptionResponse(subscription), nil
+}
+
+func (m *Module) DeleteWebhookSubscription(ctx context.Context, id string) error {
+	span, ctx := tracing.StartSpanFromContext(ctx, "WebhookUseCases.DeleteWebhookSubscription")
+	defer span.End()
+
+	if _, err := m.getSubscription(ctx, id); err != nil {
+		return err
+	}
+
+	if err := m.webhookRepo.DeleteWebhookSubscription(ctx, id); err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WebhookUseCases.DeleteWebhookSubscription] Failed to delete webhook subscription")
+		return err
+	}
+
+	return nil
+}
+
+func (m *Module) getSubscription(ctx context.Context, id string) (*model.WebhookSubscription, error) {
+	if id == "" {
+		return nil, &custerr.ErrChain{
+			Message: "webhook subscription ID is required",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+
+	subscription, err := m.webhookRepo.GetWebhookSubscription(ctx, id)
+	if err != nil {
+		if errors.Is(err, dao.ErrNoResult) {
+			return nil, &custerr.ErrChain{
+				Message: "webhook subscription not found",
+				Code:    404,
+				Type:    response2.ErrNotFound,
+			}
+		}
+
+		log.WithFields(log.Fields{
+			"error": err,
+			"id":    id,
+		}).ErrorWithCtx(ctx, "[WebhookUseCases] Failed to get webhook subscription")
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func validateSubscription(req *request.WebhookSubscriptionRequest) error {
+	endpoint, err := url.Parse(req.URL)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return &custerr.ErrChain{
+			Message: "url must be an absolute http or https URL",
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+
+	if req.Secret != "" && len(req.Secret) < minSecretLength {
+		return &custerr.ErrChain{
+			Message: fmt.Sprintf("secret must be at least %d characters", minSecretLength),
+			Code:    400,
+			Type:    response2.ErrBadRequest,
+		}
+	}
+
+	for _, eventType := range req.EventTypes {
+		if !validEventType(eventType) {
+			return &custerr.ErrChain{
+				Message: fmt.Sprintf("unknown event type %q", eventType),
+				Code:    400,
+				Type:    response2.ErrBadRequest,
+			}
+		}
+	}
+
+	return nil
+}
+
+func validEventType(eventType string) bool {
+	for _, known := range eventTypes {
+		if string(known) == strings.TrimSpace(eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeEventTypes(types []string) []string {
+	normalized := make([]string, 0, len(types))
+	for _, eventType := range types {
+		normalized = append(normalized, strings.TrimSpace(eventType))
+	}
+	return normalized
+}
+
+func newSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func toSubscriptionResponse(subscription *model.WebhookSubscription) *response.WebhookSubscriptionResponse {
+	return &response.WebhookSubscriptionResponse{
+		ID:                  subscription.ID,
+		URL:                 subscription.URL,
+		EventTypes:          subscription.EventTypes,
+		ElectionPairID:      subscription.ElectionPairID,
+		Region:              subscription.Region,
+		Active:              subscription.Active,
+		ConsecutiveFailures: subscription.ConsecutiveFailures,
+		LastError:           subscription.LastError,
+		LastSuccessAt:       subscription.LastSuccessAt,
+		LastFailureAt:       subscription.LastFailureAt,
+		CreatedAt:           subscription.CreatedAt,
+		UpdatedAt:           subscription.UpdatedAt,
+	}
+}
@@ -5,4 +5,8 @@ const (
 	Create            = "create"
 	Update            = "update"
 	Delete            = "delete"
+
+	// MetaDataFinal marks a processed vote event whose confirmed status is
+	// final, so it is not held as finalizing.
+	MetaDataFinal = "final"
 )
@@ -0,0 +1,8 @@
+package constants
+
+const (
+	// RoleObserver may read raw vote rows in addition to the public aggregates.
+	RoleObserver = "observer"
+	// RoleAdmin may trigger broadcasts, DLQ, recount and certification actions.
+	RoleAdmin = "admin"
+)
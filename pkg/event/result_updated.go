@@ -0,0 +1,33 @@
+// Package event holds the messages the result service publishes to Kafka for
+// other services.
+package event
+
+import "time"
+
+// ResultUpdated is the latest tally of an election pair in a region. It is
+// published under ResultUpdatedKey on a compacted topic, so the topic keeps the
+// current state of every tally and can be replayed from the start.
+type ResultUpdated struct {
+	ElectionPairID string       `json:"election_pair_id"`
+	Region         string       `json:"region"`
+	Counts         ResultCounts `json:"counts"`
+	// Version only grows for a key. Consumers should ignore an event whose
+	// version is not above the last one they applied for the key.
+	Version   int64     `json:"version"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ResultCounts are the votes of a tally by status. Confirmed votes are final;
+// finalizing votes are confirmed but their block may still be reorganized.
+type ResultCounts struct {
+	Total      uint64 `json:"total"`
+	Pending    uint64 `json:"pending"`
+	Confirmed  uint64 `json:"confirmed"`
+	Finalizing uint64 `json:"finalizing"`
+	Error      uint64 `json:"error"`
+}
+
+// ResultUpdatedKey is the message key of the tally of an election pair in a region.
+func ResultUpdatedKey(electionPairID, region string) string {
+	return electionPairID + "/" + region
+}
@@ -0,0 +1,780 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: result/v1/result.proto
+
+package resultv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ResultService_GetVoteResult_FullMethodName                = "/result.v1.ResultService/GetVoteResult"
+	ResultService_GetVoteResultsByElectionPair_FullMethodName = "/result.v1.ResultService/GetVoteResultsByElectionPair"
+	ResultService_GetVoteResultsByRegion_FullMethodName       = "/result.v1.ResultService/GetVoteResultsByRegion"
+	ResultService_GetVoteResultsByStatus_FullMethodName       = "/result.v1.ResultService/GetVoteResultsByStatus"
+	ResultService_GetVoteResultsByDateRange_FullMethodName    = "/result.v1.ResultService/GetVoteResultsByDateRange"
+	ResultService_GetVoteResultsByHour_FullMethodName         = "/result.v1.ResultService/GetVoteResultsByHour"
+	ResultService_GetVoteResultsByDay_FullMethodName          = "/result.v1.ResultService/GetVoteResultsByDay"
+	ResultService_GetElectionResults_FullMethodName           = "/result.v1.ResultService/GetElectionResults"
+	ResultService_GetElectionResultsByRegion_FullMethodName   = "/result.v1.ResultService/GetElectionResultsByRegion"
+	ResultService_GetRegionResults_FullMethodName             = "/result.v1.ResultService/GetRegionResults"
+	ResultService_GetRegionStatistics_FullMethodName          = "/result.v1.ResultService/GetRegionStatistics"
+	ResultService_GetOverallStatistics_FullMethodName         = "/result.v1.ResultService/GetOverallStatistics"
+	ResultService_GetDailyStatistics_FullMethodName           = "/result.v1.ResultService/GetDailyStatistics"
+	ResultService_GetVoteBreakdown_FullMethodName             = "/result.v1.ResultService/GetVoteBreakdown"
+	ResultService_CountVotesByStatus_FullMethodName           = "/result.v1.ResultService/CountVotesByStatus"
+	ResultService_CountVotesByElectionPair_FullMethodName     = "/result.v1.ResultService/CountVotesByElectionPair"
+	ResultService_CountVotesByRegion_FullMethodName           = "/result.v1.ResultService/CountVotesByRegion"
+	ResultService_WatchResults_FullMethodName                 = "/result.v1.ResultService/WatchResults"
+)
+
+// ResultServiceClient is the client API for ResultService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ResultService exposes vote result queries and live result streaming to other backend services.
+type ResultServiceClient interface {
+	GetVoteResult(ctx context.Context, in *GetVoteResultRequest, opts ...grpc.CallOption) (*VoteResult, error)
+	GetVoteResultsByElectionPair(ctx context.Context, in *GetVoteResultsByElectionPairRequest, opts ...grpc.CallOption) (*VoteResultList, error)
+	GetVoteResultsByRegion(ctx context.Context, in *GetVoteResultsByRegionRequest, opts ...grpc.CallOption) (*VoteResultList, error)
+	GetVoteResultsByStatus(ctx context.Context, in *GetVoteResultsByStatusRequest, opts ...grpc.CallOption) (*VoteResultList, error)
+	GetVoteResultsByDateRange(ctx context.Context, in *GetVoteResultsByDateRangeRequest, opts ...grpc.CallOption) (*VoteResultList, error)
+	GetVoteResultsByHour(ctx context.Context, in *GetVoteResultsByTimeRequest, opts ...grpc.CallOption) (*VoteResultList, error)
+	GetVoteResultsByDay(ctx context.Context, in *GetVoteResultsByTimeRequest, opts ...grpc.CallOption) (*VoteResultList, error)
+	GetElectionResults(ctx context.Context, in *GetElectionResultsRequest, opts ...grpc.CallOption) (*ElectionResult, error)
+	GetElectionResultsByRegion(ctx context.Context, in *GetElectionResultsByRegionRequest, opts ...grpc.CallOption) (*ElectionResultList, error)
+	GetRegionResults(ctx context.Context, in *GetRegionResultsRequest, opts ...grpc.CallOption) (*RegionResult, error)
+	GetRegionStatistics(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RegionResultList, error)
+	GetOverallStatistics(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VoteStatistics, error)
+	GetDailyStatistics(ctx context.Context, in *GetDailyStatisticsRequest, opts ...grpc.CallOption) (*VoteStatisticsList, error)
+	// GetVoteBreakdown returns vote counts per time bucket, election pair, region and status.
+	GetVoteBreakdown(ctx context.Context, in *GetVoteBreakdownRequest, opts ...grpc.CallOption) (*VoteBreakdown, error)
+	CountVotesByStatus(ctx context.Context, in *CountVotesByStatusRequest, opts ...grpc.CallOption) (*CountResponse, error)
+	CountVotesByElectionPair(ctx context.Context, in *CountVotesByElectionPairRequest, opts ...grpc.CallOption) (*CountResponse, error)
+	CountVotesByRegion(ctx context.Context, in *CountVotesByRegionRequest, opts ...grpc.CallOption) (*CountResponse, error)
+	// WatchResults streams live result updates matching the requested subscriptions until the client cancels.
+	WatchResults(ctx context.Context, in *WatchResultsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ResultUpdate], error)
+}
+
+type resultServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewResultServiceClient(cc grpc.ClientConnInterface) ResultServiceClient {
+	return &resultServiceClient{cc}
+}
+
+func (c *resultServiceClient) GetVoteResult(ctx context.Context, in *GetVoteResultRequest, opts ...grpc.CallOption) (*VoteResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteResult)
+	err := c.cc.Invoke(ctx, ResultService_GetVoteResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultServiceClient) GetVoteResultsByElectionPair(ctx context.Context, in *GetVoteResultsByElectionPairRequest, opts ...grpc.CallOption) (*VoteResultList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteResultList)
+	err := c.cc.Invoke(ctx, ResultService_GetVoteResultsByElectionPair_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultServiceClient) GetVoteResultsByRegion(ctx context.Context, in *GetVoteResultsByRegionRequest, opts ...grpc.CallOption) (*VoteResultList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteResultList)
+	err := c.cc.Invoke(ctx, ResultService_GetVoteResultsByRegion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultServiceClient) GetVoteResultsByStatus(ctx context.Context, in *GetVoteResultsByStatusRequest, opts ...grpc.CallOption) (*VoteResultList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteResultList)
+	err := c.cc.Invoke(ctx, ResultService_GetVoteResultsByStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultServiceClient) GetVoteResultsByDateRange(ctx context.Context, in *GetVoteResultsByDateRangeRequest, opts ...grpc.CallOption) (*VoteResultList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteResultList)
+	err := c.cc.Invoke(ctx, ResultService_GetVoteResultsByDateRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultServiceClient) GetVoteResultsByHour(ctx context.Context, in *GetVoteResultsByTimeRequest, opts ...grpc.CallOption) (*VoteResultList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteResultList)
+	err := c.cc.Invoke(ctx, ResultService_GetVoteResultsByHour_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultServiceClient) GetVoteResultsByDay(ctx context.Context, in *GetVoteResultsByTimeRequest, opts ...grpc.CallOption) (*VoteResultList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteResultList)
+	err := c.cc.Invoke(ctx, ResultService_GetVoteResultsByDay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultServiceClient) GetElectionResults(ctx context.Context, in *GetElectionResultsRequest, opts ...grpc.CallOption) (*ElectionResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ElectionResult)
+	err := c.cc.Invoke(ctx, ResultService_GetElectionResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultServiceClient) GetElectionResultsByRegion(ctx context.Context, in *GetElectionResultsByRegionRequest, opts ...grpc.CallOption) (*ElectionResultList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ElectionResultList)
+	err := c.cc.Invoke(ctx, ResultService_GetElectionResultsByRegion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultServiceClient) GetRegionResults(ctx context.Context, in *GetRegionResultsRequest, opts ...grpc.CallOption) (*RegionResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegionResult)
+	err := c.cc.Invoke(ctx, ResultService_GetRegionResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultServiceClient) GetRegionStatistics(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RegionResultList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegionResultList)
+	err := c.cc.Invoke(ctx, ResultService_GetRegionStatistics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultServiceClient) GetOverallStatistics(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VoteStatistics, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteStatistics)
+	err := c.cc.Invoke(ctx, ResultService_GetOverallStatistics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultServiceClient) GetDailyStatistics(ctx context.Context, in *GetDailyStatisticsRequest, opts ...grpc.CallOption) (*VoteStatisticsList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteStatisticsList)
+	err := c.cc.Invoke(ctx, ResultService_GetDailyStatistics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultServiceClient) GetVoteBreakdown(ctx context.Context, in *GetVoteBreakdownRequest, opts ...grpc.CallOption) (*VoteBreakdown, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteBreakdown)
+	err := c.cc.Invoke(ctx, ResultService_GetVoteBreakdown_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultServiceClient) CountVotesByStatus(ctx context.Context, in *CountVotesByStatusRequest, opts ...grpc.CallOption) (*CountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountResponse)
+	err := c.cc.Invoke(ctx, ResultService_CountVotesByStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultServiceClient) CountVotesByElectionPair(ctx context.Context, in *CountVotesByElectionPairRequest, opts ...grpc.CallOption) (*CountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountResponse)
+	err := c.cc.Invoke(ctx, ResultService_CountVotesByElectionPair_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultServiceClient) CountVotesByRegion(ctx context.Context, in *CountVotesByRegionRequest, opts ...grpc.CallOption) (*CountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountResponse)
+	err := c.cc.Invoke(ctx, ResultService_CountVotesByRegion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resultServiceClient) WatchResults(ctx context.Context, in *WatchResultsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ResultUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ResultService_ServiceDesc.Streams[0], ResultService_WatchResults_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchResultsRequest, ResultUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResultService_WatchResultsClient = grpc.ServerStreamingClient[ResultUpdate]
+
+// ResultServiceServer is the server API for ResultService service.
+// All implementations must embed UnimplementedResultServiceServer
+// for forward compatibility.
+//
+// ResultService exposes vote result queries and live result streaming to other backend services.
+type ResultServiceServer interface {
+	GetVoteResult(context.Context, *GetVoteResultRequest) (*VoteResult, error)
+	GetVoteResultsByElectionPair(context.Context, *GetVoteResultsByElectionPairRequest) (*VoteResultList, error)
+	GetVoteResultsByRegion(context.Context, *GetVoteResultsByRegionRequest) (*VoteResultList, error)
+	GetVoteResultsByStatus(context.Context, *GetVoteResultsByStatusRequest) (*VoteResultList, error)
+	GetVoteResultsByDateRange(context.Context, *GetVoteResultsByDateRangeRequest) (*VoteResultList, error)
+	GetVoteResultsByHour(context.Context, *GetVoteResultsByTimeRequest) (*VoteResultList, error)
+	GetVoteResultsByDay(context.Context, *GetVoteResultsByTimeRequest) (*VoteResultList, error)
+	GetElectionResults(context.Context, *GetElectionResultsRequest) (*ElectionResult, error)
+	GetElectionResultsByRegion(context.Context, *GetElectionResultsByRegionRequest) (*ElectionResultList, error)
+	GetRegionResults(context.Context, *GetRegionResultsRequest) (*RegionResult, error)
+	GetRegionStatistics(context.Context, *emptypb.Empty) (*RegionResultList, error)
+	GetOverallStatistics(context.Context, *emptypb.Empty) (*VoteStatistics, error)
+	GetDailyStatistics(context.Context, *GetDailyStatisticsRequest) (*VoteStatisticsList, error)
+	// GetVoteBreakdown returns vote counts per time bucket, election pair, region and status.
+	GetVoteBreakdown(context.Context, *GetVoteBreakdownRequest) (*VoteBreakdown, error)
+	CountVotesByStatus(context.Context, *CountVotesByStatusRequest) (*CountResponse, error)
+	CountVotesByElectionPair(context.Context, *CountVotesByElectionPairRequest) (*CountResponse, error)
+	CountVotesByRegion(context.Context, *CountVotesByRegionRequest) (*CountResponse, error)
+	// WatchResults streams live result updates matching the requested subscriptions until the client cancels.
+	WatchResults(*WatchResultsRequest, grpc.ServerStreamingServer[ResultUpdate]) error
+	mustEmbedUnimplementedResultServiceServer()
+}
+
+// UnimplementedResultServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedResultServiceServer struct{}
+
+func (UnimplementedResultServiceServer) GetVoteResult(context.Context, *GetVoteResultRequest) (*VoteResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVoteResult not implemented")
+}
+func (UnimplementedResultServiceServer) GetVoteResultsByElectionPair(context.Context, *GetVoteResultsByElectionPairRequest) (*VoteResultList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVoteResultsByElectionPair not implemented")
+}
+func (UnimplementedResultServiceServer) GetVoteResultsByRegion(context.Context, *GetVoteResultsByRegionRequest) (*VoteResultList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVoteResultsByRegion not implemented")
+}
+func (UnimplementedResultServiceServer) GetVoteResultsByStatus(context.Context, *GetVoteResultsByStatusRequest) (*VoteResultList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVoteResultsByStatus not implemented")
+}
+func (UnimplementedResultServiceServer) GetVoteResultsByDateRange(context.Context, *GetVoteResultsByDateRangeRequest) (*VoteResultList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVoteResultsByDateRange not implemented")
+}
+func (UnimplementedResultServiceServer) GetVoteResultsByHour(context.Context, *GetVoteResultsByTimeRequest) (*VoteResultList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVoteResultsByHour not implemented")
+}
+func (UnimplementedResultServiceServer) GetVoteResultsByDay(context.Context, *GetVoteResultsByTimeRequest) (*VoteResultList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVoteResultsByDay not implemented")
+}
+func (UnimplementedResultServiceServer) GetElectionResults(context.Context, *GetElectionResultsRequest) (*ElectionResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetElectionResults not implemented")
+}
+func (UnimplementedResultServiceServer) GetElectionResultsByRegion(context.Context, *GetElectionResultsByRegionRequest) (*ElectionResultList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetElectionResultsByRegion not implemented")
+}
+func (UnimplementedResultServiceServer) GetRegionResults(context.Context, *GetRegionResultsRequest) (*RegionResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegionResults not implemented")
+}
+func (UnimplementedResultServiceServer) GetRegionStatistics(context.Context, *emptypb.Empty) (*RegionResultList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegionStatistics not implemented")
+}
+func (UnimplementedResultServiceServer) GetOverallStatistics(context.Context, *emptypb.Empty) (*VoteStatistics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOverallStatistics not implemented")
+}
+func (UnimplementedResultServiceServer) GetDailyStatistics(context.Context, *GetDailyStatisticsRequest) (*VoteStatisticsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDailyStatistics not implemented")
+}
+func (UnimplementedResultServiceServer) GetVoteBreakdown(context.Context, *GetVoteBreakdownRequest) (*VoteBreakdown, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVoteBreakdown not implemented")
+}
+func (UnimplementedResultServiceServer) CountVotesByStatus(context.Context, *CountVotesByStatusRequest) (*CountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountVotesByStatus not implemented")
+}
+func (UnimplementedResultServiceServer) CountVotesByElectionPair(context.Context, *CountVotesByElectionPairRequest) (*CountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountVotesByElectionPair not implemented")
+}
+func (UnimplementedResultServiceServer) CountVotesByRegion(context.Context, *CountVotesByRegionRequest) (*CountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountVotesByRegion not implemented")
+}
+func (UnimplementedResultServiceServer) WatchResults(*WatchResultsRequest, grpc.ServerStreamingServer[ResultUpdate]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchResults not implemented")
+}
+func (UnimplementedResultServiceServer) mustEmbedUnimplementedResultServiceServer() {}
+func (UnimplementedResultServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeResultServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ResultServiceServer will
+// result in compilation errors.
+type UnsafeResultServiceServer interface {
+	mustEmbedUnimplementedResultServiceServer()
+}
+
+func RegisterResultServiceServer(s grpc.ServiceRegistrar, srv ResultServiceServer) {
+	// If the following call pancis, it indicates UnimplementedResultServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ResultService_ServiceDesc, srv)
+}
+
+func _ResultService_GetVoteResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVoteResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultServiceServer).GetVoteResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultService_GetVoteResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultServiceServer).GetVoteResult(ctx, req.(*GetVoteResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultService_GetVoteResultsByElectionPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVoteResultsByElectionPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultServiceServer).GetVoteResultsByElectionPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultService_GetVoteResultsByElectionPair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultServiceServer).GetVoteResultsByElectionPair(ctx, req.(*GetVoteResultsByElectionPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultService_GetVoteResultsByRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVoteResultsByRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultServiceServer).GetVoteResultsByRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultService_GetVoteResultsByRegion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultServiceServer).GetVoteResultsByRegion(ctx, req.(*GetVoteResultsByRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultService_GetVoteResultsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVoteResultsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultServiceServer).GetVoteResultsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultService_GetVoteResultsByStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultServiceServer).GetVoteResultsByStatus(ctx, req.(*GetVoteResultsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultService_GetVoteResultsByDateRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVoteResultsByDateRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultServiceServer).GetVoteResultsByDateRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultService_GetVoteResultsByDateRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultServiceServer).GetVoteResultsByDateRange(ctx, req.(*GetVoteResultsByDateRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultService_GetVoteResultsByHour_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVoteResultsByTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultServiceServer).GetVoteResultsByHour(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultService_GetVoteResultsByHour_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultServiceServer).GetVoteResultsByHour(ctx, req.(*GetVoteResultsByTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultService_GetVoteResultsByDay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVoteResultsByTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultServiceServer).GetVoteResultsByDay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultService_GetVoteResultsByDay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultServiceServer).GetVoteResultsByDay(ctx, req.(*GetVoteResultsByTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultService_GetElectionResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetElectionResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultServiceServer).GetElectionResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultService_GetElectionResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultServiceServer).GetElectionResults(ctx, req.(*GetElectionResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultService_GetElectionResultsByRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetElectionResultsByRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultServiceServer).GetElectionResultsByRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultService_GetElectionResultsByRegion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultServiceServer).GetElectionResultsByRegion(ctx, req.(*GetElectionResultsByRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultService_GetRegionResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegionResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultServiceServer).GetRegionResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultService_GetRegionResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultServiceServer).GetRegionResults(ctx, req.(*GetRegionResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultService_GetRegionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultServiceServer).GetRegionStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultService_GetRegionStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultServiceServer).GetRegionStatistics(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultService_GetOverallStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultServiceServer).GetOverallStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultService_GetOverallStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultServiceServer).GetOverallStatistics(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultService_GetDailyStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultServiceServer).GetDailyStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultService_GetDailyStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultServiceServer).GetDailyStatistics(ctx, req.(*GetDailyStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultService_GetVoteBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVoteBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultServiceServer).GetVoteBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultService_GetVoteBreakdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultServiceServer).GetVoteBreakdown(ctx, req.(*GetVoteBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultService_CountVotesByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountVotesByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultServiceServer).CountVotesByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultService_CountVotesByStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultServiceServer).CountVotesByStatus(ctx, req.(*CountVotesByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultService_CountVotesByElectionPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountVotesByElectionPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultServiceServer).CountVotesByElectionPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultService_CountVotesByElectionPair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultServiceServer).CountVotesByElectionPair(ctx, req.(*CountVotesByElectionPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultService_CountVotesByRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountVotesByRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResultServiceServer).CountVotesByRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResultService_CountVotesByRegion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResultServiceServer).CountVotesByRegion(ctx, req.(*CountVotesByRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResultService_WatchResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchResultsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResultServiceServer).WatchResults(m, &grpc.GenericServerStream[WatchResultsRequest, ResultUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResultService_WatchResultsServer = grpc.ServerStreamingServer[ResultUpdate]
+
+// ResultService_ServiceDesc is the grpc.ServiceDesc for ResultService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ResultService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "result.v1.ResultService",
+	HandlerType: (*ResultServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetVoteResult",
+			Handler:    _ResultService_GetVoteResult_Handler,
+		},
+		{
+			MethodName: "GetVoteResultsByElectionPair",
+			Handler:    _ResultService_GetVoteResultsByElectionPair_Handler,
+		},
+		{
+			MethodName: "GetVoteResultsByRegion",
+			Handler:    _ResultService_GetVoteResultsByRegion_Handler,
+		},
+		{
+			MethodName: "GetVoteResultsByStatus",
+			Handler:    _ResultService_GetVoteResultsByStatus_Handler,
+		},
+		{
+			MethodName: "GetVoteResultsByDateRange",
+			Handler:    _ResultService_GetVoteResultsByDateRange_Handler,
+		},
+		{
+			MethodName: "GetVoteResultsByHour",
+			Handler:    _ResultService_GetVoteResultsByHour_Handler,
+		},
+		{
+			MethodName: "GetVoteResultsByDay",
+			Handler:    _ResultService_GetVoteResultsByDay_Handler,
+		},
+		{
+			MethodName: "GetElectionResults",
+			Handler:    _ResultService_GetElectionResults_Handler,
+		},
+		{
+			MethodName: "GetElectionResultsByRegion",
+			Handler:    _ResultService_GetElectionResultsByRegion_Handler,
+		},
+		{
+			MethodName: "GetRegionResults",
+			Handler:    _ResultService_GetRegionResults_Handler,
+		},
+		{
+			MethodName: "GetRegionStatistics",
+			Handler:    _ResultService_GetRegionStatistics_Handler,
+		},
+		{
+			MethodName: "GetOverallStatistics",
+			Handler:    _ResultService_GetOverallStatistics_Handler,
+		},
+		{
+			MethodName: "GetDailyStatistics",
+			Handler:    _ResultService_GetDailyStatistics_Handler,
+		},
+		{
+			MethodName: "GetVoteBreakdown",
+			Handler:    _ResultService_GetVoteBreakdown_Handler,
+		},
+		{
+			MethodName: "CountVotesByStatus",
+			Handler:    _ResultService_CountVotesByStatus_Handler,
+		},
+		{
+			MethodName: "CountVotesByElectionPair",
+			Handler:    _ResultService_CountVotesByElectionPair_Handler,
+		},
+		{
+			MethodName: "CountVotesByRegion",
+			Handler:    _ResultService_CountVotesByRegion_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchResults",
+			Handler:       _ResultService_WatchResults_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "result/v1/result.proto",
+}
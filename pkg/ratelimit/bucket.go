@@ -0,0 +1,68 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// TokenBucket is a thread-safe token bucket refilled continuously at Rate tokens per second
+// up to Burst tokens.
+type TokenBucket struct {
+	rate     float64
+	burst    float64
+	tokens   float64
+	lastFill time.Time
+	mu       sync.Mutex
+}
+
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	if burst <= 0 {
+		burst = int(math.Max(1, math.Ceil(rate)))
+	}
+
+	return &TokenBucket{
+		rate:     rate,
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		lastFill: time.Now(),
+	}
+}
+
+// Allow consumes one token and reports whether it was available.
+func (b *TokenBucket) Allow() bool {
+	allowed, _, _ := b.Take(time.Now())
+	return allowed
+}
+
+// Take consumes one token at the given time and returns whether it was available, the tokens left
+// and how long until the bucket is full again.
+func (b *TokenBucket) Take(now time.Time) (bool, int, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(now)
+
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+
+	return allowed, int(b.tokens), b.timeUntilFull()
+}
+
+func (b *TokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(b.lastFill).Seconds()
+	if elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed*b.rate)
+		b.lastFill = now
+	}
+}
+
+func (b *TokenBucket) timeUntilFull() time.Duration {
+	if b.rate <= 0 {
+		return 0
+	}
+	missing := b.burst - b.tokens
+	return time.Duration(missing / b.rate * float64(time.Second))
+}
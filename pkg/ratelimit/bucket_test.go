@@ -0,0 +1,64 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTokenBucketTake(t *testing.T) {
+	bucket := NewTokenBucket(2, 3)
+	now := bucket.lastFill
+
+	for i := 0; i < 3; i++ {
+		allowed, remaining, _ := bucket.Take(now)
+		if !allowed {
+			t.Fatalf("take %d refused within the burst", i)
+		}
+		if want := 2 - i; remaining != want {
+			t.Fatalf("take %d left %d tokens, want %d", i, remaining, want)
+		}
+	}
+
+	allowed, _, untilFull := bucket.Take(now)
+	if allowed {
+		t.Fatal("take over the burst allowed")
+	}
+	if untilFull != 1500*time.Millisecond {
+		t.Fatalf("time until full = %s, want 1.5s", untilFull)
+	}
+
+	if allowed, _, _ = bucket.Take(now.Add(500 * time.Millisecond)); !allowed {
+		t.Fatal("take refused after a token was refilled")
+	}
+}
+
+func TestTokenBucketRefillStopsAtBurst(t *testing.T) {
+	bucket := NewTokenBucket(10, 2)
+	now := bucket.lastFill
+
+	bucket.Take(now)
+	_, remaining, untilFull := bucket.Take(now.Add(time.Hour))
+	if remaining != 1 {
+		t.Fatalf("remaining = %d after a long idle time, want burst - 1", remaining)
+	}
+	if untilFull != 100*time.Millisecond {
+		t.Fatalf("time until full = %s, want 100ms", untilFull)
+	}
+}
+
+func TestNewTokenBucketDefaultBurst(t *testing.T) {
+	tests := []struct {
+		rate float64
+		want float64
+	}{
+		{rate: 0.5, want: 1},
+		{rate: 1, want: 1},
+		{rate: 2.5, want: 3},
+	}
+
+	for _, tt := range tests {
+		if got := NewTokenBucket(tt.rate, 0).burst; got != tt.want {
+			t.Errorf("NewTokenBucket(%v, 0) burst = %v, want %v", tt.rate, got, tt.want)
+		}
+	}
+}
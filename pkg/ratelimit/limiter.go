@@ -0,0 +1,82 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Decision is the outcome of a Limiter.Take call, carrying what is needed for RateLimit-* headers.
+type Decision struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	Reset      time.Duration
+	RetryAfter time.Duration
+}
+
+type limiterEntry struct {
+	bucket   *TokenBucket
+	lastSeen time.Time
+}
+
+// Limiter keeps one TokenBucket per key and forgets keys idle for longer than idleTTL,
+// so the memory used stays proportional to the number of active clients.
+type Limiter struct {
+	rate      float64
+	burst     int
+	idleTTL   time.Duration
+	buckets   map[string]*limiterEntry
+	lastSweep time.Time
+	mu        sync.Mutex
+}
+
+func NewLimiter(rate float64, burst int, idleTTL time.Duration) *Limiter {
+	return &Limiter{
+		rate:      rate,
+		burst:     burst,
+		idleTTL:   idleTTL,
+		buckets:   make(map[string]*limiterEntry),
+		lastSweep: time.Now(),
+	}
+}
+
+// Take consumes one token from the bucket of key.
+func (l *Limiter) Take(key string, now time.Time) Decision {
+	l.mu.Lock()
+	l.sweep(now)
+	entry, ok := l.buckets[key]
+	if !ok {
+		entry = &limiterEntry{bucket: NewTokenBucket(l.rate, l.burst)}
+		l.buckets[key] = entry
+	}
+	entry.lastSeen = now
+	l.mu.Unlock()
+
+	allowed, remaining, untilFull := entry.bucket.Take(now)
+	decision := Decision{
+		Allowed:   allowed,
+		Limit:     int(entry.bucket.burst),
+		Remaining: remaining,
+		Reset:     untilFull,
+	}
+
+	// The bucket is below one token, so the next one arrives (burst-1)/rate before it is full.
+	if !allowed && l.rate > 0 {
+		decision.RetryAfter = untilFull - time.Duration((entry.bucket.burst-1)/l.rate*float64(time.Second))
+	}
+
+	return decision
+}
+
+func (l *Limiter) sweep(now time.Time) {
+	if l.idleTTL <= 0 || now.Sub(l.lastSweep) < l.idleTTL {
+		return
+	}
+
+	for key, entry := range l.buckets {
+		if now.Sub(entry.lastSeen) >= l.idleTTL {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
@@ -0,0 +1,48 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLimiterTake(t *testing.T) {
+	limiter := NewLimiter(1, 2, time.Minute)
+	now := time.Now()
+
+	for i := 0; i < 2; i++ {
+		if decision := limiter.Take("a", now); !decision.Allowed || decision.Limit != 2 {
+			t.Fatalf("take %d = %+v, want allowed with limit 2", i, decision)
+		}
+	}
+
+	decision := limiter.Take("a", now)
+	if decision.Allowed {
+		t.Fatal("take over the burst allowed")
+	}
+	if decision.Remaining != 0 {
+		t.Fatalf("remaining = %d, want 0", decision.Remaining)
+	}
+	if decision.RetryAfter <= 0 || decision.RetryAfter > time.Second {
+		t.Fatalf("retry after = %s, want within the time of one token", decision.RetryAfter)
+	}
+
+	if decision = limiter.Take("b", now); !decision.Allowed {
+		t.Fatal("another key shares the bucket of the first")
+	}
+}
+
+func TestLimiterForgetsIdleKeys(t *testing.T) {
+	limiter := NewLimiter(1, 1, time.Minute)
+	now := time.Now()
+
+	limiter.Take("idle", now)
+	limiter.Take("active", now.Add(50*time.Second))
+	limiter.Take("active", now.Add(2*time.Minute))
+
+	if _, ok := limiter.buckets["idle"]; ok {
+		t.Fatal("idle key was kept past the idle TTL")
+	}
+	if _, ok := limiter.buckets["active"]; !ok {
+		t.Fatal("active key was forgotten")
+	}
+}